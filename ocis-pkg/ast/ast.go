@@ -60,6 +60,34 @@ type DateTimeNode struct {
 	Value    time.Time
 }
 
+// NumericNode represents a float64 value, units like in '10MB' are already applied
+type NumericNode struct {
+	*Base
+	Key      string
+	Operator *OperatorNode
+	Value    float64
+}
+
+// ProximityNode represents terms which must occur close to each other,
+// like in 'cat NEAR(3) dog'. Distance is the maximum number of terms between them,
+// if Ordered is set, the terms must occur in the given order (ONEAR)
+type ProximityNode struct {
+	*Base
+	Key      string
+	Terms    []string
+	Distance int
+	Ordered  bool
+}
+
+// RankNode represents a dynamic rank expression like 'cat XRANK(cb=2) dog',
+// the results match Match, the ones which also match Rank are boosted by Boost
+type RankNode struct {
+	*Base
+	Match Node
+	Rank  Node
+	Boost float64
+}
+
 // OperatorNode represents an operator value like
 // AND, OR, NOT, =, <= ... and so on
 type OperatorNode struct {
@@ -81,6 +109,10 @@ func NodeKey(n Node) string {
 		return node.Key
 	case *DateTimeNode:
 		return node.Key
+	case *NumericNode:
+		return node.Key
+	case *ProximityNode:
+		return node.Key
 	case *BooleanNode:
 		return node.Key
 	case *GroupNode:
@@ -97,6 +129,10 @@ func NodeValue(n Node) interface{} {
 		return node.Value
 	case *DateTimeNode:
 		return node.Value
+	case *NumericNode:
+		return node.Value
+	case *ProximityNode:
+		return node.Terms
 	case *BooleanNode:
 		return node.Value
 	case *GroupNode:
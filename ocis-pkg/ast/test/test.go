@@ -21,6 +21,9 @@ func DiffAst(x, y interface{}, opts ...cmp.Option) string {
 			cmpopts.IgnoreFields(ast.GroupNode{}, "Base"),
 			cmpopts.IgnoreFields(ast.BooleanNode{}, "Base"),
 			cmpopts.IgnoreFields(ast.DateTimeNode{}, "Base"),
+			cmpopts.IgnoreFields(ast.NumericNode{}, "Base"),
+			cmpopts.IgnoreFields(ast.ProximityNode{}, "Base"),
+			cmpopts.IgnoreFields(ast.RankNode{}, "Base"),
 		)...,
 	)
 }
@@ -0,0 +1,46 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/events"
+)
+
+// StartRescan triggers a rescan of already stored files
+type StartRescan struct {
+	SpaceID          string    // empty means all spaces
+	ModifiedSince    time.Time // zero means all files
+	SignatureVersion string    // the signature version which caused the rescan, empty when started manually
+	ExecutionTime    time.Time
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (StartRescan) Unmarshal(v []byte) (interface{}, error) {
+	e := StartRescan{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// StoredFileInfected is emitted when a rescan finds a virus in an already stored file
+type StoredFileInfected struct {
+	ResourceID  *provider.ResourceId
+	SpaceID     string
+	SpaceOwner  *user.UserId
+	Path        string
+	Filename    string
+	Description string
+	Scandate    time.Time
+	Outcome     events.PostprocessingOutcome // the infected file handling which was applied
+	Timestamp   *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (StoredFileInfected) Unmarshal(v []byte) (interface{}, error) {
+	e := StoredFileInfected{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -0,0 +1,3 @@
+// Package event contains the events which ocis services emit for other ocis services,
+// like the audit and userlog services, and which are not part of the reva events.
+package event
@@ -0,0 +1,40 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// AuthenticationLockedOut is emitted when a username, client IP or public link is locked out after too many failed authentication attempts
+type AuthenticationLockedOut struct {
+	Kind        string // the kind of the locked out subject, 'user', 'ip' or 'link'
+	Value       string // the username, client IP or public link token
+	ClientIP    string // the client IP of the last failed attempt
+	Failures    int
+	LockedUntil *types.Timestamp
+	Timestamp   *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (AuthenticationLockedOut) Unmarshal(v []byte) (interface{}, error) {
+	e := AuthenticationLockedOut{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// AuthenticationLockoutCleared is emitted when an admin cleared the lockout of a username, client IP or public link
+type AuthenticationLockoutCleared struct {
+	Executant *user.UserId
+	Kind      string
+	Value     string
+	Timestamp *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (AuthenticationLockoutCleared) Unmarshal(v []byte) (interface{}, error) {
+	e := AuthenticationLockoutCleared{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
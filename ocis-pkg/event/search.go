@@ -0,0 +1,29 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// SavedSearchMatched is emitted when a new or moved resource matches a saved search with an alert
+type SavedSearchMatched struct {
+	SearchID   string
+	SearchName string
+	UserID     *user.UserId // the owner of the saved search
+	Executant  *user.UserId
+	ResourceID *provider.ResourceId
+	SpaceID    string
+	Path       string
+	Filename   string
+	Timestamp  *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (SavedSearchMatched) Unmarshal(v []byte) (interface{}, error) {
+	e := SavedSearchMatched{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -2,7 +2,10 @@ package kql
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/jinzhu/now"
 	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
@@ -46,6 +49,24 @@ func toNodes[T ast.Node](in interface{}) ([]T, error) {
 	}
 }
 
+// toRankOperand returns the node of a XRANK operand,
+// restrictions which consist of several nodes, like 'mtime:today', are grouped
+func toRankOperand(in interface{}) (ast.Node, error) {
+	nodes, err := toNodes[ast.Node](in)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(nodes) {
+	case 0:
+		return nil, fmt.Errorf("can't convert '%T' to a rank operand", in)
+	case 1:
+		return nodes[0], nil
+	default:
+		return &ast.GroupNode{Nodes: nodes}, nil
+	}
+}
+
 func toString(in interface{}) (string, error) {
 	switch v := in.(type) {
 	case []byte:
@@ -79,6 +100,38 @@ func toTime(in interface{}) (time.Time, error) {
 	return now.Parse(ts)
 }
 
+// _numberUnits are the factors of the units which can follow a number, like in '10MB'
+var _numberUnits = map[string]float64{
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+	"TB": 1 << 40,
+}
+
+func toNumber(in interface{}) (float64, error) {
+	v, err := toString(in)
+	if err != nil {
+		return 0, err
+	}
+
+	factor := 1.0
+	if i := strings.IndexFunc(v, unicode.IsLetter); i >= 0 {
+		f, ok := _numberUnits[strings.ToUpper(v[i:])]
+		if !ok {
+			return 0, fmt.Errorf("unsupported unit '%s'", v[i:])
+		}
+		v, factor = v[:i], f
+	}
+
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return n * factor, nil
+}
+
 func toTimeRange(in interface{}) (*time.Time, *time.Time, error) {
 	var from, to time.Time
 
@@ -43,12 +43,12 @@ var g = &grammar{
 					pos: position{line: 19, col: 6, offset: 351},
 					exprs: []any{
 						&actionExpr{
-							pos: position{line: 233, col: 5, offset: 4804},
+							pos: position{line: 322, col: 5, offset: 6825},
 							run: (*parser).callonNodes3,
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 233, col: 5, offset: 4804},
+								pos: position{line: 322, col: 5, offset: 6825},
 								expr: &charClassMatcher{
-									pos:        position{line: 233, col: 5, offset: 4804},
+									pos:        position{line: 322, col: 5, offset: 6825},
 									val:        "[ \\t]",
 									chars:      []rune{' ', '\t'},
 									ignoreCase: false,
@@ -72,24 +72,316 @@ var g = &grammar{
 				alternatives: []any{
 					&ruleRefExpr{
 						pos:  position{line: 22, col: 5, offset: 373},
-						name: "GroupNode",
+						name: "RankNode",
 					},
 					&actionExpr{
-						pos: position{line: 46, col: 5, offset: 1042},
+						pos: position{line: 58, col: 5, offset: 1328},
 						run: (*parser).callonNode3,
 						expr: &seqExpr{
-							pos: position{line: 46, col: 5, offset: 1042},
+							pos: position{line: 58, col: 5, offset: 1328},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 58, col: 5, offset: 1328},
+									label: "l",
+									expr: &choiceExpr{
+										pos: position{line: 68, col: 5, offset: 1600},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonNode7,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 69, col: 5, offset: 1613},
+												run: (*parser).callonNode14,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 69, col: 5, offset: 1613},
+													expr: &charClassMatcher{
+														pos:        position{line: 69, col: 5, offset: 1613},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 58, col: 21, offset: 1344},
+									expr: &charClassMatcher{
+										pos:        position{line: 58, col: 21, offset: 1344},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 28, offset: 1351},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 58, col: 31, offset: 1354},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 58, col: 31, offset: 1354},
+												val:        "ONEAR",
+												ignoreCase: false,
+												want:       "\"ONEAR\"",
+											},
+											&litMatcher{
+												pos:        position{line: 58, col: 41, offset: 1364},
+												val:        "NEAR",
+												ignoreCase: false,
+												want:       "\"NEAR\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 49, offset: 1372},
+									label: "d",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 58, col: 51, offset: 1374},
+										expr: &actionExpr{
+											pos: position{line: 63, col: 5, offset: 1511},
+											run: (*parser).callonNode25,
+											expr: &seqExpr{
+												pos: position{line: 63, col: 5, offset: 1511},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 63, col: 5, offset: 1511},
+														val:        "(",
+														ignoreCase: false,
+														want:       "\"(\"",
+													},
+													&actionExpr{
+														pos: position{line: 322, col: 5, offset: 6825},
+														run: (*parser).callonNode28,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 322, col: 5, offset: 6825},
+															expr: &charClassMatcher{
+																pos:        position{line: 322, col: 5, offset: 6825},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&zeroOrOneExpr{
+														pos: position{line: 63, col: 11, offset: 1517},
+														expr: &seqExpr{
+															pos: position{line: 63, col: 12, offset: 1518},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 63, col: 12, offset: 1518},
+																	val:        "n",
+																	ignoreCase: true,
+																	want:       "\"n\"i",
+																},
+																&actionExpr{
+																	pos: position{line: 322, col: 5, offset: 6825},
+																	run: (*parser).callonNode34,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 322, col: 5, offset: 6825},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 322, col: 5, offset: 6825},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 63, col: 19, offset: 1525},
+																	val:        "=",
+																	ignoreCase: false,
+																	want:       "\"=\"",
+																},
+																&actionExpr{
+																	pos: position{line: 322, col: 5, offset: 6825},
+																	run: (*parser).callonNode38,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 322, col: 5, offset: 6825},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 322, col: 5, offset: 6825},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 63, col: 27, offset: 1533},
+														label: "v",
+														expr: &oneOrMoreExpr{
+															pos: position{line: 63, col: 29, offset: 1535},
+															expr: &actionExpr{
+																pos: position{line: 317, col: 5, offset: 6774},
+																run: (*parser).callonNode43,
+																expr: &charClassMatcher{
+																	pos:        position{line: 317, col: 5, offset: 6774},
+																	val:        "[0-9]",
+																	ranges:     []rune{'0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&actionExpr{
+														pos: position{line: 322, col: 5, offset: 6825},
+														run: (*parser).callonNode45,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 322, col: 5, offset: 6825},
+															expr: &charClassMatcher{
+																pos:        position{line: 322, col: 5, offset: 6825},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 63, col: 38, offset: 1544},
+														val:        ")",
+														ignoreCase: false,
+														want:       "\")\"",
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 58, col: 70, offset: 1393},
+									expr: &charClassMatcher{
+										pos:        position{line: 58, col: 70, offset: 1393},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 77, offset: 1400},
+									label: "r",
+									expr: &choiceExpr{
+										pos: position{line: 68, col: 5, offset: 1600},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonNode53,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 69, col: 5, offset: 1613},
+												run: (*parser).callonNode60,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 69, col: 5, offset: 1613},
+													expr: &charClassMatcher{
+														pos:        position{line: 69, col: 5, offset: 1613},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 24, col: 5, offset: 408},
+						name: "GroupNode",
+					},
+					&actionExpr{
+						pos: position{line: 84, col: 5, offset: 1990},
+						run: (*parser).callonNode64,
+						expr: &seqExpr{
+							pos: position{line: 84, col: 5, offset: 1990},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 46, col: 5, offset: 1042},
+									pos:   position{line: 84, col: 5, offset: 1990},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 46, col: 7, offset: 1044},
+										pos: position{line: 84, col: 7, offset: 1992},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode7,
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode68,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 307, col: 5, offset: 6655},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -99,23 +391,23 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 46, col: 14, offset: 1051},
+									pos: position{line: 84, col: 14, offset: 1999},
 									alternatives: []any{
 										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode10,
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonNode71,
 											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
+												pos:        position{line: 186, col: 5, offset: 4544},
 												val:        ":",
 												ignoreCase: false,
 												want:       "\":\"",
 											},
 										},
 										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode12,
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonNode73,
 											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
+												pos:        position{line: 191, col: 5, offset: 4630},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
@@ -124,19 +416,19 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 46, col: 53, offset: 1090},
+									pos:   position{line: 84, col: 53, offset: 2038},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 46, col: 56, offset: 1093},
+										pos: position{line: 84, col: 56, offset: 2041},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 46, col: 56, offset: 1093},
+												pos:        position{line: 84, col: 56, offset: 2041},
 												val:        "true",
 												ignoreCase: false,
 												want:       "\"true\"",
 											},
 											&litMatcher{
-												pos:        position{line: 46, col: 65, offset: 1102},
+												pos:        position{line: 84, col: 65, offset: 2050},
 												val:        "false",
 												ignoreCase: false,
 												want:       "\"false\"",
@@ -148,21 +440,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 51, col: 5, offset: 1203},
-						run: (*parser).callonNode18,
+						pos: position{line: 89, col: 5, offset: 2151},
+						run: (*parser).callonNode79,
 						expr: &seqExpr{
-							pos: position{line: 51, col: 5, offset: 1203},
+							pos: position{line: 89, col: 5, offset: 2151},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 51, col: 5, offset: 1203},
+									pos:   position{line: 89, col: 5, offset: 2151},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 51, col: 7, offset: 1205},
+										pos: position{line: 89, col: 7, offset: 2153},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode22,
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode83,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 307, col: 5, offset: 6655},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -171,113 +463,69 @@ var g = &grammar{
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 51, col: 13, offset: 1211},
-									label: "o",
-									expr: &choiceExpr{
-										pos: position{line: 52, col: 9, offset: 1223},
-										alternatives: []any{
-											&actionExpr{
-												pos: position{line: 145, col: 5, offset: 3358},
-												run: (*parser).callonNode26,
-												expr: &litMatcher{
-													pos:        position{line: 145, col: 5, offset: 3358},
-													val:        ">=",
-													ignoreCase: false,
-													want:       "\">=\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 135, col: 5, offset: 3174},
-												run: (*parser).callonNode28,
-												expr: &litMatcher{
-													pos:        position{line: 135, col: 5, offset: 3174},
-													val:        "<=",
-													ignoreCase: false,
-													want:       "\"<=\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 140, col: 5, offset: 3263},
-												run: (*parser).callonNode30,
-												expr: &litMatcher{
-													pos:        position{line: 140, col: 5, offset: 3263},
-													val:        ">",
-													ignoreCase: false,
-													want:       "\">\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 130, col: 5, offset: 3082},
-												run: (*parser).callonNode32,
-												expr: &litMatcher{
-													pos:        position{line: 130, col: 5, offset: 3082},
-													val:        "<",
-													ignoreCase: false,
-													want:       "\"<\"",
-												},
-											},
-											&actionExpr{
-												pos: position{line: 125, col: 5, offset: 2997},
-												run: (*parser).callonNode34,
-												expr: &litMatcher{
-													pos:        position{line: 125, col: 5, offset: 2997},
-													val:        "=",
-													ignoreCase: false,
-													want:       "\"=\"",
-												},
+								&choiceExpr{
+									pos: position{line: 90, col: 9, offset: 2169},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonNode86,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
 											},
-											&actionExpr{
-												pos: position{line: 120, col: 5, offset: 2911},
-												run: (*parser).callonNode36,
-												expr: &litMatcher{
-													pos:        position{line: 120, col: 5, offset: 2911},
-													val:        ":",
-													ignoreCase: false,
-													want:       "\":\"",
-												},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonNode88,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
 											},
 										},
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 58, col: 7, offset: 1403},
+									pos: position{line: 92, col: 7, offset: 2221},
 									expr: &litMatcher{
-										pos:        position{line: 58, col: 7, offset: 1403},
+										pos:        position{line: 92, col: 7, offset: 2221},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 58, col: 12, offset: 1408},
-									label: "v",
+									pos:   position{line: 92, col: 12, offset: 2226},
+									label: "f",
 									expr: &choiceExpr{
-										pos: position{line: 59, col: 9, offset: 1420},
+										pos: position{line: 93, col: 9, offset: 2238},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 195, col: 5, offset: 4197},
-												run: (*parser).callonNode42,
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonNode94,
 												expr: &seqExpr{
-													pos: position{line: 195, col: 5, offset: 4197},
+													pos: position{line: 261, col: 5, offset: 5830},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 185, col: 5, offset: 3960},
-															run: (*parser).callonNode44,
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonNode96,
 															expr: &seqExpr{
-																pos: position{line: 185, col: 5, offset: 3960},
+																pos: position{line: 251, col: 5, offset: 5593},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 155, col: 5, offset: 3560},
-																		run: (*parser).callonNode46,
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonNode98,
 																		expr: &seqExpr{
-																			pos: position{line: 155, col: 5, offset: 3560},
+																			pos: position{line: 221, col: 5, offset: 5193},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode48,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode100,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -285,10 +533,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode50,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode102,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -296,10 +544,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode52,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode104,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -307,10 +555,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode54,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode106,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -321,22 +569,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 14, offset: 3969},
+																		pos:        position{line: 251, col: 14, offset: 5602},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 160, col: 5, offset: 3637},
-																		run: (*parser).callonNode57,
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonNode109,
 																		expr: &seqExpr{
-																			pos: position{line: 160, col: 5, offset: 3637},
+																			pos: position{line: 226, col: 5, offset: 5270},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode59,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode111,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -344,10 +592,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode61,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode113,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -358,22 +606,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 28, offset: 3983},
+																		pos:        position{line: 251, col: 28, offset: 5616},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 165, col: 5, offset: 3700},
-																		run: (*parser).callonNode64,
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonNode116,
 																		expr: &seqExpr{
-																			pos: position{line: 165, col: 5, offset: 3700},
+																			pos: position{line: 231, col: 5, offset: 5333},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode66,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode118,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -381,10 +629,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode68,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode120,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -398,28 +646,28 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 195, col: 14, offset: 4206},
+															pos:        position{line: 261, col: 14, offset: 5839},
 															val:        "T",
 															ignoreCase: false,
 															want:       "\"T\"",
 														},
 														&actionExpr{
-															pos: position{line: 190, col: 5, offset: 4047},
-															run: (*parser).callonNode71,
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonNode123,
 															expr: &seqExpr{
-																pos: position{line: 190, col: 5, offset: 4047},
+																pos: position{line: 256, col: 5, offset: 5680},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 170, col: 5, offset: 3764},
-																		run: (*parser).callonNode73,
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonNode125,
 																		expr: &seqExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
+																			pos: position{line: 236, col: 5, offset: 5397},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode75,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode127,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -427,10 +675,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode77,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode129,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -441,22 +689,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 14, offset: 4056},
+																		pos:        position{line: 256, col: 14, offset: 5689},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 175, col: 5, offset: 3830},
-																		run: (*parser).callonNode80,
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonNode132,
 																		expr: &seqExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
+																			pos: position{line: 241, col: 5, offset: 5463},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode82,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode134,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -464,10 +712,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode84,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode136,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -478,22 +726,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 29, offset: 4071},
+																		pos:        position{line: 256, col: 29, offset: 5704},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 180, col: 5, offset: 3896},
-																		run: (*parser).callonNode87,
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonNode139,
 																		expr: &seqExpr{
-																			pos: position{line: 180, col: 5, offset: 3896},
+																			pos: position{line: 246, col: 5, offset: 5529},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode89,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode141,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -501,10 +749,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
-																					run: (*parser).callonNode91,
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode143,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 317, col: 5, offset: 6774},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -515,23 +763,23 @@ var g = &grammar{
 																		},
 																	},
 																	&zeroOrOneExpr{
-																		pos: position{line: 190, col: 44, offset: 4086},
+																		pos: position{line: 256, col: 44, offset: 5719},
 																		expr: &seqExpr{
-																			pos: position{line: 190, col: 45, offset: 4087},
+																			pos: position{line: 256, col: 45, offset: 5720},
 																			exprs: []any{
 																				&litMatcher{
-																					pos:        position{line: 190, col: 45, offset: 4087},
+																					pos:        position{line: 256, col: 45, offset: 5720},
 																					val:        ".",
 																					ignoreCase: false,
 																					want:       "\".\"",
 																				},
 																				&oneOrMoreExpr{
-																					pos: position{line: 190, col: 49, offset: 4091},
+																					pos: position{line: 256, col: 49, offset: 5724},
 																					expr: &actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode97,
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode149,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 317, col: 5, offset: 6774},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -543,35 +791,35 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 190, col: 59, offset: 4101},
+																		pos: position{line: 256, col: 59, offset: 5734},
 																		alternatives: []any{
 																			&litMatcher{
-																				pos:        position{line: 190, col: 59, offset: 4101},
+																				pos:        position{line: 256, col: 59, offset: 5734},
 																				val:        "Z",
 																				ignoreCase: false,
 																				want:       "\"Z\"",
 																			},
 																			&seqExpr{
-																				pos: position{line: 190, col: 65, offset: 4107},
+																				pos: position{line: 256, col: 65, offset: 5740},
 																				exprs: []any{
 																					&charClassMatcher{
-																						pos:        position{line: 190, col: 66, offset: 4108},
+																						pos:        position{line: 256, col: 66, offset: 5741},
 																						val:        "[+-]",
 																						chars:      []rune{'+', '-'},
 																						ignoreCase: false,
 																						inverted:   false,
 																					},
 																					&actionExpr{
-																						pos: position{line: 170, col: 5, offset: 3764},
-																						run: (*parser).callonNode103,
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonNode155,
 																						expr: &seqExpr{
-																							pos: position{line: 170, col: 5, offset: 3764},
+																							pos: position{line: 236, col: 5, offset: 5397},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode105,
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode157,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 317, col: 5, offset: 6774},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -579,10 +827,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode107,
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode159,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 317, col: 5, offset: 6774},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -593,22 +841,22 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 190, col: 86, offset: 4128},
+																						pos:        position{line: 256, col: 86, offset: 5761},
 																						val:        ":",
 																						ignoreCase: false,
 																						want:       "\":\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 175, col: 5, offset: 3830},
-																						run: (*parser).callonNode110,
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonNode162,
 																						expr: &seqExpr{
-																							pos: position{line: 175, col: 5, offset: 3830},
+																							pos: position{line: 241, col: 5, offset: 5463},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode112,
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode164,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 317, col: 5, offset: 6774},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -616,10 +864,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
-																									run: (*parser).callonNode114,
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode166,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 317, col: 5, offset: 6774},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -640,22 +888,22 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 185, col: 5, offset: 3960},
-												run: (*parser).callonNode116,
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonNode168,
 												expr: &seqExpr{
-													pos: position{line: 185, col: 5, offset: 3960},
+													pos: position{line: 251, col: 5, offset: 5593},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 155, col: 5, offset: 3560},
-															run: (*parser).callonNode118,
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonNode170,
 															expr: &seqExpr{
-																pos: position{line: 155, col: 5, offset: 3560},
+																pos: position{line: 221, col: 5, offset: 5193},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode120,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode172,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -663,10 +911,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode122,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode174,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -674,10 +922,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode124,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode176,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -685,10 +933,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode126,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode178,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -699,22 +947,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 14, offset: 3969},
+															pos:        position{line: 251, col: 14, offset: 5602},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 160, col: 5, offset: 3637},
-															run: (*parser).callonNode129,
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonNode181,
 															expr: &seqExpr{
-																pos: position{line: 160, col: 5, offset: 3637},
+																pos: position{line: 226, col: 5, offset: 5270},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode131,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode183,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -722,10 +970,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode133,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode185,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -736,22 +984,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 28, offset: 3983},
+															pos:        position{line: 251, col: 28, offset: 5616},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 165, col: 5, offset: 3700},
-															run: (*parser).callonNode136,
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonNode188,
 															expr: &seqExpr{
-																pos: position{line: 165, col: 5, offset: 3700},
+																pos: position{line: 231, col: 5, offset: 5333},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode138,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode190,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -759,10 +1007,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode140,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode192,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -775,60 +1023,433 @@ var g = &grammar{
 													},
 												},
 											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 95, col: 7, offset: 2272},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 95, col: 12, offset: 2277},
+									label: "t",
+									expr: &choiceExpr{
+										pos: position{line: 96, col: 9, offset: 2289},
+										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 190, col: 5, offset: 4047},
-												run: (*parser).callonNode142,
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonNode197,
 												expr: &seqExpr{
-													pos: position{line: 190, col: 5, offset: 4047},
+													pos: position{line: 261, col: 5, offset: 5830},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 170, col: 5, offset: 3764},
-															run: (*parser).callonNode144,
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonNode199,
 															expr: &seqExpr{
-																pos: position{line: 170, col: 5, offset: 3764},
+																pos: position{line: 251, col: 5, offset: 5593},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode146,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonNode201,
+																		expr: &seqExpr{
+																			pos: position{line: 221, col: 5, offset: 5193},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode203,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode205,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode207,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode209,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
 																		},
 																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 14, offset: 5602},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode148,
-																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonNode212,
+																		expr: &seqExpr{
+																			pos: position{line: 226, col: 5, offset: 5270},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode214,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode216,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 28, offset: 5616},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonNode219,
+																		expr: &seqExpr{
+																			pos: position{line: 231, col: 5, offset: 5333},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode221,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode223,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
 																		},
 																	},
 																},
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 190, col: 14, offset: 4056},
-															val:        ":",
+															pos:        position{line: 261, col: 14, offset: 5839},
+															val:        "T",
 															ignoreCase: false,
-															want:       "\":\"",
+															want:       "\"T\"",
+														},
+														&actionExpr{
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonNode226,
+															expr: &seqExpr{
+																pos: position{line: 256, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonNode228,
+																		expr: &seqExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode230,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode232,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 14, offset: 5689},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonNode235,
+																		expr: &seqExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode237,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode239,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 29, offset: 5704},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonNode242,
+																		expr: &seqExpr{
+																			pos: position{line: 246, col: 5, offset: 5529},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode244,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode246,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 256, col: 44, offset: 5719},
+																		expr: &seqExpr{
+																			pos: position{line: 256, col: 45, offset: 5720},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 256, col: 45, offset: 5720},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 256, col: 49, offset: 5724},
+																					expr: &actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode252,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 256, col: 59, offset: 5734},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 256, col: 59, offset: 5734},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 256, col: 65, offset: 5740},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 256, col: 66, offset: 5741},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonNode258,
+																						expr: &seqExpr{
+																							pos: position{line: 236, col: 5, offset: 5397},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode260,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode262,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 256, col: 86, offset: 5761},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonNode265,
+																						expr: &seqExpr{
+																							pos: position{line: 241, col: 5, offset: 5463},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode267,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode269,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
 														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonNode271,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5593},
+													exprs: []any{
 														&actionExpr{
-															pos: position{line: 175, col: 5, offset: 3830},
-															run: (*parser).callonNode151,
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonNode273,
 															expr: &seqExpr{
-																pos: position{line: 175, col: 5, offset: 3830},
+																pos: position{line: 221, col: 5, offset: 5193},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode153,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode275,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode277,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -836,10 +1457,21 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode155,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode279,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode281,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -850,22 +1482,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 190, col: 29, offset: 4071},
-															val:        ":",
+															pos:        position{line: 251, col: 14, offset: 5602},
+															val:        "-",
 															ignoreCase: false,
-															want:       "\":\"",
+															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 180, col: 5, offset: 3896},
-															run: (*parser).callonNode158,
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonNode284,
 															expr: &seqExpr{
-																pos: position{line: 180, col: 5, offset: 3896},
+																pos: position{line: 226, col: 5, offset: 5270},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode160,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode286,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -873,10 +1505,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
-																		run: (*parser).callonNode162,
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode288,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 317, col: 5, offset: 6774},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -886,120 +1518,38 @@ var g = &grammar{
 																},
 															},
 														},
-														&zeroOrOneExpr{
-															pos: position{line: 190, col: 44, offset: 4086},
-															expr: &seqExpr{
-																pos: position{line: 190, col: 45, offset: 4087},
-																exprs: []any{
-																	&litMatcher{
-																		pos:        position{line: 190, col: 45, offset: 4087},
-																		val:        ".",
-																		ignoreCase: false,
-																		want:       "\".\"",
-																	},
-																	&oneOrMoreExpr{
-																		pos: position{line: 190, col: 49, offset: 4091},
-																		expr: &actionExpr{
-																			pos: position{line: 228, col: 5, offset: 4753},
-																			run: (*parser).callonNode168,
-																			expr: &charClassMatcher{
-																				pos:        position{line: 228, col: 5, offset: 4753},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
-																			},
-																		},
-																	},
-																},
-															},
+														&litMatcher{
+															pos:        position{line: 251, col: 28, offset: 5616},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
 														},
-														&choiceExpr{
-															pos: position{line: 190, col: 59, offset: 4101},
-															alternatives: []any{
-																&litMatcher{
-																	pos:        position{line: 190, col: 59, offset: 4101},
-																	val:        "Z",
-																	ignoreCase: false,
-																	want:       "\"Z\"",
-																},
-																&seqExpr{
-																	pos: position{line: 190, col: 65, offset: 4107},
-																	exprs: []any{
-																		&charClassMatcher{
-																			pos:        position{line: 190, col: 66, offset: 4108},
-																			val:        "[+-]",
-																			chars:      []rune{'+', '-'},
+														&actionExpr{
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonNode291,
+															expr: &seqExpr{
+																pos: position{line: 231, col: 5, offset: 5333},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode293,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
 																			inverted:   false,
 																		},
-																		&actionExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
-																			run: (*parser).callonNode174,
-																			expr: &seqExpr{
-																				pos: position{line: 170, col: 5, offset: 3764},
-																				exprs: []any{
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode176,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode178,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 190, col: 86, offset: 4128},
-																			val:        ":",
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode295,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
-																			want:       "\":\"",
-																		},
-																		&actionExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
-																			run: (*parser).callonNode181,
-																			expr: &seqExpr{
-																				pos: position{line: 175, col: 5, offset: 3830},
-																				exprs: []any{
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode183,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
-																						run: (*parser).callonNode185,
-																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
-																							val:        "[0-9]",
-																							ranges:     []rune{'0', '9'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																				},
-																			},
+																			inverted:   false,
 																		},
 																	},
 																},
@@ -1012,9 +1562,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 62, col: 7, offset: 1473},
+									pos: position{line: 98, col: 7, offset: 2323},
 									expr: &litMatcher{
-										pos:        position{line: 62, col: 7, offset: 1473},
+										pos:        position{line: 98, col: 7, offset: 2323},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
@@ -1024,21 +1574,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 65, col: 5, offset: 1549},
-						run: (*parser).callonNode189,
+						pos: position{line: 101, col: 5, offset: 2404},
+						run: (*parser).callonNode299,
 						expr: &seqExpr{
-							pos: position{line: 65, col: 5, offset: 1549},
+							pos: position{line: 101, col: 5, offset: 2404},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 65, col: 5, offset: 1549},
+									pos:   position{line: 101, col: 5, offset: 2404},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 65, col: 7, offset: 1551},
+										pos: position{line: 101, col: 7, offset: 2406},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode193,
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode303,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 307, col: 5, offset: 6655},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -1047,439 +1597,5214 @@ var g = &grammar{
 										},
 									},
 								},
-								&choiceExpr{
-									pos: position{line: 66, col: 9, offset: 1567},
-									alternatives: []any{
-										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode196,
-											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
-												val:        "=",
-												ignoreCase: false,
-												want:       "\"=\"",
-											},
-										},
-										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode198,
-											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
-												val:        ":",
-												ignoreCase: false,
-												want:       "\":\"",
-											},
-										},
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 68, col: 7, offset: 1619},
-									expr: &litMatcher{
-										pos:        position{line: 68, col: 7, offset: 1619},
-										val:        "\"",
-										ignoreCase: false,
-										want:       "\"\\\"\"",
-									},
-								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 12, offset: 1624},
-									label: "v",
+									pos:   position{line: 101, col: 13, offset: 2412},
+									label: "o",
 									expr: &choiceExpr{
-										pos: position{line: 200, col: 5, offset: 4285},
+										pos: position{line: 102, col: 9, offset: 2424},
 										alternatives: []any{
-											&litMatcher{
-												pos:        position{line: 200, col: 5, offset: 4285},
-												val:        "today",
-												ignoreCase: false,
-												want:       "\"today\"",
-											},
-											&litMatcher{
-												pos:        position{line: 201, col: 5, offset: 4299},
-												val:        "yesterday",
-												ignoreCase: false,
-												want:       "\"yesterday\"",
-											},
-											&litMatcher{
-												pos:        position{line: 202, col: 5, offset: 4317},
-												val:        "this week",
-												ignoreCase: false,
-												want:       "\"this week\"",
-											},
-											&litMatcher{
-												pos:        position{line: 203, col: 5, offset: 4335},
-												val:        "last week",
-												ignoreCase: false,
-												want:       "\"last week\"",
-											},
-											&litMatcher{
-												pos:        position{line: 204, col: 5, offset: 4353},
-												val:        "last 7 days",
-												ignoreCase: false,
-												want:       "\"last 7 days\"",
+											&actionExpr{
+												pos: position{line: 211, col: 5, offset: 4991},
+												run: (*parser).callonNode307,
+												expr: &litMatcher{
+													pos:        position{line: 211, col: 5, offset: 4991},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
 											},
-											&litMatcher{
-												pos:        position{line: 205, col: 5, offset: 4373},
-												val:        "this month",
-												ignoreCase: false,
-												want:       "\"this month\"",
+											&actionExpr{
+												pos: position{line: 201, col: 5, offset: 4807},
+												run: (*parser).callonNode309,
+												expr: &litMatcher{
+													pos:        position{line: 201, col: 5, offset: 4807},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
 											},
-											&litMatcher{
-												pos:        position{line: 206, col: 5, offset: 4392},
-												val:        "last month",
-												ignoreCase: false,
-												want:       "\"last month\"",
+											&actionExpr{
+												pos: position{line: 206, col: 5, offset: 4896},
+												run: (*parser).callonNode311,
+												expr: &litMatcher{
+													pos:        position{line: 206, col: 5, offset: 4896},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
 											},
-											&litMatcher{
-												pos:        position{line: 207, col: 5, offset: 4411},
-												val:        "last 30 days",
-												ignoreCase: false,
-												want:       "\"last 30 days\"",
+											&actionExpr{
+												pos: position{line: 196, col: 5, offset: 4715},
+												run: (*parser).callonNode313,
+												expr: &litMatcher{
+													pos:        position{line: 196, col: 5, offset: 4715},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
 											},
-											&litMatcher{
-												pos:        position{line: 208, col: 5, offset: 4432},
-												val:        "this year",
-												ignoreCase: false,
-												want:       "\"this year\"",
+											&actionExpr{
+												pos: position{line: 191, col: 5, offset: 4630},
+												run: (*parser).callonNode315,
+												expr: &litMatcher{
+													pos:        position{line: 191, col: 5, offset: 4630},
+													val:        "=",
+													ignoreCase: false,
+													want:       "\"=\"",
+												},
 											},
 											&actionExpr{
-												pos: position{line: 209, col: 5, offset: 4450},
-												run: (*parser).callonNode213,
+												pos: position{line: 186, col: 5, offset: 4544},
+												run: (*parser).callonNode317,
 												expr: &litMatcher{
-													pos:        position{line: 209, col: 5, offset: 4450},
-													val:        "last year",
+													pos:        position{line: 186, col: 5, offset: 4544},
+													val:        ":",
 													ignoreCase: false,
-													want:       "\"last year\"",
+													want:       "\":\"",
 												},
 											},
 										},
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 68, col: 38, offset: 1650},
+									pos: position{line: 108, col: 7, offset: 2604},
 									expr: &litMatcher{
-										pos:        position{line: 68, col: 38, offset: 1650},
+										pos:        position{line: 108, col: 7, offset: 2604},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
 									},
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 1769},
-						run: (*parser).callonNode217,
-						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 1769},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 73, col: 5, offset: 1769},
-									label: "k",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 73, col: 7, offset: 1771},
-										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
-											run: (*parser).callonNode221,
-											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
-												val:        "[A-Za-z]",
-												ranges:     []rune{'A', 'Z', 'a', 'z'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 73, col: 14, offset: 1778},
-									alternatives: []any{
-										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode224,
-											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
-												val:        ":",
-												ignoreCase: false,
-												want:       "\":\"",
-											},
-										},
-										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode226,
-											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
-												val:        "=",
-												ignoreCase: false,
-												want:       "\"=\"",
-											},
-										},
-									},
-								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 53, offset: 1817},
+									pos:   position{line: 108, col: 12, offset: 2609},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 73, col: 56, offset: 1820},
+										pos: position{line: 109, col: 9, offset: 2621},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 223, col: 5, offset: 4693},
-												run: (*parser).callonNode230,
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonNode323,
 												expr: &seqExpr{
-													pos: position{line: 223, col: 5, offset: 4693},
+													pos: position{line: 261, col: 5, offset: 5830},
 													exprs: []any{
-														&litMatcher{
-															pos:        position{line: 223, col: 5, offset: 4693},
-															val:        "\"",
-															ignoreCase: false,
-															want:       "\"\\\"\"",
-														},
-														&labeledExpr{
-															pos:   position{line: 223, col: 9, offset: 4697},
-															label: "v",
-															expr: &zeroOrMoreExpr{
-																pos: position{line: 223, col: 11, offset: 4699},
-																expr: &charClassMatcher{
-																	pos:        position{line: 223, col: 11, offset: 4699},
-																	val:        "[^\"]",
-																	chars:      []rune{'"'},
-																	ignoreCase: false,
-																	inverted:   true,
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 223, col: 17, offset: 4705},
-															val:        "\"",
-															ignoreCase: false,
-															want:       "\"\\\"\"",
-														},
-													},
-												},
-											},
-											&oneOrMoreExpr{
-												pos: position{line: 73, col: 65, offset: 1829},
-												expr: &charClassMatcher{
-													pos:        position{line: 73, col: 65, offset: 1829},
-													val:        "[^ ()]",
-													chars:      []rune{' ', '(', ')'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 105, col: 5, offset: 2621},
-						run: (*parser).callonNode239,
-						expr: &choiceExpr{
-							pos: position{line: 105, col: 6, offset: 2622},
-							alternatives: []any{
-								&litMatcher{
-									pos:        position{line: 105, col: 6, offset: 2622},
-									val:        "AND",
-									ignoreCase: false,
-									want:       "\"AND\"",
-								},
-								&litMatcher{
-									pos:        position{line: 105, col: 14, offset: 2630},
-									val:        "+",
-									ignoreCase: false,
-									want:       "\"+\"",
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 2722},
-						run: (*parser).callonNode243,
-						expr: &choiceExpr{
-							pos: position{line: 110, col: 6, offset: 2723},
-							alternatives: []any{
-								&litMatcher{
-									pos:        position{line: 110, col: 6, offset: 2723},
-									val:        "NOT",
-									ignoreCase: false,
-									want:       "\"NOT\"",
-								},
-								&litMatcher{
-									pos:        position{line: 110, col: 14, offset: 2731},
-									val:        "-",
-									ignoreCase: false,
-									want:       "\"-\"",
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 115, col: 5, offset: 2822},
-						run: (*parser).callonNode247,
-						expr: &litMatcher{
-							pos:        position{line: 115, col: 6, offset: 2823},
-							val:        "OR",
-							ignoreCase: false,
-							want:       "\"OR\"",
-						},
-					},
-					&actionExpr{
-						pos: position{line: 86, col: 6, offset: 2109},
-						run: (*parser).callonNode249,
-						expr: &seqExpr{
-							pos: position{line: 86, col: 6, offset: 2109},
-							exprs: []any{
-								&zeroOrOneExpr{
-									pos: position{line: 86, col: 6, offset: 2109},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode252,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode254,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 86, col: 27, offset: 2130},
-									label: "v",
-									expr: &actionExpr{
-										pos: position{line: 223, col: 5, offset: 4693},
-										run: (*parser).callonNode258,
-										expr: &seqExpr{
-											pos: position{line: 223, col: 5, offset: 4693},
-											exprs: []any{
-												&litMatcher{
-													pos:        position{line: 223, col: 5, offset: 4693},
-													val:        "\"",
-													ignoreCase: false,
-													want:       "\"\\\"\"",
-												},
-												&labeledExpr{
-													pos:   position{line: 223, col: 9, offset: 4697},
-													label: "v",
-													expr: &zeroOrMoreExpr{
-														pos: position{line: 223, col: 11, offset: 4699},
-														expr: &charClassMatcher{
-															pos:        position{line: 223, col: 11, offset: 4699},
-															val:        "[^\"]",
-															chars:      []rune{'"'},
+														&actionExpr{
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonNode325,
+															expr: &seqExpr{
+																pos: position{line: 251, col: 5, offset: 5593},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonNode327,
+																		expr: &seqExpr{
+																			pos: position{line: 221, col: 5, offset: 5193},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode329,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode331,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode333,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode335,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 14, offset: 5602},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonNode338,
+																		expr: &seqExpr{
+																			pos: position{line: 226, col: 5, offset: 5270},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode340,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode342,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 28, offset: 5616},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonNode345,
+																		expr: &seqExpr{
+																			pos: position{line: 231, col: 5, offset: 5333},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode347,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode349,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 261, col: 14, offset: 5839},
+															val:        "T",
 															ignoreCase: false,
-															inverted:   true,
+															want:       "\"T\"",
 														},
-													},
-												},
-												&litMatcher{
-													pos:        position{line: 223, col: 17, offset: 4705},
-													val:        "\"",
-													ignoreCase: false,
-													want:       "\"\\\"\"",
-												},
-											},
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode265,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 86, col: 38, offset: 2141},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode269,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 91, col: 6, offset: 2239},
-						run: (*parser).callonNode271,
-						expr: &seqExpr{
-							pos: position{line: 91, col: 6, offset: 2239},
-							exprs: []any{
-								&zeroOrOneExpr{
-									pos: position{line: 91, col: 6, offset: 2239},
-									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode274,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
-											ignoreCase: false,
-											want:       "\":\"",
-										},
-									},
-								},
-								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode276,
-									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
-										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
-											val:        "[ \\t]",
-											chars:      []rune{' ', '\t'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 91, col: 27, offset: 2260},
-									label: "v",
-									expr: &oneOrMoreExpr{
-										pos: position{line: 91, col: 29, offset: 2262},
-										expr: &charClassMatcher{
-											pos:        position{line: 91, col: 29, offset: 2262},
-											val:        "[^ :()]",
-											chars:      []rune{' ', ':', '(', ')'},
-											ignoreCase: false,
-											inverted:   true,
-										},
-									},
+														&actionExpr{
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonNode352,
+															expr: &seqExpr{
+																pos: position{line: 256, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonNode354,
+																		expr: &seqExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode356,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode358,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 14, offset: 5689},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonNode361,
+																		expr: &seqExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode363,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode365,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 29, offset: 5704},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonNode368,
+																		expr: &seqExpr{
+																			pos: position{line: 246, col: 5, offset: 5529},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode370,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonNode372,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 256, col: 44, offset: 5719},
+																		expr: &seqExpr{
+																			pos: position{line: 256, col: 45, offset: 5720},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 256, col: 45, offset: 5720},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 256, col: 49, offset: 5724},
+																					expr: &actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode378,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 256, col: 59, offset: 5734},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 256, col: 59, offset: 5734},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 256, col: 65, offset: 5740},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 256, col: 66, offset: 5741},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonNode384,
+																						expr: &seqExpr{
+																							pos: position{line: 236, col: 5, offset: 5397},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode386,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode388,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 256, col: 86, offset: 5761},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonNode391,
+																						expr: &seqExpr{
+																							pos: position{line: 241, col: 5, offset: 5463},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode393,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonNode395,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonNode397,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5593},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonNode399,
+															expr: &seqExpr{
+																pos: position{line: 221, col: 5, offset: 5193},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode401,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode403,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode405,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode407,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 14, offset: 5602},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonNode410,
+															expr: &seqExpr{
+																pos: position{line: 226, col: 5, offset: 5270},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode412,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode414,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 28, offset: 5616},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonNode417,
+															expr: &seqExpr{
+																pos: position{line: 231, col: 5, offset: 5333},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode419,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode421,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 256, col: 5, offset: 5680},
+												run: (*parser).callonNode423,
+												expr: &seqExpr{
+													pos: position{line: 256, col: 5, offset: 5680},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 236, col: 5, offset: 5397},
+															run: (*parser).callonNode425,
+															expr: &seqExpr{
+																pos: position{line: 236, col: 5, offset: 5397},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode427,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode429,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 256, col: 14, offset: 5689},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 241, col: 5, offset: 5463},
+															run: (*parser).callonNode432,
+															expr: &seqExpr{
+																pos: position{line: 241, col: 5, offset: 5463},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode434,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode436,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 256, col: 29, offset: 5704},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 246, col: 5, offset: 5529},
+															run: (*parser).callonNode439,
+															expr: &seqExpr{
+																pos: position{line: 246, col: 5, offset: 5529},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode441,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonNode443,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 256, col: 44, offset: 5719},
+															expr: &seqExpr{
+																pos: position{line: 256, col: 45, offset: 5720},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 256, col: 45, offset: 5720},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 256, col: 49, offset: 5724},
+																		expr: &actionExpr{
+																			pos: position{line: 317, col: 5, offset: 6774},
+																			run: (*parser).callonNode449,
+																			expr: &charClassMatcher{
+																				pos:        position{line: 317, col: 5, offset: 6774},
+																				val:        "[0-9]",
+																				ranges:     []rune{'0', '9'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&choiceExpr{
+															pos: position{line: 256, col: 59, offset: 5734},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 256, col: 59, offset: 5734},
+																	val:        "Z",
+																	ignoreCase: false,
+																	want:       "\"Z\"",
+																},
+																&seqExpr{
+																	pos: position{line: 256, col: 65, offset: 5740},
+																	exprs: []any{
+																		&charClassMatcher{
+																			pos:        position{line: 256, col: 66, offset: 5741},
+																			val:        "[+-]",
+																			chars:      []rune{'+', '-'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&actionExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			run: (*parser).callonNode455,
+																			expr: &seqExpr{
+																				pos: position{line: 236, col: 5, offset: 5397},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode457,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode459,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																		&litMatcher{
+																			pos:        position{line: 256, col: 86, offset: 5761},
+																			val:        ":",
+																			ignoreCase: false,
+																			want:       "\":\"",
+																		},
+																		&actionExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			run: (*parser).callonNode462,
+																			expr: &seqExpr{
+																				pos: position{line: 241, col: 5, offset: 5463},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode464,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonNode466,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 112, col: 7, offset: 2674},
+									expr: &litMatcher{
+										pos:        position{line: 112, col: 7, offset: 2674},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 115, col: 5, offset: 2750},
+						run: (*parser).callonNode470,
+						expr: &seqExpr{
+							pos: position{line: 115, col: 5, offset: 2750},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 115, col: 5, offset: 2750},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 115, col: 7, offset: 2752},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode474,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 116, col: 9, offset: 2768},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonNode477,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonNode479,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 118, col: 7, offset: 2820},
+									expr: &litMatcher{
+										pos:        position{line: 118, col: 7, offset: 2820},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 118, col: 12, offset: 2825},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 266, col: 5, offset: 5918},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 266, col: 5, offset: 5918},
+												val:        "today",
+												ignoreCase: false,
+												want:       "\"today\"",
+											},
+											&litMatcher{
+												pos:        position{line: 267, col: 5, offset: 5932},
+												val:        "yesterday",
+												ignoreCase: false,
+												want:       "\"yesterday\"",
+											},
+											&litMatcher{
+												pos:        position{line: 268, col: 5, offset: 5950},
+												val:        "this week",
+												ignoreCase: false,
+												want:       "\"this week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 269, col: 5, offset: 5968},
+												val:        "last week",
+												ignoreCase: false,
+												want:       "\"last week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 270, col: 5, offset: 5986},
+												val:        "last 7 days",
+												ignoreCase: false,
+												want:       "\"last 7 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 271, col: 5, offset: 6006},
+												val:        "this month",
+												ignoreCase: false,
+												want:       "\"this month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 272, col: 5, offset: 6025},
+												val:        "last month",
+												ignoreCase: false,
+												want:       "\"last month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 273, col: 5, offset: 6044},
+												val:        "last 30 days",
+												ignoreCase: false,
+												want:       "\"last 30 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 274, col: 5, offset: 6065},
+												val:        "this year",
+												ignoreCase: false,
+												want:       "\"this year\"",
+											},
+											&actionExpr{
+												pos: position{line: 275, col: 5, offset: 6083},
+												run: (*parser).callonNode494,
+												expr: &litMatcher{
+													pos:        position{line: 275, col: 5, offset: 6083},
+													val:        "last year",
+													ignoreCase: false,
+													want:       "\"last year\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 118, col: 38, offset: 2851},
+									expr: &litMatcher{
+										pos:        position{line: 118, col: 38, offset: 2851},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 123, col: 5, offset: 2965},
+						run: (*parser).callonNode498,
+						expr: &seqExpr{
+							pos: position{line: 123, col: 5, offset: 2965},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 123, col: 5, offset: 2965},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 123, col: 7, offset: 2967},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode502,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 124, col: 9, offset: 2983},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonNode505,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonNode507,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 126, col: 7, offset: 3035},
+									label: "f",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonNode510,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonNode513,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonNode519,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonNode527,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 126, col: 16, offset: 3044},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 126, col: 21, offset: 3049},
+									label: "t",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonNode531,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonNode534,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonNode540,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonNode548,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 126, col: 30, offset: 3058},
+									expr: &charClassMatcher{
+										pos:        position{line: 126, col: 31, offset: 3059},
+										val:        "[^ ()]",
+										chars:      []rune{' ', '(', ')'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 129, col: 5, offset: 3141},
+						run: (*parser).callonNode552,
+						expr: &seqExpr{
+							pos: position{line: 129, col: 5, offset: 3141},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 129, col: 5, offset: 3141},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 129, col: 7, offset: 3143},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode556,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 129, col: 13, offset: 3149},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 130, col: 9, offset: 3161},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 211, col: 5, offset: 4991},
+												run: (*parser).callonNode560,
+												expr: &litMatcher{
+													pos:        position{line: 211, col: 5, offset: 4991},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 201, col: 5, offset: 4807},
+												run: (*parser).callonNode562,
+												expr: &litMatcher{
+													pos:        position{line: 201, col: 5, offset: 4807},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 206, col: 5, offset: 4896},
+												run: (*parser).callonNode564,
+												expr: &litMatcher{
+													pos:        position{line: 206, col: 5, offset: 4896},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 196, col: 5, offset: 4715},
+												run: (*parser).callonNode566,
+												expr: &litMatcher{
+													pos:        position{line: 196, col: 5, offset: 4715},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 134, col: 7, offset: 3285},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonNode569,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonNode572,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonNode578,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonNode586,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 134, col: 16, offset: 3294},
+									expr: &charClassMatcher{
+										pos:        position{line: 134, col: 17, offset: 3295},
+										val:        "[^ ()]",
+										chars:      []rune{' ', '(', ')'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 139, col: 5, offset: 3402},
+						run: (*parser).callonNode590,
+						expr: &seqExpr{
+							pos: position{line: 139, col: 5, offset: 3402},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 139, col: 5, offset: 3402},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 139, col: 7, offset: 3404},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonNode594,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 139, col: 14, offset: 3411},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonNode597,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonNode599,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 139, col: 53, offset: 3450},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 139, col: 56, offset: 3453},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonNode603,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 139, col: 65, offset: 3462},
+												expr: &charClassMatcher{
+													pos:        position{line: 139, col: 65, offset: 3462},
+													val:        "[^ ()]",
+													chars:      []rune{' ', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 171, col: 5, offset: 4254},
+						run: (*parser).callonNode612,
+						expr: &choiceExpr{
+							pos: position{line: 171, col: 6, offset: 4255},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 171, col: 6, offset: 4255},
+									val:        "AND",
+									ignoreCase: false,
+									want:       "\"AND\"",
+								},
+								&litMatcher{
+									pos:        position{line: 171, col: 14, offset: 4263},
+									val:        "+",
+									ignoreCase: false,
+									want:       "\"+\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 176, col: 5, offset: 4355},
+						run: (*parser).callonNode616,
+						expr: &choiceExpr{
+							pos: position{line: 176, col: 6, offset: 4356},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 176, col: 6, offset: 4356},
+									val:        "NOT",
+									ignoreCase: false,
+									want:       "\"NOT\"",
+								},
+								&litMatcher{
+									pos:        position{line: 176, col: 14, offset: 4364},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 181, col: 5, offset: 4455},
+						run: (*parser).callonNode620,
+						expr: &litMatcher{
+							pos:        position{line: 181, col: 6, offset: 4456},
+							val:        "OR",
+							ignoreCase: false,
+							want:       "\"OR\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 152, col: 6, offset: 3742},
+						run: (*parser).callonNode622,
+						expr: &seqExpr{
+							pos: position{line: 152, col: 6, offset: 3742},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 152, col: 6, offset: 3742},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonNode625,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonNode627,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 152, col: 27, offset: 3763},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 312, col: 5, offset: 6714},
+										run: (*parser).callonNode631,
+										expr: &seqExpr{
+											pos: position{line: 312, col: 5, offset: 6714},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 312, col: 5, offset: 6714},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+												&labeledExpr{
+													pos:   position{line: 312, col: 9, offset: 6718},
+													label: "v",
+													expr: &zeroOrMoreExpr{
+														pos: position{line: 312, col: 11, offset: 6720},
+														expr: &charClassMatcher{
+															pos:        position{line: 312, col: 11, offset: 6720},
+															val:        "[^\"]",
+															chars:      []rune{'"'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 312, col: 17, offset: 6726},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+											},
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonNode638,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 152, col: 38, offset: 3774},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonNode642,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 157, col: 6, offset: 3872},
+						run: (*parser).callonNode644,
+						expr: &seqExpr{
+							pos: position{line: 157, col: 6, offset: 3872},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 157, col: 6, offset: 3872},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonNode647,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonNode649,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 157, col: 27, offset: 3893},
+									label: "v",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 157, col: 29, offset: 3895},
+										expr: &charClassMatcher{
+											pos:        position{line: 157, col: 29, offset: 3895},
+											val:        "[^ :()]",
+											chars:      []rune{' ', ':', '(', ')'},
+											ignoreCase: false,
+											inverted:   true,
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonNode655,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 157, col: 40, offset: 3906},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonNode659,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GroupNode",
+			pos:  position{line: 33, col: 1, offset: 630},
+			expr: &actionExpr{
+				pos: position{line: 34, col: 5, offset: 647},
+				run: (*parser).callonGroupNode1,
+				expr: &seqExpr{
+					pos: position{line: 34, col: 5, offset: 647},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 34, col: 5, offset: 647},
+							label: "k",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 34, col: 7, offset: 649},
+								expr: &oneOrMoreExpr{
+									pos: position{line: 34, col: 8, offset: 650},
+									expr: &actionExpr{
+										pos: position{line: 307, col: 5, offset: 6655},
+										run: (*parser).callonGroupNode6,
+										expr: &charClassMatcher{
+											pos:        position{line: 307, col: 5, offset: 6655},
+											val:        "[A-Za-z]",
+											ranges:     []rune{'A', 'Z', 'a', 'z'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 34, col: 16, offset: 658},
+							expr: &choiceExpr{
+								pos: position{line: 34, col: 17, offset: 659},
+								alternatives: []any{
+									&actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonGroupNode10,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+									&actionExpr{
+										pos: position{line: 191, col: 5, offset: 4630},
+										run: (*parser).callonGroupNode12,
+										expr: &litMatcher{
+											pos:        position{line: 191, col: 5, offset: 4630},
+											val:        "=",
+											ignoreCase: false,
+											want:       "\"=\"",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 34, col: 57, offset: 699},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 34, col: 61, offset: 703},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 34, col: 63, offset: 705},
+								name: "Nodes",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 34, col: 69, offset: 711},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RankNode",
+			pos:  position{line: 42, col: 1, offset: 909},
+			expr: &actionExpr{
+				pos: position{line: 43, col: 5, offset: 925},
+				run: (*parser).callonRankNode1,
+				expr: &seqExpr{
+					pos: position{line: 43, col: 5, offset: 925},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 43, col: 5, offset: 925},
+							label: "m",
+							expr: &ruleRefExpr{
+								pos:  position{line: 43, col: 7, offset: 927},
+								name: "RankOperandNode",
+							},
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode5,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 43, col: 25, offset: 945},
+							val:        "XRANK(",
+							ignoreCase: false,
+							want:       "\"XRANK(\"",
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode9,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 43, col: 36, offset: 956},
+							val:        "cb",
+							ignoreCase: false,
+							want:       "\"cb\"",
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode13,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 43, col: 43, offset: 963},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode17,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 43, col: 49, offset: 969},
+							label: "b",
+							expr: &actionExpr{
+								pos: position{line: 298, col: 5, offset: 6463},
+								run: (*parser).callonRankNode21,
+								expr: &seqExpr{
+									pos: position{line: 298, col: 5, offset: 6463},
+									exprs: []any{
+										&oneOrMoreExpr{
+											pos: position{line: 298, col: 5, offset: 6463},
+											expr: &actionExpr{
+												pos: position{line: 317, col: 5, offset: 6774},
+												run: (*parser).callonRankNode24,
+												expr: &charClassMatcher{
+													pos:        position{line: 317, col: 5, offset: 6774},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+											},
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 298, col: 12, offset: 6470},
+											expr: &seqExpr{
+												pos: position{line: 298, col: 13, offset: 6471},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 298, col: 13, offset: 6471},
+														val:        ".",
+														ignoreCase: false,
+														want:       "\".\"",
+													},
+													&oneOrMoreExpr{
+														pos: position{line: 298, col: 17, offset: 6475},
+														expr: &actionExpr{
+															pos: position{line: 317, col: 5, offset: 6774},
+															run: (*parser).callonRankNode30,
+															expr: &charClassMatcher{
+																pos:        position{line: 317, col: 5, offset: 6774},
+																val:        "[0-9]",
+																ranges:     []rune{'0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode32,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 43, col: 61, offset: 981},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+						&actionExpr{
+							pos: position{line: 322, col: 5, offset: 6825},
+							run: (*parser).callonRankNode36,
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 322, col: 5, offset: 6825},
+								expr: &charClassMatcher{
+									pos:        position{line: 322, col: 5, offset: 6825},
+									val:        "[ \\t]",
+									chars:      []rune{' ', '\t'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 43, col: 67, offset: 987},
+							label: "r",
+							expr: &ruleRefExpr{
+								pos:  position{line: 43, col: 69, offset: 989},
+								name: "RankOperandNode",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RankOperandNode",
+			pos:  position{line: 47, col: 1, offset: 1067},
+			expr: &choiceExpr{
+				pos: position{line: 48, col: 5, offset: 1090},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 58, col: 5, offset: 1328},
+						run: (*parser).callonRankOperandNode2,
+						expr: &seqExpr{
+							pos: position{line: 58, col: 5, offset: 1328},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 58, col: 5, offset: 1328},
+									label: "l",
+									expr: &choiceExpr{
+										pos: position{line: 68, col: 5, offset: 1600},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonRankOperandNode6,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 69, col: 5, offset: 1613},
+												run: (*parser).callonRankOperandNode13,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 69, col: 5, offset: 1613},
+													expr: &charClassMatcher{
+														pos:        position{line: 69, col: 5, offset: 1613},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 58, col: 21, offset: 1344},
+									expr: &charClassMatcher{
+										pos:        position{line: 58, col: 21, offset: 1344},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 28, offset: 1351},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 58, col: 31, offset: 1354},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 58, col: 31, offset: 1354},
+												val:        "ONEAR",
+												ignoreCase: false,
+												want:       "\"ONEAR\"",
+											},
+											&litMatcher{
+												pos:        position{line: 58, col: 41, offset: 1364},
+												val:        "NEAR",
+												ignoreCase: false,
+												want:       "\"NEAR\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 49, offset: 1372},
+									label: "d",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 58, col: 51, offset: 1374},
+										expr: &actionExpr{
+											pos: position{line: 63, col: 5, offset: 1511},
+											run: (*parser).callonRankOperandNode24,
+											expr: &seqExpr{
+												pos: position{line: 63, col: 5, offset: 1511},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 63, col: 5, offset: 1511},
+														val:        "(",
+														ignoreCase: false,
+														want:       "\"(\"",
+													},
+													&actionExpr{
+														pos: position{line: 322, col: 5, offset: 6825},
+														run: (*parser).callonRankOperandNode27,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 322, col: 5, offset: 6825},
+															expr: &charClassMatcher{
+																pos:        position{line: 322, col: 5, offset: 6825},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&zeroOrOneExpr{
+														pos: position{line: 63, col: 11, offset: 1517},
+														expr: &seqExpr{
+															pos: position{line: 63, col: 12, offset: 1518},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 63, col: 12, offset: 1518},
+																	val:        "n",
+																	ignoreCase: true,
+																	want:       "\"n\"i",
+																},
+																&actionExpr{
+																	pos: position{line: 322, col: 5, offset: 6825},
+																	run: (*parser).callonRankOperandNode33,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 322, col: 5, offset: 6825},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 322, col: 5, offset: 6825},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 63, col: 19, offset: 1525},
+																	val:        "=",
+																	ignoreCase: false,
+																	want:       "\"=\"",
+																},
+																&actionExpr{
+																	pos: position{line: 322, col: 5, offset: 6825},
+																	run: (*parser).callonRankOperandNode37,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 322, col: 5, offset: 6825},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 322, col: 5, offset: 6825},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 63, col: 27, offset: 1533},
+														label: "v",
+														expr: &oneOrMoreExpr{
+															pos: position{line: 63, col: 29, offset: 1535},
+															expr: &actionExpr{
+																pos: position{line: 317, col: 5, offset: 6774},
+																run: (*parser).callonRankOperandNode42,
+																expr: &charClassMatcher{
+																	pos:        position{line: 317, col: 5, offset: 6774},
+																	val:        "[0-9]",
+																	ranges:     []rune{'0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&actionExpr{
+														pos: position{line: 322, col: 5, offset: 6825},
+														run: (*parser).callonRankOperandNode44,
+														expr: &zeroOrMoreExpr{
+															pos: position{line: 322, col: 5, offset: 6825},
+															expr: &charClassMatcher{
+																pos:        position{line: 322, col: 5, offset: 6825},
+																val:        "[ \\t]",
+																chars:      []rune{' ', '\t'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 63, col: 38, offset: 1544},
+														val:        ")",
+														ignoreCase: false,
+														want:       "\")\"",
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 58, col: 70, offset: 1393},
+									expr: &charClassMatcher{
+										pos:        position{line: 58, col: 70, offset: 1393},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 58, col: 77, offset: 1400},
+									label: "r",
+									expr: &choiceExpr{
+										pos: position{line: 68, col: 5, offset: 1600},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonRankOperandNode52,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 69, col: 5, offset: 1613},
+												run: (*parser).callonRankOperandNode59,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 69, col: 5, offset: 1613},
+													expr: &charClassMatcher{
+														pos:        position{line: 69, col: 5, offset: 1613},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 49, col: 5, offset: 1110},
+						name: "GroupNode",
+					},
+					&actionExpr{
+						pos: position{line: 84, col: 5, offset: 1990},
+						run: (*parser).callonRankOperandNode63,
+						expr: &seqExpr{
+							pos: position{line: 84, col: 5, offset: 1990},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 84, col: 5, offset: 1990},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 84, col: 7, offset: 1992},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode67,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 84, col: 14, offset: 1999},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonRankOperandNode70,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonRankOperandNode72,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 84, col: 53, offset: 2038},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 84, col: 56, offset: 2041},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 84, col: 56, offset: 2041},
+												val:        "true",
+												ignoreCase: false,
+												want:       "\"true\"",
+											},
+											&litMatcher{
+												pos:        position{line: 84, col: 65, offset: 2050},
+												val:        "false",
+												ignoreCase: false,
+												want:       "\"false\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 89, col: 5, offset: 2151},
+						run: (*parser).callonRankOperandNode78,
+						expr: &seqExpr{
+							pos: position{line: 89, col: 5, offset: 2151},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 89, col: 5, offset: 2151},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 89, col: 7, offset: 2153},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode82,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 90, col: 9, offset: 2169},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonRankOperandNode85,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonRankOperandNode87,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 92, col: 7, offset: 2221},
+									expr: &litMatcher{
+										pos:        position{line: 92, col: 7, offset: 2221},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 92, col: 12, offset: 2226},
+									label: "f",
+									expr: &choiceExpr{
+										pos: position{line: 93, col: 9, offset: 2238},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonRankOperandNode93,
+												expr: &seqExpr{
+													pos: position{line: 261, col: 5, offset: 5830},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonRankOperandNode95,
+															expr: &seqExpr{
+																pos: position{line: 251, col: 5, offset: 5593},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonRankOperandNode97,
+																		expr: &seqExpr{
+																			pos: position{line: 221, col: 5, offset: 5193},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode99,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode101,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode103,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode105,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 14, offset: 5602},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonRankOperandNode108,
+																		expr: &seqExpr{
+																			pos: position{line: 226, col: 5, offset: 5270},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode110,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode112,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 28, offset: 5616},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonRankOperandNode115,
+																		expr: &seqExpr{
+																			pos: position{line: 231, col: 5, offset: 5333},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode117,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode119,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 261, col: 14, offset: 5839},
+															val:        "T",
+															ignoreCase: false,
+															want:       "\"T\"",
+														},
+														&actionExpr{
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonRankOperandNode122,
+															expr: &seqExpr{
+																pos: position{line: 256, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonRankOperandNode124,
+																		expr: &seqExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode126,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode128,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 14, offset: 5689},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonRankOperandNode131,
+																		expr: &seqExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode133,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode135,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 29, offset: 5704},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonRankOperandNode138,
+																		expr: &seqExpr{
+																			pos: position{line: 246, col: 5, offset: 5529},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode140,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode142,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 256, col: 44, offset: 5719},
+																		expr: &seqExpr{
+																			pos: position{line: 256, col: 45, offset: 5720},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 256, col: 45, offset: 5720},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 256, col: 49, offset: 5724},
+																					expr: &actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode148,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 256, col: 59, offset: 5734},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 256, col: 59, offset: 5734},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 256, col: 65, offset: 5740},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 256, col: 66, offset: 5741},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonRankOperandNode154,
+																						expr: &seqExpr{
+																							pos: position{line: 236, col: 5, offset: 5397},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode156,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode158,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 256, col: 86, offset: 5761},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonRankOperandNode161,
+																						expr: &seqExpr{
+																							pos: position{line: 241, col: 5, offset: 5463},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode163,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode165,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonRankOperandNode167,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5593},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonRankOperandNode169,
+															expr: &seqExpr{
+																pos: position{line: 221, col: 5, offset: 5193},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode171,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode173,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode175,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode177,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 14, offset: 5602},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonRankOperandNode180,
+															expr: &seqExpr{
+																pos: position{line: 226, col: 5, offset: 5270},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode182,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode184,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 28, offset: 5616},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonRankOperandNode187,
+															expr: &seqExpr{
+																pos: position{line: 231, col: 5, offset: 5333},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode189,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode191,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 95, col: 7, offset: 2272},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 95, col: 12, offset: 2277},
+									label: "t",
+									expr: &choiceExpr{
+										pos: position{line: 96, col: 9, offset: 2289},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonRankOperandNode196,
+												expr: &seqExpr{
+													pos: position{line: 261, col: 5, offset: 5830},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonRankOperandNode198,
+															expr: &seqExpr{
+																pos: position{line: 251, col: 5, offset: 5593},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonRankOperandNode200,
+																		expr: &seqExpr{
+																			pos: position{line: 221, col: 5, offset: 5193},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode202,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode204,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode206,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode208,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 14, offset: 5602},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonRankOperandNode211,
+																		expr: &seqExpr{
+																			pos: position{line: 226, col: 5, offset: 5270},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode213,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode215,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 28, offset: 5616},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonRankOperandNode218,
+																		expr: &seqExpr{
+																			pos: position{line: 231, col: 5, offset: 5333},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode220,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode222,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 261, col: 14, offset: 5839},
+															val:        "T",
+															ignoreCase: false,
+															want:       "\"T\"",
+														},
+														&actionExpr{
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonRankOperandNode225,
+															expr: &seqExpr{
+																pos: position{line: 256, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonRankOperandNode227,
+																		expr: &seqExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode229,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode231,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 14, offset: 5689},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonRankOperandNode234,
+																		expr: &seqExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode236,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode238,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 29, offset: 5704},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonRankOperandNode241,
+																		expr: &seqExpr{
+																			pos: position{line: 246, col: 5, offset: 5529},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode243,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode245,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 256, col: 44, offset: 5719},
+																		expr: &seqExpr{
+																			pos: position{line: 256, col: 45, offset: 5720},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 256, col: 45, offset: 5720},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 256, col: 49, offset: 5724},
+																					expr: &actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode251,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 256, col: 59, offset: 5734},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 256, col: 59, offset: 5734},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 256, col: 65, offset: 5740},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 256, col: 66, offset: 5741},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonRankOperandNode257,
+																						expr: &seqExpr{
+																							pos: position{line: 236, col: 5, offset: 5397},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode259,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode261,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 256, col: 86, offset: 5761},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonRankOperandNode264,
+																						expr: &seqExpr{
+																							pos: position{line: 241, col: 5, offset: 5463},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode266,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode268,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonRankOperandNode270,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5593},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonRankOperandNode272,
+															expr: &seqExpr{
+																pos: position{line: 221, col: 5, offset: 5193},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode274,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode276,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode278,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode280,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 14, offset: 5602},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonRankOperandNode283,
+															expr: &seqExpr{
+																pos: position{line: 226, col: 5, offset: 5270},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode285,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode287,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 28, offset: 5616},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonRankOperandNode290,
+															expr: &seqExpr{
+																pos: position{line: 231, col: 5, offset: 5333},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode292,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode294,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 98, col: 7, offset: 2323},
+									expr: &litMatcher{
+										pos:        position{line: 98, col: 7, offset: 2323},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 101, col: 5, offset: 2404},
+						run: (*parser).callonRankOperandNode298,
+						expr: &seqExpr{
+							pos: position{line: 101, col: 5, offset: 2404},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 101, col: 5, offset: 2404},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 101, col: 7, offset: 2406},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode302,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 101, col: 13, offset: 2412},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 102, col: 9, offset: 2424},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 211, col: 5, offset: 4991},
+												run: (*parser).callonRankOperandNode306,
+												expr: &litMatcher{
+													pos:        position{line: 211, col: 5, offset: 4991},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 201, col: 5, offset: 4807},
+												run: (*parser).callonRankOperandNode308,
+												expr: &litMatcher{
+													pos:        position{line: 201, col: 5, offset: 4807},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 206, col: 5, offset: 4896},
+												run: (*parser).callonRankOperandNode310,
+												expr: &litMatcher{
+													pos:        position{line: 206, col: 5, offset: 4896},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 196, col: 5, offset: 4715},
+												run: (*parser).callonRankOperandNode312,
+												expr: &litMatcher{
+													pos:        position{line: 196, col: 5, offset: 4715},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 191, col: 5, offset: 4630},
+												run: (*parser).callonRankOperandNode314,
+												expr: &litMatcher{
+													pos:        position{line: 191, col: 5, offset: 4630},
+													val:        "=",
+													ignoreCase: false,
+													want:       "\"=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 186, col: 5, offset: 4544},
+												run: (*parser).callonRankOperandNode316,
+												expr: &litMatcher{
+													pos:        position{line: 186, col: 5, offset: 4544},
+													val:        ":",
+													ignoreCase: false,
+													want:       "\":\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 108, col: 7, offset: 2604},
+									expr: &litMatcher{
+										pos:        position{line: 108, col: 7, offset: 2604},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 108, col: 12, offset: 2609},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 109, col: 9, offset: 2621},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 261, col: 5, offset: 5830},
+												run: (*parser).callonRankOperandNode322,
+												expr: &seqExpr{
+													pos: position{line: 261, col: 5, offset: 5830},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 251, col: 5, offset: 5593},
+															run: (*parser).callonRankOperandNode324,
+															expr: &seqExpr{
+																pos: position{line: 251, col: 5, offset: 5593},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 221, col: 5, offset: 5193},
+																		run: (*parser).callonRankOperandNode326,
+																		expr: &seqExpr{
+																			pos: position{line: 221, col: 5, offset: 5193},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode328,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode330,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode332,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode334,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 14, offset: 5602},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 226, col: 5, offset: 5270},
+																		run: (*parser).callonRankOperandNode337,
+																		expr: &seqExpr{
+																			pos: position{line: 226, col: 5, offset: 5270},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode339,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode341,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 251, col: 28, offset: 5616},
+																		val:        "-",
+																		ignoreCase: false,
+																		want:       "\"-\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 231, col: 5, offset: 5333},
+																		run: (*parser).callonRankOperandNode344,
+																		expr: &seqExpr{
+																			pos: position{line: 231, col: 5, offset: 5333},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode346,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode348,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 261, col: 14, offset: 5839},
+															val:        "T",
+															ignoreCase: false,
+															want:       "\"T\"",
+														},
+														&actionExpr{
+															pos: position{line: 256, col: 5, offset: 5680},
+															run: (*parser).callonRankOperandNode351,
+															expr: &seqExpr{
+																pos: position{line: 256, col: 5, offset: 5680},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 236, col: 5, offset: 5397},
+																		run: (*parser).callonRankOperandNode353,
+																		expr: &seqExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode355,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode357,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 14, offset: 5689},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 241, col: 5, offset: 5463},
+																		run: (*parser).callonRankOperandNode360,
+																		expr: &seqExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode362,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode364,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 256, col: 29, offset: 5704},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&actionExpr{
+																		pos: position{line: 246, col: 5, offset: 5529},
+																		run: (*parser).callonRankOperandNode367,
+																		expr: &seqExpr{
+																			pos: position{line: 246, col: 5, offset: 5529},
+																			exprs: []any{
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode369,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 317, col: 5, offset: 6774},
+																					run: (*parser).callonRankOperandNode371,
+																					expr: &charClassMatcher{
+																						pos:        position{line: 317, col: 5, offset: 6774},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 256, col: 44, offset: 5719},
+																		expr: &seqExpr{
+																			pos: position{line: 256, col: 45, offset: 5720},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 256, col: 45, offset: 5720},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 256, col: 49, offset: 5724},
+																					expr: &actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode377,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 256, col: 59, offset: 5734},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 256, col: 59, offset: 5734},
+																				val:        "Z",
+																				ignoreCase: false,
+																				want:       "\"Z\"",
+																			},
+																			&seqExpr{
+																				pos: position{line: 256, col: 65, offset: 5740},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 256, col: 66, offset: 5741},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&actionExpr{
+																						pos: position{line: 236, col: 5, offset: 5397},
+																						run: (*parser).callonRankOperandNode383,
+																						expr: &seqExpr{
+																							pos: position{line: 236, col: 5, offset: 5397},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode385,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode387,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 256, col: 86, offset: 5761},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&actionExpr{
+																						pos: position{line: 241, col: 5, offset: 5463},
+																						run: (*parser).callonRankOperandNode390,
+																						expr: &seqExpr{
+																							pos: position{line: 241, col: 5, offset: 5463},
+																							exprs: []any{
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode392,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&actionExpr{
+																									pos: position{line: 317, col: 5, offset: 6774},
+																									run: (*parser).callonRankOperandNode394,
+																									expr: &charClassMatcher{
+																										pos:        position{line: 317, col: 5, offset: 6774},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5593},
+												run: (*parser).callonRankOperandNode396,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5593},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 221, col: 5, offset: 5193},
+															run: (*parser).callonRankOperandNode398,
+															expr: &seqExpr{
+																pos: position{line: 221, col: 5, offset: 5193},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode400,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode402,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode404,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode406,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 14, offset: 5602},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 226, col: 5, offset: 5270},
+															run: (*parser).callonRankOperandNode409,
+															expr: &seqExpr{
+																pos: position{line: 226, col: 5, offset: 5270},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode411,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode413,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 28, offset: 5616},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&actionExpr{
+															pos: position{line: 231, col: 5, offset: 5333},
+															run: (*parser).callonRankOperandNode416,
+															expr: &seqExpr{
+																pos: position{line: 231, col: 5, offset: 5333},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode418,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode420,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 256, col: 5, offset: 5680},
+												run: (*parser).callonRankOperandNode422,
+												expr: &seqExpr{
+													pos: position{line: 256, col: 5, offset: 5680},
+													exprs: []any{
+														&actionExpr{
+															pos: position{line: 236, col: 5, offset: 5397},
+															run: (*parser).callonRankOperandNode424,
+															expr: &seqExpr{
+																pos: position{line: 236, col: 5, offset: 5397},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode426,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode428,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 256, col: 14, offset: 5689},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 241, col: 5, offset: 5463},
+															run: (*parser).callonRankOperandNode431,
+															expr: &seqExpr{
+																pos: position{line: 241, col: 5, offset: 5463},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode433,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode435,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 256, col: 29, offset: 5704},
+															val:        ":",
+															ignoreCase: false,
+															want:       "\":\"",
+														},
+														&actionExpr{
+															pos: position{line: 246, col: 5, offset: 5529},
+															run: (*parser).callonRankOperandNode438,
+															expr: &seqExpr{
+																pos: position{line: 246, col: 5, offset: 5529},
+																exprs: []any{
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode440,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 317, col: 5, offset: 6774},
+																		run: (*parser).callonRankOperandNode442,
+																		expr: &charClassMatcher{
+																			pos:        position{line: 317, col: 5, offset: 6774},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 256, col: 44, offset: 5719},
+															expr: &seqExpr{
+																pos: position{line: 256, col: 45, offset: 5720},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 256, col: 45, offset: 5720},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 256, col: 49, offset: 5724},
+																		expr: &actionExpr{
+																			pos: position{line: 317, col: 5, offset: 6774},
+																			run: (*parser).callonRankOperandNode448,
+																			expr: &charClassMatcher{
+																				pos:        position{line: 317, col: 5, offset: 6774},
+																				val:        "[0-9]",
+																				ranges:     []rune{'0', '9'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&choiceExpr{
+															pos: position{line: 256, col: 59, offset: 5734},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 256, col: 59, offset: 5734},
+																	val:        "Z",
+																	ignoreCase: false,
+																	want:       "\"Z\"",
+																},
+																&seqExpr{
+																	pos: position{line: 256, col: 65, offset: 5740},
+																	exprs: []any{
+																		&charClassMatcher{
+																			pos:        position{line: 256, col: 66, offset: 5741},
+																			val:        "[+-]",
+																			chars:      []rune{'+', '-'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&actionExpr{
+																			pos: position{line: 236, col: 5, offset: 5397},
+																			run: (*parser).callonRankOperandNode454,
+																			expr: &seqExpr{
+																				pos: position{line: 236, col: 5, offset: 5397},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode456,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode458,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																		&litMatcher{
+																			pos:        position{line: 256, col: 86, offset: 5761},
+																			val:        ":",
+																			ignoreCase: false,
+																			want:       "\":\"",
+																		},
+																		&actionExpr{
+																			pos: position{line: 241, col: 5, offset: 5463},
+																			run: (*parser).callonRankOperandNode461,
+																			expr: &seqExpr{
+																				pos: position{line: 241, col: 5, offset: 5463},
+																				exprs: []any{
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode463,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 317, col: 5, offset: 6774},
+																						run: (*parser).callonRankOperandNode465,
+																						expr: &charClassMatcher{
+																							pos:        position{line: 317, col: 5, offset: 6774},
+																							val:        "[0-9]",
+																							ranges:     []rune{'0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 112, col: 7, offset: 2674},
+									expr: &litMatcher{
+										pos:        position{line: 112, col: 7, offset: 2674},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 115, col: 5, offset: 2750},
+						run: (*parser).callonRankOperandNode469,
+						expr: &seqExpr{
+							pos: position{line: 115, col: 5, offset: 2750},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 115, col: 5, offset: 2750},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 115, col: 7, offset: 2752},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode473,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 116, col: 9, offset: 2768},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonRankOperandNode476,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonRankOperandNode478,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 118, col: 7, offset: 2820},
+									expr: &litMatcher{
+										pos:        position{line: 118, col: 7, offset: 2820},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 118, col: 12, offset: 2825},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 266, col: 5, offset: 5918},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 266, col: 5, offset: 5918},
+												val:        "today",
+												ignoreCase: false,
+												want:       "\"today\"",
+											},
+											&litMatcher{
+												pos:        position{line: 267, col: 5, offset: 5932},
+												val:        "yesterday",
+												ignoreCase: false,
+												want:       "\"yesterday\"",
+											},
+											&litMatcher{
+												pos:        position{line: 268, col: 5, offset: 5950},
+												val:        "this week",
+												ignoreCase: false,
+												want:       "\"this week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 269, col: 5, offset: 5968},
+												val:        "last week",
+												ignoreCase: false,
+												want:       "\"last week\"",
+											},
+											&litMatcher{
+												pos:        position{line: 270, col: 5, offset: 5986},
+												val:        "last 7 days",
+												ignoreCase: false,
+												want:       "\"last 7 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 271, col: 5, offset: 6006},
+												val:        "this month",
+												ignoreCase: false,
+												want:       "\"this month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 272, col: 5, offset: 6025},
+												val:        "last month",
+												ignoreCase: false,
+												want:       "\"last month\"",
+											},
+											&litMatcher{
+												pos:        position{line: 273, col: 5, offset: 6044},
+												val:        "last 30 days",
+												ignoreCase: false,
+												want:       "\"last 30 days\"",
+											},
+											&litMatcher{
+												pos:        position{line: 274, col: 5, offset: 6065},
+												val:        "this year",
+												ignoreCase: false,
+												want:       "\"this year\"",
+											},
+											&actionExpr{
+												pos: position{line: 275, col: 5, offset: 6083},
+												run: (*parser).callonRankOperandNode493,
+												expr: &litMatcher{
+													pos:        position{line: 275, col: 5, offset: 6083},
+													val:        "last year",
+													ignoreCase: false,
+													want:       "\"last year\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 118, col: 38, offset: 2851},
+									expr: &litMatcher{
+										pos:        position{line: 118, col: 38, offset: 2851},
+										val:        "\"",
+										ignoreCase: false,
+										want:       "\"\\\"\"",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 123, col: 5, offset: 2965},
+						run: (*parser).callonRankOperandNode497,
+						expr: &seqExpr{
+							pos: position{line: 123, col: 5, offset: 2965},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 123, col: 5, offset: 2965},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 123, col: 7, offset: 2967},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode501,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 124, col: 9, offset: 2983},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonRankOperandNode504,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonRankOperandNode506,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 126, col: 7, offset: 3035},
+									label: "f",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonRankOperandNode509,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonRankOperandNode512,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonRankOperandNode518,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonRankOperandNode526,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 126, col: 16, offset: 3044},
+									val:        "..",
+									ignoreCase: false,
+									want:       "\"..\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 126, col: 21, offset: 3049},
+									label: "t",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonRankOperandNode530,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonRankOperandNode533,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonRankOperandNode539,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonRankOperandNode547,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 126, col: 30, offset: 3058},
+									expr: &charClassMatcher{
+										pos:        position{line: 126, col: 31, offset: 3059},
+										val:        "[^ ()]",
+										chars:      []rune{' ', '(', ')'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 129, col: 5, offset: 3141},
+						run: (*parser).callonRankOperandNode551,
+						expr: &seqExpr{
+							pos: position{line: 129, col: 5, offset: 3141},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 129, col: 5, offset: 3141},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 129, col: 7, offset: 3143},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode555,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 129, col: 13, offset: 3149},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 130, col: 9, offset: 3161},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 211, col: 5, offset: 4991},
+												run: (*parser).callonRankOperandNode559,
+												expr: &litMatcher{
+													pos:        position{line: 211, col: 5, offset: 4991},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 201, col: 5, offset: 4807},
+												run: (*parser).callonRankOperandNode561,
+												expr: &litMatcher{
+													pos:        position{line: 201, col: 5, offset: 4807},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 206, col: 5, offset: 4896},
+												run: (*parser).callonRankOperandNode563,
+												expr: &litMatcher{
+													pos:        position{line: 206, col: 5, offset: 4896},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 196, col: 5, offset: 4715},
+												run: (*parser).callonRankOperandNode565,
+												expr: &litMatcher{
+													pos:        position{line: 196, col: 5, offset: 4715},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 134, col: 7, offset: 3285},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 284, col: 5, offset: 6272},
+										run: (*parser).callonRankOperandNode568,
+										expr: &seqExpr{
+											pos: position{line: 284, col: 5, offset: 6272},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 284, col: 5, offset: 6272},
+													expr: &actionExpr{
+														pos: position{line: 317, col: 5, offset: 6774},
+														run: (*parser).callonRankOperandNode571,
+														expr: &charClassMatcher{
+															pos:        position{line: 317, col: 5, offset: 6774},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 12, offset: 6279},
+													expr: &seqExpr{
+														pos: position{line: 284, col: 13, offset: 6280},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 284, col: 13, offset: 6280},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 284, col: 17, offset: 6284},
+																expr: &actionExpr{
+																	pos: position{line: 317, col: 5, offset: 6774},
+																	run: (*parser).callonRankOperandNode577,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 317, col: 5, offset: 6774},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 284, col: 26, offset: 6293},
+													expr: &choiceExpr{
+														pos: position{line: 289, col: 5, offset: 6359},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 289, col: 5, offset: 6359},
+																val:        "kb",
+																ignoreCase: true,
+																want:       "\"KB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 290, col: 5, offset: 6371},
+																val:        "mb",
+																ignoreCase: true,
+																want:       "\"MB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 291, col: 5, offset: 6383},
+																val:        "gb",
+																ignoreCase: true,
+																want:       "\"GB\"i",
+															},
+															&litMatcher{
+																pos:        position{line: 292, col: 5, offset: 6395},
+																val:        "tb",
+																ignoreCase: true,
+																want:       "\"TB\"i",
+															},
+															&actionExpr{
+																pos: position{line: 293, col: 5, offset: 6407},
+																run: (*parser).callonRankOperandNode585,
+																expr: &litMatcher{
+																	pos:        position{line: 293, col: 5, offset: 6407},
+																	val:        "b",
+																	ignoreCase: true,
+																	want:       "\"B\"i",
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 134, col: 16, offset: 3294},
+									expr: &charClassMatcher{
+										pos:        position{line: 134, col: 17, offset: 3295},
+										val:        "[^ ()]",
+										chars:      []rune{' ', '(', ')'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 139, col: 5, offset: 3402},
+						run: (*parser).callonRankOperandNode589,
+						expr: &seqExpr{
+							pos: position{line: 139, col: 5, offset: 3402},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 139, col: 5, offset: 3402},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 139, col: 7, offset: 3404},
+										expr: &actionExpr{
+											pos: position{line: 307, col: 5, offset: 6655},
+											run: (*parser).callonRankOperandNode593,
+											expr: &charClassMatcher{
+												pos:        position{line: 307, col: 5, offset: 6655},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 139, col: 14, offset: 3411},
+									alternatives: []any{
+										&actionExpr{
+											pos: position{line: 186, col: 5, offset: 4544},
+											run: (*parser).callonRankOperandNode596,
+											expr: &litMatcher{
+												pos:        position{line: 186, col: 5, offset: 4544},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
+											},
+										},
+										&actionExpr{
+											pos: position{line: 191, col: 5, offset: 4630},
+											run: (*parser).callonRankOperandNode598,
+											expr: &litMatcher{
+												pos:        position{line: 191, col: 5, offset: 4630},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 139, col: 53, offset: 3450},
+									label: "v",
+									expr: &choiceExpr{
+										pos: position{line: 139, col: 56, offset: 3453},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 312, col: 5, offset: 6714},
+												run: (*parser).callonRankOperandNode602,
+												expr: &seqExpr{
+													pos: position{line: 312, col: 5, offset: 6714},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 312, col: 5, offset: 6714},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 312, col: 9, offset: 6718},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 312, col: 11, offset: 6720},
+																expr: &charClassMatcher{
+																	pos:        position{line: 312, col: 11, offset: 6720},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 312, col: 17, offset: 6726},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 139, col: 65, offset: 3462},
+												expr: &charClassMatcher{
+													pos:        position{line: 139, col: 65, offset: 3462},
+													val:        "[^ ()]",
+													chars:      []rune{' ', '(', ')'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 152, col: 6, offset: 3742},
+						run: (*parser).callonRankOperandNode611,
+						expr: &seqExpr{
+							pos: position{line: 152, col: 6, offset: 3742},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 152, col: 6, offset: 3742},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonRankOperandNode614,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonRankOperandNode616,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 152, col: 27, offset: 3763},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 312, col: 5, offset: 6714},
+										run: (*parser).callonRankOperandNode620,
+										expr: &seqExpr{
+											pos: position{line: 312, col: 5, offset: 6714},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 312, col: 5, offset: 6714},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+												&labeledExpr{
+													pos:   position{line: 312, col: 9, offset: 6718},
+													label: "v",
+													expr: &zeroOrMoreExpr{
+														pos: position{line: 312, col: 11, offset: 6720},
+														expr: &charClassMatcher{
+															pos:        position{line: 312, col: 11, offset: 6720},
+															val:        "[^\"]",
+															chars:      []rune{'"'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 312, col: 17, offset: 6726},
+													val:        "\"",
+													ignoreCase: false,
+													want:       "\"\\\"\"",
+												},
+											},
+										},
+									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode282,
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonRankOperandNode627,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 322, col: 5, offset: 6825},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 322, col: 5, offset: 6825},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1488,12 +6813,12 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 91, col: 40, offset: 2273},
+									pos: position{line: 152, col: 38, offset: 3774},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode286,
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonRankOperandNode631,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 186, col: 5, offset: 4544},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1503,87 +6828,82 @@ var g = &grammar{
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "GroupNode",
-			pos:  position{line: 31, col: 1, offset: 595},
-			expr: &actionExpr{
-				pos: position{line: 32, col: 5, offset: 612},
-				run: (*parser).callonGroupNode1,
-				expr: &seqExpr{
-					pos: position{line: 32, col: 5, offset: 612},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 32, col: 5, offset: 612},
-							label: "k",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 32, col: 7, offset: 614},
-								expr: &oneOrMoreExpr{
-									pos: position{line: 32, col: 8, offset: 615},
+					&actionExpr{
+						pos: position{line: 157, col: 6, offset: 3872},
+						run: (*parser).callonRankOperandNode633,
+						expr: &seqExpr{
+							pos: position{line: 157, col: 6, offset: 3872},
+							exprs: []any{
+								&zeroOrOneExpr{
+									pos: position{line: 157, col: 6, offset: 3872},
 									expr: &actionExpr{
-										pos: position{line: 218, col: 5, offset: 4634},
-										run: (*parser).callonGroupNode6,
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonRankOperandNode636,
+										expr: &litMatcher{
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
+											ignoreCase: false,
+											want:       "\":\"",
+										},
+									},
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonRankOperandNode638,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
 										expr: &charClassMatcher{
-											pos:        position{line: 218, col: 5, offset: 4634},
-											val:        "[A-Za-z]",
-											ranges:     []rune{'A', 'Z', 'a', 'z'},
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
 											inverted:   false,
 										},
 									},
 								},
-							},
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 32, col: 16, offset: 623},
-							expr: &choiceExpr{
-								pos: position{line: 32, col: 17, offset: 624},
-								alternatives: []any{
-									&actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonGroupNode10,
-										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
-											val:        ":",
+								&labeledExpr{
+									pos:   position{line: 157, col: 27, offset: 3893},
+									label: "v",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 157, col: 29, offset: 3895},
+										expr: &charClassMatcher{
+											pos:        position{line: 157, col: 29, offset: 3895},
+											val:        "[^ :()]",
+											chars:      []rune{' ', ':', '(', ')'},
 											ignoreCase: false,
-											want:       "\":\"",
+											inverted:   true,
 										},
 									},
-									&actionExpr{
-										pos: position{line: 125, col: 5, offset: 2997},
-										run: (*parser).callonGroupNode12,
+								},
+								&actionExpr{
+									pos: position{line: 322, col: 5, offset: 6825},
+									run: (*parser).callonRankOperandNode644,
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 322, col: 5, offset: 6825},
+										expr: &charClassMatcher{
+											pos:        position{line: 322, col: 5, offset: 6825},
+											val:        "[ \\t]",
+											chars:      []rune{' ', '\t'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 157, col: 40, offset: 3906},
+									expr: &actionExpr{
+										pos: position{line: 186, col: 5, offset: 4544},
+										run: (*parser).callonRankOperandNode648,
 										expr: &litMatcher{
-											pos:        position{line: 125, col: 5, offset: 2997},
-											val:        "=",
+											pos:        position{line: 186, col: 5, offset: 4544},
+											val:        ":",
 											ignoreCase: false,
-											want:       "\"=\"",
+											want:       "\":\"",
 										},
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 32, col: 57, offset: 664},
-							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
-						},
-						&labeledExpr{
-							pos:   position{line: 32, col: 61, offset: 668},
-							label: "v",
-							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 63, offset: 670},
-								name: "Nodes",
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 32, col: 69, offset: 676},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
 					},
 				},
 			},
@@ -1591,1115 +6911,4899 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onAST1(n any) (any, error) {
-	return buildAST(n, c.text, c.pos)
+func (c *current) onAST1(n any) (any, error) {
+	return buildAST(n, c.text, c.pos)
+
+}
+
+func (p *parser) callonAST1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAST1(stack["n"])
+}
+
+func (c *current) onNodes3() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNodes3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNodes3()
+}
+
+func (c *current) onNode7(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode7(stack["v"])
+}
+
+func (c *current) onNode14() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode14()
+}
+
+func (c *current) onNode28() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode28() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode28()
+}
+
+func (c *current) onNode34() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode34() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode34()
+}
+
+func (c *current) onNode38() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode38() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode38()
+}
+
+func (c *current) onNode43() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode43() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode43()
+}
+
+func (c *current) onNode45(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode45() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode45(stack["v"])
+}
+
+func (c *current) onNode25(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode25() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode25(stack["v"])
+}
+
+func (c *current) onNode53(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode53() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode53(stack["v"])
+}
+
+func (c *current) onNode60() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode60() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode60()
+}
+
+func (c *current) onNode3(l, o, d, r any) (any, error) {
+	return buildProximityNode(l, o, d, r, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode3(stack["l"], stack["o"], stack["d"], stack["r"])
+}
+
+func (c *current) onNode68() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode68() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode68()
+}
+
+func (c *current) onNode71() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode71() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode71()
+}
+
+func (c *current) onNode73() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode73() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode73()
+}
+
+func (c *current) onNode64(k, v any) (any, error) {
+	return buildBooleanNode(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode64() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode64(stack["k"], stack["v"])
+}
+
+func (c *current) onNode83() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode83() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode83()
+}
+
+func (c *current) onNode86() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode86() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode86()
+}
+
+func (c *current) onNode88() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode88() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode88()
+}
+
+func (c *current) onNode100() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode100() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode100()
+}
+
+func (c *current) onNode102() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode102() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode102()
+}
+
+func (c *current) onNode104() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode104() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode104()
+}
+
+func (c *current) onNode106() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode106() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode106()
+}
+
+func (c *current) onNode98() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode98() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode98()
+}
+
+func (c *current) onNode111() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode111() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode111()
+}
+
+func (c *current) onNode113() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode113() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode113()
+}
+
+func (c *current) onNode109() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode109() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode109()
+}
+
+func (c *current) onNode118() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode118() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode118()
+}
+
+func (c *current) onNode120() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode120() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode120()
+}
+
+func (c *current) onNode116() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode116() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode116()
+}
+
+func (c *current) onNode96() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode96() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode96()
+}
+
+func (c *current) onNode127() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode127() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode127()
+}
+
+func (c *current) onNode129() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode129() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode129()
+}
+
+func (c *current) onNode125() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode125() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode125()
+}
+
+func (c *current) onNode134() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode134() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode134()
+}
+
+func (c *current) onNode136() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode136() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode136()
+}
+
+func (c *current) onNode132() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode132() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode132()
+}
+
+func (c *current) onNode141() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode141() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode141()
+}
+
+func (c *current) onNode143() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode143() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode143()
+}
+
+func (c *current) onNode139() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode139() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode139()
+}
+
+func (c *current) onNode149() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode149() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode149()
+}
+
+func (c *current) onNode157() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode157() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode157()
+}
+
+func (c *current) onNode159() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode159() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode159()
+}
+
+func (c *current) onNode155() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode155() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode155()
+}
+
+func (c *current) onNode164() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode164() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode164()
+}
+
+func (c *current) onNode166() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode166() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode166()
+}
+
+func (c *current) onNode162() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode162() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode162()
+}
+
+func (c *current) onNode123() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode123() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode123()
+}
+
+func (c *current) onNode94() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode94() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode94()
+}
+
+func (c *current) onNode172() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode172() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode172()
+}
+
+func (c *current) onNode174() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode174() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode174()
+}
+
+func (c *current) onNode176() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode176() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode176()
+}
+
+func (c *current) onNode178() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode178() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode178()
+}
+
+func (c *current) onNode170() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode170() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode170()
+}
+
+func (c *current) onNode183() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode183() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode183()
+}
+
+func (c *current) onNode185() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode185() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode185()
+}
+
+func (c *current) onNode181() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode181() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode181()
+}
+
+func (c *current) onNode190() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode190() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode190()
+}
+
+func (c *current) onNode192() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode192() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode192()
+}
+
+func (c *current) onNode188() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode188() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode188()
+}
+
+func (c *current) onNode168() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode168() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode168()
+}
+
+func (c *current) onNode203() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode203() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode203()
+}
+
+func (c *current) onNode205() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode205() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode205()
+}
+
+func (c *current) onNode207() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode207() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode207()
+}
+
+func (c *current) onNode209() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode209() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode209()
+}
+
+func (c *current) onNode201() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode201() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode201()
+}
+
+func (c *current) onNode214() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode214() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode214()
+}
+
+func (c *current) onNode216() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode216() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode216()
+}
+
+func (c *current) onNode212() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode212() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode212()
+}
+
+func (c *current) onNode221() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode221() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode221()
+}
+
+func (c *current) onNode223() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode223() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode223()
+}
+
+func (c *current) onNode219() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode219() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode219()
+}
+
+func (c *current) onNode199() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode199() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode199()
+}
+
+func (c *current) onNode230() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode230() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode230()
+}
+
+func (c *current) onNode232() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode232() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode232()
+}
+
+func (c *current) onNode228() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode228() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode228()
+}
+
+func (c *current) onNode237() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode237() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode237()
+}
+
+func (c *current) onNode239() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode239() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode239()
+}
+
+func (c *current) onNode235() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode235() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode235()
+}
+
+func (c *current) onNode244() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode244() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode244()
+}
+
+func (c *current) onNode246() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode246() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode246()
+}
+
+func (c *current) onNode242() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode242() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode242()
+}
+
+func (c *current) onNode252() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode252() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode252()
+}
+
+func (c *current) onNode260() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode260() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode260()
+}
+
+func (c *current) onNode262() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode262() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode262()
+}
+
+func (c *current) onNode258() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode258() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode258()
+}
+
+func (c *current) onNode267() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode267() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode267()
+}
+
+func (c *current) onNode269() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode269() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode269()
+}
+
+func (c *current) onNode265() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode265() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode265()
+}
+
+func (c *current) onNode226() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode226() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode226()
+}
+
+func (c *current) onNode197() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode197() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode197()
+}
+
+func (c *current) onNode275() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode275() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode275()
+}
+
+func (c *current) onNode277() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode277() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode277()
+}
+
+func (c *current) onNode279() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode279() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode279()
+}
+
+func (c *current) onNode281() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode281() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode281()
+}
+
+func (c *current) onNode273() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode273() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode273()
+}
+
+func (c *current) onNode286() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode286() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode286()
+}
+
+func (c *current) onNode288() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode288() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode288()
+}
+
+func (c *current) onNode284() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode284() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode284()
+}
+
+func (c *current) onNode293() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode293() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode293()
+}
+
+func (c *current) onNode295() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode295() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode295()
+}
+
+func (c *current) onNode291() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode291() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode291()
+}
+
+func (c *current) onNode271() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode271() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode271()
+}
+
+func (c *current) onNode79(k, f, t any) (any, error) {
+	return buildDateTimeRangeNode(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode79() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode79(stack["k"], stack["f"], stack["t"])
+}
+
+func (c *current) onNode303() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode303() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode303()
+}
+
+func (c *current) onNode307() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode307() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode307()
+}
+
+func (c *current) onNode309() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode309() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode309()
+}
+
+func (c *current) onNode311() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode311() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode311()
+}
+
+func (c *current) onNode313() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode313() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode313()
+}
+
+func (c *current) onNode315() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode315() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode315()
+}
+
+func (c *current) onNode317() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode317() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode317()
+}
+
+func (c *current) onNode329() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode329() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode329()
+}
+
+func (c *current) onNode331() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode331() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode331()
+}
+
+func (c *current) onNode333() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode333() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode333()
+}
+
+func (c *current) onNode335() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode335() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode335()
+}
+
+func (c *current) onNode327() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode327() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode327()
+}
+
+func (c *current) onNode340() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode340() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode340()
+}
+
+func (c *current) onNode342() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode342() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode342()
+}
+
+func (c *current) onNode338() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode338() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode338()
+}
+
+func (c *current) onNode347() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode347() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode347()
+}
+
+func (c *current) onNode349() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode349() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode349()
+}
+
+func (c *current) onNode345() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode345() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode345()
+}
+
+func (c *current) onNode325() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode325() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode325()
+}
+
+func (c *current) onNode356() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode356() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode356()
+}
+
+func (c *current) onNode358() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode358() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode358()
+}
+
+func (c *current) onNode354() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode354() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode354()
+}
+
+func (c *current) onNode363() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode363() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode363()
+}
+
+func (c *current) onNode365() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode365() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode365()
+}
+
+func (c *current) onNode361() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode361() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode361()
+}
+
+func (c *current) onNode370() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode370() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode370()
+}
+
+func (c *current) onNode372() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode372() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode372()
+}
+
+func (c *current) onNode368() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode368() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode368()
+}
+
+func (c *current) onNode378() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode378() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode378()
+}
+
+func (c *current) onNode386() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode386() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode386()
+}
+
+func (c *current) onNode388() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode388() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode388()
+}
+
+func (c *current) onNode384() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode384() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode384()
+}
+
+func (c *current) onNode393() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode393() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode393()
+}
+
+func (c *current) onNode395() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode395() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode395()
+}
+
+func (c *current) onNode391() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode391() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode391()
+}
+
+func (c *current) onNode352() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode352() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode352()
+}
+
+func (c *current) onNode323() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode323() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode323()
+}
+
+func (c *current) onNode401() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode401() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode401()
+}
+
+func (c *current) onNode403() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode403() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode403()
+}
+
+func (c *current) onNode405() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode405() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode405()
+}
+
+func (c *current) onNode407() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode407() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode407()
+}
+
+func (c *current) onNode399() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode399() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode399()
+}
+
+func (c *current) onNode412() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode412() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode412()
+}
+
+func (c *current) onNode414() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode414() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode414()
+}
+
+func (c *current) onNode410() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode410() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode410()
+}
+
+func (c *current) onNode419() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode419() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode419()
+}
+
+func (c *current) onNode421() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode421() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode421()
+}
+
+func (c *current) onNode417() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode417() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode417()
+}
+
+func (c *current) onNode397() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode397() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode397()
+}
+
+func (c *current) onNode427() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode427() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode427()
+}
+
+func (c *current) onNode429() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode429() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode429()
+}
+
+func (c *current) onNode425() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode425() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode425()
+}
+
+func (c *current) onNode434() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode434() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode434()
+}
+
+func (c *current) onNode436() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode436() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode436()
+}
+
+func (c *current) onNode432() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode432() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode432()
+}
+
+func (c *current) onNode441() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode441() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode441()
+}
+
+func (c *current) onNode443() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode443() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode443()
+}
+
+func (c *current) onNode439() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode439() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode439()
+}
+
+func (c *current) onNode449() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode449() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode449()
+}
+
+func (c *current) onNode457() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode457() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode457()
+}
+
+func (c *current) onNode459() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode459() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode459()
+}
+
+func (c *current) onNode455() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode455() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode455()
+}
+
+func (c *current) onNode464() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode464() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode464()
+}
+
+func (c *current) onNode466() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode466() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode466()
+}
+
+func (c *current) onNode462() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode462() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode462()
+}
+
+func (c *current) onNode423() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode423() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode423()
+}
+
+func (c *current) onNode299(k, o, v any) (any, error) {
+	return buildDateTimeNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode299() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode299(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onNode474() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode474() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode474()
+}
+
+func (c *current) onNode477() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode477() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode477()
+}
+
+func (c *current) onNode479() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode479() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode479()
+}
+
+func (c *current) onNode494() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode494() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode494()
+}
+
+func (c *current) onNode470(k, v any) (any, error) {
+	return buildNaturalLanguageDateTimeNodes(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode470() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode470(stack["k"], stack["v"])
+}
+
+func (c *current) onNode502() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode502() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode502()
+}
+
+func (c *current) onNode505() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode505() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode505()
+}
+
+func (c *current) onNode507() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode507() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode507()
+}
+
+func (c *current) onNode513() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode513() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode513()
+}
+
+func (c *current) onNode519() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode519() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode519()
+}
+
+func (c *current) onNode527() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode527() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode527()
+}
+
+func (c *current) onNode510() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode510() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode510()
+}
+
+func (c *current) onNode534() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode534() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode534()
+}
+
+func (c *current) onNode540() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode540() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode540()
+}
+
+func (c *current) onNode548() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode548() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode548()
+}
+
+func (c *current) onNode531() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode531() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode531()
+}
+
+func (c *current) onNode498(k, f, t any) (any, error) {
+	return buildNumericRangeNode(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode498() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode498(stack["k"], stack["f"], stack["t"])
+}
+
+func (c *current) onNode556() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode556() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode556()
+}
+
+func (c *current) onNode560() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode560() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode560()
+}
+
+func (c *current) onNode562() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode562() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode562()
+}
+
+func (c *current) onNode564() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode564() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode564()
+}
+
+func (c *current) onNode566() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode566() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode566()
+}
+
+func (c *current) onNode572() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode572() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode572()
+}
+
+func (c *current) onNode578() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode578() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode578()
+}
+
+func (c *current) onNode586() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode586() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode586()
+}
+
+func (c *current) onNode569() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode569() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode569()
+}
+
+func (c *current) onNode552(k, o, v any) (any, error) {
+	return buildNumericNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode552() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode552(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onNode594() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode594() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode594()
+}
+
+func (c *current) onNode597() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode597() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode597()
+}
+
+func (c *current) onNode599() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode599() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode599()
+}
+
+func (c *current) onNode603(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode603() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode603(stack["v"])
+}
+
+func (c *current) onNode590(k, v any) (any, error) {
+	return buildStringNode(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode590() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode590(stack["k"], stack["v"])
+}
+
+func (c *current) onNode612() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode612() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode612()
+}
+
+func (c *current) onNode616() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode616() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode616()
+}
+
+func (c *current) onNode620() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode620() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode620()
+}
+
+func (c *current) onNode625() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode625() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode625()
+}
+
+func (c *current) onNode627() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode627() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode627()
+}
+
+func (c *current) onNode631(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode631() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode631(stack["v"])
+}
+
+func (c *current) onNode638(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode638() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode638(stack["v"])
+}
+
+func (c *current) onNode642() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode642() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode642()
+}
+
+func (c *current) onNode622(v any) (any, error) {
+	return buildStringNode("", v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode622() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode622(stack["v"])
+}
+
+func (c *current) onNode647() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode647() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode647()
+}
+
+func (c *current) onNode649() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode649() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode649()
+}
+
+func (c *current) onNode655(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode655() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode655(stack["v"])
+}
+
+func (c *current) onNode659() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode659() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode659()
+}
+
+func (c *current) onNode644(v any) (any, error) {
+	return buildStringNode("", v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode644() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode644(stack["v"])
+}
+
+func (c *current) onGroupNode6() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonGroupNode6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupNode6()
+}
+
+func (c *current) onGroupNode10() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonGroupNode10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupNode10()
+}
+
+func (c *current) onGroupNode12() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonGroupNode12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupNode12()
+}
+
+func (c *current) onGroupNode1(k, v any) (any, error) {
+	return buildGroupNode(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonGroupNode1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupNode1(stack["k"], stack["v"])
+}
+
+func (c *current) onRankNode5(m any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode5(stack["m"])
+}
+
+func (c *current) onRankNode9(m any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode9(stack["m"])
+}
+
+func (c *current) onRankNode13(m any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode13(stack["m"])
+}
+
+func (c *current) onRankNode17(m any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode17(stack["m"])
+}
+
+func (c *current) onRankNode24() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankNode24() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode24()
+}
+
+func (c *current) onRankNode30() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankNode30() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode30()
+}
+
+func (c *current) onRankNode21() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankNode21() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode21()
+}
+
+func (c *current) onRankNode32(m, b any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode32() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode32(stack["m"], stack["b"])
+}
+
+func (c *current) onRankNode36(m, b any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankNode36() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode36(stack["m"], stack["b"])
+}
+
+func (c *current) onRankNode1(m, b, r any) (any, error) {
+	return buildRankNode(m, b, r, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankNode1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankNode1(stack["m"], stack["b"], stack["r"])
+}
+
+func (c *current) onRankOperandNode6(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonRankOperandNode6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode6(stack["v"])
+}
+
+func (c *current) onRankOperandNode13() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode13() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode13()
+}
+
+func (c *current) onRankOperandNode27() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankOperandNode27() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode27()
+}
+
+func (c *current) onRankOperandNode33() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankOperandNode33() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode33()
+}
+
+func (c *current) onRankOperandNode37() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankOperandNode37() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode37()
+}
+
+func (c *current) onRankOperandNode42() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode42() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode42()
+}
+
+func (c *current) onRankOperandNode44(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankOperandNode44() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode44(stack["v"])
+}
+
+func (c *current) onRankOperandNode24(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonRankOperandNode24() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode24(stack["v"])
+}
+
+func (c *current) onRankOperandNode52(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonRankOperandNode52() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode52(stack["v"])
+}
+
+func (c *current) onRankOperandNode59() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode59() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode59()
+}
+
+func (c *current) onRankOperandNode2(l, o, d, r any) (any, error) {
+	return buildProximityNode(l, o, d, r, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode2(stack["l"], stack["o"], stack["d"], stack["r"])
+}
+
+func (c *current) onRankOperandNode67() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode67() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode67()
+}
+
+func (c *current) onRankOperandNode70() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode70() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode70()
+}
+
+func (c *current) onRankOperandNode72() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode72() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode72()
+}
+
+func (c *current) onRankOperandNode63(k, v any) (any, error) {
+	return buildBooleanNode(k, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode63() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode63(stack["k"], stack["v"])
+}
+
+func (c *current) onRankOperandNode82() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode82() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode82()
+}
+
+func (c *current) onRankOperandNode85() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode85() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode85()
+}
+
+func (c *current) onRankOperandNode87() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode87() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode87()
+}
+
+func (c *current) onRankOperandNode99() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode99() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode99()
+}
+
+func (c *current) onRankOperandNode101() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode101() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode101()
+}
+
+func (c *current) onRankOperandNode103() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode103() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode103()
+}
+
+func (c *current) onRankOperandNode105() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode105() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode105()
+}
+
+func (c *current) onRankOperandNode97() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode97() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode97()
+}
+
+func (c *current) onRankOperandNode110() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode110() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode110()
+}
+
+func (c *current) onRankOperandNode112() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode112() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode112()
+}
+
+func (c *current) onRankOperandNode108() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode108() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode108()
+}
+
+func (c *current) onRankOperandNode117() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode117() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode117()
+}
+
+func (c *current) onRankOperandNode119() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode119() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode119()
+}
+
+func (c *current) onRankOperandNode115() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode115() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode115()
+}
+
+func (c *current) onRankOperandNode95() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode95() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode95()
+}
+
+func (c *current) onRankOperandNode126() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode126() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode126()
+}
+
+func (c *current) onRankOperandNode128() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode128() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode128()
+}
+
+func (c *current) onRankOperandNode124() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode124() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode124()
+}
+
+func (c *current) onRankOperandNode133() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode133() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode133()
+}
+
+func (c *current) onRankOperandNode135() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode135() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode135()
+}
+
+func (c *current) onRankOperandNode131() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode131() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode131()
+}
+
+func (c *current) onRankOperandNode140() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode140() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode140()
+}
+
+func (c *current) onRankOperandNode142() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode142() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode142()
+}
+
+func (c *current) onRankOperandNode138() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode138() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode138()
+}
+
+func (c *current) onRankOperandNode148() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode148() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode148()
+}
+
+func (c *current) onRankOperandNode156() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode156() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode156()
+}
+
+func (c *current) onRankOperandNode158() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode158() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode158()
+}
+
+func (c *current) onRankOperandNode154() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode154() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode154()
+}
+
+func (c *current) onRankOperandNode163() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode163() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode163()
+}
+
+func (c *current) onRankOperandNode165() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode165() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode165()
+}
+
+func (c *current) onRankOperandNode161() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode161() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode161()
+}
+
+func (c *current) onRankOperandNode122() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode122() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode122()
+}
+
+func (c *current) onRankOperandNode93() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode93() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode93()
+}
+
+func (c *current) onRankOperandNode171() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode171() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode171()
+}
+
+func (c *current) onRankOperandNode173() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode173() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode173()
+}
+
+func (c *current) onRankOperandNode175() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode175() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode175()
+}
+
+func (c *current) onRankOperandNode177() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode177() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode177()
+}
+
+func (c *current) onRankOperandNode169() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode169() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode169()
+}
+
+func (c *current) onRankOperandNode182() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode182() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode182()
+}
+
+func (c *current) onRankOperandNode184() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode184() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode184()
+}
+
+func (c *current) onRankOperandNode180() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode180() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode180()
+}
+
+func (c *current) onRankOperandNode189() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode189() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode189()
+}
+
+func (c *current) onRankOperandNode191() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode191() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode191()
+}
+
+func (c *current) onRankOperandNode187() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode187() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode187()
+}
+
+func (c *current) onRankOperandNode167() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode167() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode167()
+}
+
+func (c *current) onRankOperandNode202() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode202() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode202()
+}
+
+func (c *current) onRankOperandNode204() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode204() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode204()
+}
+
+func (c *current) onRankOperandNode206() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode206() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode206()
+}
+
+func (c *current) onRankOperandNode208() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode208() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode208()
+}
+
+func (c *current) onRankOperandNode200() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode200() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode200()
+}
+
+func (c *current) onRankOperandNode213() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode213() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode213()
+}
+
+func (c *current) onRankOperandNode215() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode215() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode215()
+}
+
+func (c *current) onRankOperandNode211() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode211() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode211()
+}
+
+func (c *current) onRankOperandNode220() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode220() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode220()
+}
+
+func (c *current) onRankOperandNode222() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode222() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode222()
+}
+
+func (c *current) onRankOperandNode218() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode218() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode218()
+}
+
+func (c *current) onRankOperandNode198() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode198() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode198()
+}
+
+func (c *current) onRankOperandNode229() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode229() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode229()
+}
+
+func (c *current) onRankOperandNode231() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode231() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode231()
+}
+
+func (c *current) onRankOperandNode227() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode227() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode227()
+}
+
+func (c *current) onRankOperandNode236() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode236() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode236()
+}
+
+func (c *current) onRankOperandNode238() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode238() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode238()
+}
+
+func (c *current) onRankOperandNode234() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode234() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode234()
+}
+
+func (c *current) onRankOperandNode243() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode243() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode243()
+}
+
+func (c *current) onRankOperandNode245() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode245() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode245()
+}
+
+func (c *current) onRankOperandNode241() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode241() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode241()
+}
+
+func (c *current) onRankOperandNode251() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode251() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode251()
+}
+
+func (c *current) onRankOperandNode259() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode259() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode259()
+}
+
+func (c *current) onRankOperandNode261() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode261() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode261()
+}
+
+func (c *current) onRankOperandNode257() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode257() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode257()
+}
+
+func (c *current) onRankOperandNode266() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode266() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode266()
+}
+
+func (c *current) onRankOperandNode268() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode268() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode268()
+}
+
+func (c *current) onRankOperandNode264() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode264() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode264()
+}
+
+func (c *current) onRankOperandNode225() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode225() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode225()
+}
+
+func (c *current) onRankOperandNode196() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode196() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode196()
+}
+
+func (c *current) onRankOperandNode274() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode274() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode274()
+}
+
+func (c *current) onRankOperandNode276() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode276() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode276()
+}
+
+func (c *current) onRankOperandNode278() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode278() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode278()
+}
+
+func (c *current) onRankOperandNode280() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode280() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode280()
+}
+
+func (c *current) onRankOperandNode272() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode272() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode272()
+}
+
+func (c *current) onRankOperandNode285() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode285() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode285()
+}
+
+func (c *current) onRankOperandNode287() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonAST1() (any, error) {
+func (p *parser) callonRankOperandNode287() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAST1(stack["n"])
+	return p.cur.onRankOperandNode287()
 }
 
-func (c *current) onNodes3() (any, error) {
-	return nil, nil
+func (c *current) onRankOperandNode283() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNodes3() (any, error) {
+func (p *parser) callonRankOperandNode283() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNodes3()
+	return p.cur.onRankOperandNode283()
 }
 
-func (c *current) onNode7() (any, error) {
+func (c *current) onRankOperandNode292() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode7() (any, error) {
+func (p *parser) callonRankOperandNode292() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode7()
+	return p.cur.onRankOperandNode292()
 }
 
-func (c *current) onNode10() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onRankOperandNode294() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode10() (any, error) {
+func (p *parser) callonRankOperandNode294() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode10()
+	return p.cur.onRankOperandNode294()
 }
 
-func (c *current) onNode12() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onRankOperandNode290() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode12() (any, error) {
+func (p *parser) callonRankOperandNode290() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode12()
+	return p.cur.onRankOperandNode290()
 }
 
-func (c *current) onNode3(k, v any) (any, error) {
-	return buildBooleanNode(k, v, c.text, c.pos)
+func (c *current) onRankOperandNode270() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode3() (any, error) {
+func (p *parser) callonRankOperandNode270() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode270()
+}
+
+func (c *current) onRankOperandNode78(k, f, t any) (any, error) {
+	return buildDateTimeRangeNode(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode78() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode3(stack["k"], stack["v"])
+	return p.cur.onRankOperandNode78(stack["k"], stack["f"], stack["t"])
 }
 
-func (c *current) onNode22() (any, error) {
+func (c *current) onRankOperandNode302() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode22() (any, error) {
+func (p *parser) callonRankOperandNode302() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode22()
+	return p.cur.onRankOperandNode302()
 }
 
-func (c *current) onNode26() (any, error) {
+func (c *current) onRankOperandNode306() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode26() (any, error) {
+func (p *parser) callonRankOperandNode306() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode26()
+	return p.cur.onRankOperandNode306()
 }
 
-func (c *current) onNode28() (any, error) {
+func (c *current) onRankOperandNode308() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode28() (any, error) {
+func (p *parser) callonRankOperandNode308() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode28()
+	return p.cur.onRankOperandNode308()
 }
 
-func (c *current) onNode30() (any, error) {
+func (c *current) onRankOperandNode310() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode30() (any, error) {
+func (p *parser) callonRankOperandNode310() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode30()
+	return p.cur.onRankOperandNode310()
 }
 
-func (c *current) onNode32() (any, error) {
+func (c *current) onRankOperandNode312() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode32() (any, error) {
+func (p *parser) callonRankOperandNode312() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode32()
+	return p.cur.onRankOperandNode312()
 }
 
-func (c *current) onNode34() (any, error) {
+func (c *current) onRankOperandNode314() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode34() (any, error) {
+func (p *parser) callonRankOperandNode314() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode34()
+	return p.cur.onRankOperandNode314()
 }
 
-func (c *current) onNode36() (any, error) {
+func (c *current) onRankOperandNode316() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode36() (any, error) {
+func (p *parser) callonRankOperandNode316() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode36()
+	return p.cur.onRankOperandNode316()
 }
 
-func (c *current) onNode48() (any, error) {
+func (c *current) onRankOperandNode328() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode48() (any, error) {
+func (p *parser) callonRankOperandNode328() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode48()
+	return p.cur.onRankOperandNode328()
 }
 
-func (c *current) onNode50() (any, error) {
+func (c *current) onRankOperandNode330() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode50() (any, error) {
+func (p *parser) callonRankOperandNode330() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode50()
+	return p.cur.onRankOperandNode330()
 }
 
-func (c *current) onNode52() (any, error) {
+func (c *current) onRankOperandNode332() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode52() (any, error) {
+func (p *parser) callonRankOperandNode332() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode52()
+	return p.cur.onRankOperandNode332()
 }
 
-func (c *current) onNode54() (any, error) {
+func (c *current) onRankOperandNode334() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode54() (any, error) {
+func (p *parser) callonRankOperandNode334() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode54()
+	return p.cur.onRankOperandNode334()
 }
 
-func (c *current) onNode46() (any, error) {
+func (c *current) onRankOperandNode326() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode46() (any, error) {
+func (p *parser) callonRankOperandNode326() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode46()
+	return p.cur.onRankOperandNode326()
 }
 
-func (c *current) onNode59() (any, error) {
+func (c *current) onRankOperandNode339() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode59() (any, error) {
+func (p *parser) callonRankOperandNode339() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode59()
+	return p.cur.onRankOperandNode339()
 }
 
-func (c *current) onNode61() (any, error) {
+func (c *current) onRankOperandNode341() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode61() (any, error) {
+func (p *parser) callonRankOperandNode341() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode61()
+	return p.cur.onRankOperandNode341()
 }
 
-func (c *current) onNode57() (any, error) {
+func (c *current) onRankOperandNode337() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode57() (any, error) {
+func (p *parser) callonRankOperandNode337() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode57()
+	return p.cur.onRankOperandNode337()
 }
 
-func (c *current) onNode66() (any, error) {
+func (c *current) onRankOperandNode346() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode66() (any, error) {
+func (p *parser) callonRankOperandNode346() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode66()
+	return p.cur.onRankOperandNode346()
 }
 
-func (c *current) onNode68() (any, error) {
+func (c *current) onRankOperandNode348() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode68() (any, error) {
+func (p *parser) callonRankOperandNode348() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode68()
+	return p.cur.onRankOperandNode348()
 }
 
-func (c *current) onNode64() (any, error) {
+func (c *current) onRankOperandNode344() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode64() (any, error) {
+func (p *parser) callonRankOperandNode344() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode64()
+	return p.cur.onRankOperandNode344()
 }
 
-func (c *current) onNode44() (any, error) {
+func (c *current) onRankOperandNode324() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode44() (any, error) {
+func (p *parser) callonRankOperandNode324() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode44()
+	return p.cur.onRankOperandNode324()
 }
 
-func (c *current) onNode75() (any, error) {
+func (c *current) onRankOperandNode355() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode75() (any, error) {
+func (p *parser) callonRankOperandNode355() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode75()
+	return p.cur.onRankOperandNode355()
 }
 
-func (c *current) onNode77() (any, error) {
+func (c *current) onRankOperandNode357() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode77() (any, error) {
+func (p *parser) callonRankOperandNode357() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode77()
+	return p.cur.onRankOperandNode357()
 }
 
-func (c *current) onNode73() (any, error) {
+func (c *current) onRankOperandNode353() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode73() (any, error) {
+func (p *parser) callonRankOperandNode353() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode73()
+	return p.cur.onRankOperandNode353()
 }
 
-func (c *current) onNode82() (any, error) {
+func (c *current) onRankOperandNode362() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode82() (any, error) {
+func (p *parser) callonRankOperandNode362() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode82()
+	return p.cur.onRankOperandNode362()
 }
 
-func (c *current) onNode84() (any, error) {
+func (c *current) onRankOperandNode364() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode84() (any, error) {
+func (p *parser) callonRankOperandNode364() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode84()
+	return p.cur.onRankOperandNode364()
 }
 
-func (c *current) onNode80() (any, error) {
+func (c *current) onRankOperandNode360() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode80() (any, error) {
+func (p *parser) callonRankOperandNode360() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode80()
+	return p.cur.onRankOperandNode360()
 }
 
-func (c *current) onNode89() (any, error) {
+func (c *current) onRankOperandNode369() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode89() (any, error) {
+func (p *parser) callonRankOperandNode369() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode89()
+	return p.cur.onRankOperandNode369()
 }
 
-func (c *current) onNode91() (any, error) {
+func (c *current) onRankOperandNode371() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode91() (any, error) {
+func (p *parser) callonRankOperandNode371() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode91()
+	return p.cur.onRankOperandNode371()
 }
 
-func (c *current) onNode87() (any, error) {
+func (c *current) onRankOperandNode367() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode87() (any, error) {
+func (p *parser) callonRankOperandNode367() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode87()
+	return p.cur.onRankOperandNode367()
 }
 
-func (c *current) onNode97() (any, error) {
+func (c *current) onRankOperandNode377() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode97() (any, error) {
+func (p *parser) callonRankOperandNode377() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode97()
+	return p.cur.onRankOperandNode377()
 }
 
-func (c *current) onNode105() (any, error) {
+func (c *current) onRankOperandNode385() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode105() (any, error) {
+func (p *parser) callonRankOperandNode385() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode105()
+	return p.cur.onRankOperandNode385()
 }
 
-func (c *current) onNode107() (any, error) {
+func (c *current) onRankOperandNode387() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode107() (any, error) {
+func (p *parser) callonRankOperandNode387() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode107()
+	return p.cur.onRankOperandNode387()
 }
 
-func (c *current) onNode103() (any, error) {
+func (c *current) onRankOperandNode383() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode103() (any, error) {
+func (p *parser) callonRankOperandNode383() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode103()
+	return p.cur.onRankOperandNode383()
 }
 
-func (c *current) onNode112() (any, error) {
+func (c *current) onRankOperandNode392() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode112() (any, error) {
+func (p *parser) callonRankOperandNode392() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode112()
+	return p.cur.onRankOperandNode392()
 }
 
-func (c *current) onNode114() (any, error) {
+func (c *current) onRankOperandNode394() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode114() (any, error) {
+func (p *parser) callonRankOperandNode394() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode114()
+	return p.cur.onRankOperandNode394()
 }
 
-func (c *current) onNode110() (any, error) {
+func (c *current) onRankOperandNode390() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode110() (any, error) {
+func (p *parser) callonRankOperandNode390() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode110()
+	return p.cur.onRankOperandNode390()
 }
 
-func (c *current) onNode71() (any, error) {
+func (c *current) onRankOperandNode351() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode71() (any, error) {
+func (p *parser) callonRankOperandNode351() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode71()
+	return p.cur.onRankOperandNode351()
 }
 
-func (c *current) onNode42() (any, error) {
+func (c *current) onRankOperandNode322() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode42() (any, error) {
+func (p *parser) callonRankOperandNode322() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode42()
+	return p.cur.onRankOperandNode322()
 }
 
-func (c *current) onNode120() (any, error) {
+func (c *current) onRankOperandNode400() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode120() (any, error) {
+func (p *parser) callonRankOperandNode400() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode120()
+	return p.cur.onRankOperandNode400()
 }
 
-func (c *current) onNode122() (any, error) {
+func (c *current) onRankOperandNode402() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode122() (any, error) {
+func (p *parser) callonRankOperandNode402() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode122()
+	return p.cur.onRankOperandNode402()
 }
 
-func (c *current) onNode124() (any, error) {
+func (c *current) onRankOperandNode404() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode124() (any, error) {
+func (p *parser) callonRankOperandNode404() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode124()
+	return p.cur.onRankOperandNode404()
 }
 
-func (c *current) onNode126() (any, error) {
+func (c *current) onRankOperandNode406() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode126() (any, error) {
+func (p *parser) callonRankOperandNode406() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode126()
+	return p.cur.onRankOperandNode406()
 }
 
-func (c *current) onNode118() (any, error) {
+func (c *current) onRankOperandNode398() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode118() (any, error) {
+func (p *parser) callonRankOperandNode398() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode118()
+	return p.cur.onRankOperandNode398()
 }
 
-func (c *current) onNode131() (any, error) {
+func (c *current) onRankOperandNode411() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode131() (any, error) {
+func (p *parser) callonRankOperandNode411() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode131()
+	return p.cur.onRankOperandNode411()
 }
 
-func (c *current) onNode133() (any, error) {
+func (c *current) onRankOperandNode413() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode133() (any, error) {
+func (p *parser) callonRankOperandNode413() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode133()
+	return p.cur.onRankOperandNode413()
 }
 
-func (c *current) onNode129() (any, error) {
+func (c *current) onRankOperandNode409() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode129() (any, error) {
+func (p *parser) callonRankOperandNode409() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode129()
+	return p.cur.onRankOperandNode409()
 }
 
-func (c *current) onNode138() (any, error) {
+func (c *current) onRankOperandNode418() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode138() (any, error) {
+func (p *parser) callonRankOperandNode418() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode138()
+	return p.cur.onRankOperandNode418()
 }
 
-func (c *current) onNode140() (any, error) {
+func (c *current) onRankOperandNode420() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode140() (any, error) {
+func (p *parser) callonRankOperandNode420() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode140()
+	return p.cur.onRankOperandNode420()
 }
 
-func (c *current) onNode136() (any, error) {
+func (c *current) onRankOperandNode416() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode136() (any, error) {
+func (p *parser) callonRankOperandNode416() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode136()
+	return p.cur.onRankOperandNode416()
 }
 
-func (c *current) onNode116() (any, error) {
+func (c *current) onRankOperandNode396() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode116() (any, error) {
+func (p *parser) callonRankOperandNode396() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode116()
+	return p.cur.onRankOperandNode396()
 }
 
-func (c *current) onNode146() (any, error) {
+func (c *current) onRankOperandNode426() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode146() (any, error) {
+func (p *parser) callonRankOperandNode426() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode146()
+	return p.cur.onRankOperandNode426()
 }
 
-func (c *current) onNode148() (any, error) {
+func (c *current) onRankOperandNode428() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode148() (any, error) {
+func (p *parser) callonRankOperandNode428() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode148()
+	return p.cur.onRankOperandNode428()
 }
 
-func (c *current) onNode144() (any, error) {
+func (c *current) onRankOperandNode424() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode144() (any, error) {
+func (p *parser) callonRankOperandNode424() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode144()
+	return p.cur.onRankOperandNode424()
 }
 
-func (c *current) onNode153() (any, error) {
+func (c *current) onRankOperandNode433() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode153() (any, error) {
+func (p *parser) callonRankOperandNode433() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode153()
+	return p.cur.onRankOperandNode433()
 }
 
-func (c *current) onNode155() (any, error) {
+func (c *current) onRankOperandNode435() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode155() (any, error) {
+func (p *parser) callonRankOperandNode435() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode155()
+	return p.cur.onRankOperandNode435()
 }
 
-func (c *current) onNode151() (any, error) {
+func (c *current) onRankOperandNode431() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode151() (any, error) {
+func (p *parser) callonRankOperandNode431() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode151()
+	return p.cur.onRankOperandNode431()
 }
 
-func (c *current) onNode160() (any, error) {
+func (c *current) onRankOperandNode440() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode160() (any, error) {
+func (p *parser) callonRankOperandNode440() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode160()
+	return p.cur.onRankOperandNode440()
 }
 
-func (c *current) onNode162() (any, error) {
+func (c *current) onRankOperandNode442() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode162() (any, error) {
+func (p *parser) callonRankOperandNode442() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode162()
+	return p.cur.onRankOperandNode442()
 }
 
-func (c *current) onNode158() (any, error) {
+func (c *current) onRankOperandNode438() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode158() (any, error) {
+func (p *parser) callonRankOperandNode438() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode158()
+	return p.cur.onRankOperandNode438()
 }
 
-func (c *current) onNode168() (any, error) {
+func (c *current) onRankOperandNode448() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode168() (any, error) {
+func (p *parser) callonRankOperandNode448() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode168()
+	return p.cur.onRankOperandNode448()
 }
 
-func (c *current) onNode176() (any, error) {
+func (c *current) onRankOperandNode456() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode176() (any, error) {
+func (p *parser) callonRankOperandNode456() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode176()
+	return p.cur.onRankOperandNode456()
 }
 
-func (c *current) onNode178() (any, error) {
+func (c *current) onRankOperandNode458() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode178() (any, error) {
+func (p *parser) callonRankOperandNode458() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode178()
+	return p.cur.onRankOperandNode458()
 }
 
-func (c *current) onNode174() (any, error) {
+func (c *current) onRankOperandNode454() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode174() (any, error) {
+func (p *parser) callonRankOperandNode454() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode174()
+	return p.cur.onRankOperandNode454()
 }
 
-func (c *current) onNode183() (any, error) {
+func (c *current) onRankOperandNode463() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode183() (any, error) {
+func (p *parser) callonRankOperandNode463() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode183()
+	return p.cur.onRankOperandNode463()
 }
 
-func (c *current) onNode185() (any, error) {
+func (c *current) onRankOperandNode465() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode185() (any, error) {
+func (p *parser) callonRankOperandNode465() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode185()
+	return p.cur.onRankOperandNode465()
 }
 
-func (c *current) onNode181() (any, error) {
+func (c *current) onRankOperandNode461() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode181() (any, error) {
+func (p *parser) callonRankOperandNode461() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode181()
+	return p.cur.onRankOperandNode461()
 }
 
-func (c *current) onNode142() (any, error) {
+func (c *current) onRankOperandNode422() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode142() (any, error) {
+func (p *parser) callonRankOperandNode422() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode142()
+	return p.cur.onRankOperandNode422()
 }
 
-func (c *current) onNode18(k, o, v any) (any, error) {
+func (c *current) onRankOperandNode298(k, o, v any) (any, error) {
 	return buildDateTimeNode(k, o, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode18() (any, error) {
+func (p *parser) callonRankOperandNode298() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode18(stack["k"], stack["o"], stack["v"])
+	return p.cur.onRankOperandNode298(stack["k"], stack["o"], stack["v"])
 }
 
-func (c *current) onNode193() (any, error) {
+func (c *current) onRankOperandNode473() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode193() (any, error) {
+func (p *parser) callonRankOperandNode473() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode193()
+	return p.cur.onRankOperandNode473()
 }
 
-func (c *current) onNode196() (any, error) {
+func (c *current) onRankOperandNode476() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode196() (any, error) {
+func (p *parser) callonRankOperandNode476() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode196()
+	return p.cur.onRankOperandNode476()
 }
 
-func (c *current) onNode198() (any, error) {
+func (c *current) onRankOperandNode478() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode198() (any, error) {
+func (p *parser) callonRankOperandNode478() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode198()
+	return p.cur.onRankOperandNode478()
 }
 
-func (c *current) onNode213() (any, error) {
+func (c *current) onRankOperandNode493() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode213() (any, error) {
+func (p *parser) callonRankOperandNode493() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode213()
+	return p.cur.onRankOperandNode493()
 }
 
-func (c *current) onNode189(k, v any) (any, error) {
+func (c *current) onRankOperandNode469(k, v any) (any, error) {
 	return buildNaturalLanguageDateTimeNodes(k, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode189() (any, error) {
+func (p *parser) callonRankOperandNode469() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode189(stack["k"], stack["v"])
+	return p.cur.onRankOperandNode469(stack["k"], stack["v"])
 }
 
-func (c *current) onNode221() (any, error) {
+func (c *current) onRankOperandNode501() (any, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonNode221() (any, error) {
+func (p *parser) callonRankOperandNode501() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode221()
+	return p.cur.onRankOperandNode501()
 }
 
-func (c *current) onNode224() (any, error) {
+func (c *current) onRankOperandNode504() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode224() (any, error) {
+func (p *parser) callonRankOperandNode504() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode224()
+	return p.cur.onRankOperandNode504()
 }
 
-func (c *current) onNode226() (any, error) {
+func (c *current) onRankOperandNode506() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode226() (any, error) {
+func (p *parser) callonRankOperandNode506() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode226()
+	return p.cur.onRankOperandNode506()
 }
 
-func (c *current) onNode230(v any) (any, error) {
-	return v, nil
+func (c *current) onRankOperandNode512() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode230() (any, error) {
+func (p *parser) callonRankOperandNode512() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode230(stack["v"])
+	return p.cur.onRankOperandNode512()
 }
 
-func (c *current) onNode217(k, v any) (any, error) {
-	return buildStringNode(k, v, c.text, c.pos)
+func (c *current) onRankOperandNode518() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode217() (any, error) {
+func (p *parser) callonRankOperandNode518() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode217(stack["k"], stack["v"])
+	return p.cur.onRankOperandNode518()
 }
 
-func (c *current) onNode239() (any, error) {
+func (c *current) onRankOperandNode526() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode526() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode526()
+}
+
+func (c *current) onRankOperandNode509() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode509() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode509()
+}
+
+func (c *current) onRankOperandNode533() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode533() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode533()
+}
+
+func (c *current) onRankOperandNode539() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode539() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode539()
+}
+
+func (c *current) onRankOperandNode547() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode547() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode547()
+}
+
+func (c *current) onRankOperandNode530() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode530() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode530()
+}
+
+func (c *current) onRankOperandNode497(k, f, t any) (any, error) {
+	return buildNumericRangeNode(k, f, t, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode497() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode497(stack["k"], stack["f"], stack["t"])
+}
+
+func (c *current) onRankOperandNode555() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode555() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode555()
+}
+
+func (c *current) onRankOperandNode559() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode239() (any, error) {
+func (p *parser) callonRankOperandNode559() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode239()
+	return p.cur.onRankOperandNode559()
 }
 
-func (c *current) onNode243() (any, error) {
+func (c *current) onRankOperandNode561() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode243() (any, error) {
+func (p *parser) callonRankOperandNode561() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode243()
+	return p.cur.onRankOperandNode561()
 }
 
-func (c *current) onNode247() (any, error) {
+func (c *current) onRankOperandNode563() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode247() (any, error) {
+func (p *parser) callonRankOperandNode563() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode247()
+	return p.cur.onRankOperandNode563()
 }
 
-func (c *current) onNode252() (any, error) {
+func (c *current) onRankOperandNode565() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode252() (any, error) {
+func (p *parser) callonRankOperandNode565() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode252()
+	return p.cur.onRankOperandNode565()
 }
 
-func (c *current) onNode254() (any, error) {
-	return nil, nil
+func (c *current) onRankOperandNode571() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode254() (any, error) {
+func (p *parser) callonRankOperandNode571() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode254()
+	return p.cur.onRankOperandNode571()
 }
 
-func (c *current) onNode258(v any) (any, error) {
-	return v, nil
+func (c *current) onRankOperandNode577() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode258() (any, error) {
+func (p *parser) callonRankOperandNode577() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode258(stack["v"])
+	return p.cur.onRankOperandNode577()
 }
 
-func (c *current) onNode265(v any) (any, error) {
-	return nil, nil
+func (c *current) onRankOperandNode585() (any, error) {
+	return c.text, nil
 
 }
 
-func (p *parser) callonNode265() (any, error) {
+func (p *parser) callonRankOperandNode585() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode265(stack["v"])
+	return p.cur.onRankOperandNode585()
 }
 
-func (c *current) onNode269() (any, error) {
+func (c *current) onRankOperandNode568() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode568() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode568()
+}
+
+func (c *current) onRankOperandNode551(k, o, v any) (any, error) {
+	return buildNumericNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode551() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode551(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onRankOperandNode593() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonRankOperandNode593() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode593()
+}
+
+func (c *current) onRankOperandNode596() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode269() (any, error) {
+func (p *parser) callonRankOperandNode596() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode269()
+	return p.cur.onRankOperandNode596()
 }
 
-func (c *current) onNode249(v any) (any, error) {
-	return buildStringNode("", v, c.text, c.pos)
+func (c *current) onRankOperandNode598() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonRankOperandNode598() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode598()
+}
+
+func (c *current) onRankOperandNode602(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonRankOperandNode602() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode602(stack["v"])
+}
+
+func (c *current) onRankOperandNode589(k, v any) (any, error) {
+	return buildStringNode(k, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode249() (any, error) {
+func (p *parser) callonRankOperandNode589() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode249(stack["v"])
+	return p.cur.onRankOperandNode589(stack["k"], stack["v"])
 }
 
-func (c *current) onNode274() (any, error) {
+func (c *current) onRankOperandNode614() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode274() (any, error) {
+func (p *parser) callonRankOperandNode614() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode274()
+	return p.cur.onRankOperandNode614()
 }
 
-func (c *current) onNode276() (any, error) {
+func (c *current) onRankOperandNode616() (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode276() (any, error) {
+func (p *parser) callonRankOperandNode616() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode616()
+}
+
+func (c *current) onRankOperandNode620(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonRankOperandNode620() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode276()
+	return p.cur.onRankOperandNode620(stack["v"])
 }
 
-func (c *current) onNode282(v any) (any, error) {
+func (c *current) onRankOperandNode627(v any) (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode282() (any, error) {
+func (p *parser) callonRankOperandNode627() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode282(stack["v"])
+	return p.cur.onRankOperandNode627(stack["v"])
 }
 
-func (c *current) onNode286() (any, error) {
+func (c *current) onRankOperandNode631() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode286() (any, error) {
+func (p *parser) callonRankOperandNode631() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode286()
+	return p.cur.onRankOperandNode631()
 }
 
-func (c *current) onNode271(v any) (any, error) {
+func (c *current) onRankOperandNode611(v any) (any, error) {
 	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode271() (any, error) {
+func (p *parser) callonRankOperandNode611() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode271(stack["v"])
+	return p.cur.onRankOperandNode611(stack["v"])
 }
 
-func (c *current) onGroupNode6() (any, error) {
-	return c.text, nil
+func (c *current) onRankOperandNode636() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonGroupNode6() (any, error) {
+func (p *parser) callonRankOperandNode636() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGroupNode6()
+	return p.cur.onRankOperandNode636()
 }
 
-func (c *current) onGroupNode10() (any, error) {
-	return buildOperatorNode(c.text, c.pos)
+func (c *current) onRankOperandNode638() (any, error) {
+	return nil, nil
 
 }
 
-func (p *parser) callonGroupNode10() (any, error) {
+func (p *parser) callonRankOperandNode638() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGroupNode10()
+	return p.cur.onRankOperandNode638()
 }
 
-func (c *current) onGroupNode12() (any, error) {
+func (c *current) onRankOperandNode644(v any) (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonRankOperandNode644() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRankOperandNode644(stack["v"])
+}
+
+func (c *current) onRankOperandNode648() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonGroupNode12() (any, error) {
+func (p *parser) callonRankOperandNode648() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGroupNode12()
+	return p.cur.onRankOperandNode648()
 }
 
-func (c *current) onGroupNode1(k, v any) (any, error) {
-	return buildGroupNode(k, v, c.text, c.pos)
+func (c *current) onRankOperandNode633(v any) (any, error) {
+	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonGroupNode1() (any, error) {
+func (p *parser) callonRankOperandNode633() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGroupNode1(stack["k"], stack["v"])
+	return p.cur.onRankOperandNode633(stack["v"])
 }
 
 var (
@@ -2716,7 +11820,7 @@ var (
 
 	// errMaxExprCnt is used to signal that the maximum number of
 	// expressions have been parsed.
-	errMaxExprCnt = errors.New("max number of expresssions parsed")
+	errMaxExprCnt = errors.New("max number of expressions parsed")
 )
 
 // Option is a function that can set an option on the parser. It returns
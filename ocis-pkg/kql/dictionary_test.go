@@ -848,6 +848,301 @@ func TestParse_DateTimeRestrictionNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: `Mtime:2023-01-01..2023-01-31`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.DateTimeNode{
+							Key:      "Mtime",
+							Operator: &ast.OperatorNode{Value: ">="},
+							Value:    mustParseTime(t, "2023-01-01"),
+						},
+						&ast.OperatorNode{Value: kql.BoolAND},
+						&ast.DateTimeNode{
+							Key:      "Mtime",
+							Operator: &ast.OperatorNode{Value: "<="},
+							Value:    mustParseTime(t, "2023-01-31 23:59:59.999999999"),
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: `Mtime:"2023-09-05T08:00:00Z..2023-09-05T10:00:00Z"`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.DateTimeNode{
+							Key:      "Mtime",
+							Operator: &ast.OperatorNode{Value: ">="},
+							Value:    mustParseTime(t, "2023-09-05T08:00:00Z"),
+						},
+						&ast.OperatorNode{Value: kql.BoolAND},
+						&ast.DateTimeNode{
+							Key:      "Mtime",
+							Operator: &ast.OperatorNode{Value: "<="},
+							Value:    mustParseTime(t, "2023-09-05T10:00:00Z"),
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_NumericRestrictionNode(t *testing.T) {
+	tests := []testCase{
+		{
+			name: "comparison",
+			query: join([]string{
+				`size>10`,
+				`size>=1.5KB`,
+				`size<10mb`,
+				`size<=2GB`,
+			}),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{
+						Key:      "size",
+						Operator: &ast.OperatorNode{Value: ">"},
+						Value:    10,
+					},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{
+						Key:      "size",
+						Operator: &ast.OperatorNode{Value: ">="},
+						Value:    1536,
+					},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{
+						Key:      "size",
+						Operator: &ast.OperatorNode{Value: "<"},
+						Value:    10 << 20,
+					},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{
+						Key:      "size",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    2 << 30,
+					},
+				},
+			},
+		},
+		{
+			name: `size:1KB..5MB`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: ">="},
+							Value:    1024,
+						},
+						&ast.OperatorNode{Value: kql.BoolAND},
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: "<="},
+							Value:    5 << 20,
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: `NOT size=1..2 name:foo`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.OperatorNode{Value: kql.BoolNOT},
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: ">="},
+							Value:    1,
+						},
+						&ast.OperatorNode{Value: kql.BoolAND},
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: "<="},
+							Value:    2,
+						},
+					}},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "name", Value: "foo"},
+				},
+			},
+		},
+		{
+			name: "numbers without comparison are text",
+			query: join([]string{
+				`size:10`,
+				`name:10MB`,
+				`name>10MBs`,
+			}),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "size", Value: "10"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "name", Value: "10MB"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "name>10MBs"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testKQL(t, tc)
+		})
+	}
+}
+
+func TestParse_ProximityAndRankNode(t *testing.T) {
+	tests := []testCase{
+		// NEAR Operator
+		{
+			name: `acquisition NEAR debt`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"acquisition", "debt"}, Distance: 8},
+				},
+			},
+		},
+		{
+			name: `acquisition NEAR(n=5) "debt ratio"`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"acquisition", "debt ratio"}, Distance: 5},
+				},
+			},
+		},
+		{
+			name: `content:(acquisition NEAR(2) debt) name:report`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Key: "content", Nodes: []ast.Node{
+						&ast.ProximityNode{Terms: []string{"acquisition", "debt"}, Distance: 2},
+					}},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "name", Value: "report"},
+				},
+			},
+		},
+		// ONEAR Operator
+		{
+			name: `acquisition ONEAR(n=3) debt OR cat`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"acquisition", "debt"}, Distance: 3, Ordered: true},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.StringNode{Value: "cat"},
+				},
+			},
+		},
+		{
+			name: "operators need whitespace",
+			query: join([]string{
+				`catNEAR dog`,
+				`NEAR`,
+			}),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "catNEAR"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "dog"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "NEAR"},
+				},
+			},
+		},
+		// XRANK Operator
+		{
+			name: `cat XRANK(cb=100) dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Match: &ast.StringNode{Value: "cat"},
+						Rank:  &ast.StringNode{Value: "dog"},
+						Boost: 100,
+					},
+				},
+			},
+		},
+		{
+			name:  `(cat OR dog) XRANK(cb=1.5) tag:pet NOT mtime:today`,
+			patch: setWorldClock(t, "2023-09-05T08:42:11Z"),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Match: &ast.GroupNode{Nodes: []ast.Node{
+							&ast.StringNode{Value: "cat"},
+							&ast.OperatorNode{Value: kql.BoolOR},
+							&ast.StringNode{Value: "dog"},
+						}},
+						Rank:  &ast.StringNode{Key: "tag", Value: "pet"},
+						Boost: 1.5,
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.OperatorNode{Value: kql.BoolNOT},
+					&ast.DateTimeNode{
+						Key:      "mtime",
+						Operator: &ast.OperatorNode{Value: ">="},
+						Value:    mustParseTime(t, "2023-09-05T00:00:00Z"),
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.DateTimeNode{
+						Key:      "mtime",
+						Operator: &ast.OperatorNode{Value: "<="},
+						Value:    mustParseTime(t, "2023-09-05T23:59:59.999999999Z"),
+					},
+				},
+			},
+		},
+		{
+			name:  `content:invoice XRANK(cb=2) mtime:today`,
+			patch: setWorldClock(t, "2023-09-05T08:42:11Z"),
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Match: &ast.StringNode{Key: "content", Value: "invoice"},
+						Rank: &ast.GroupNode{Nodes: []ast.Node{
+							&ast.DateTimeNode{
+								Key:      "mtime",
+								Operator: &ast.OperatorNode{Value: ">="},
+								Value:    mustParseTime(t, "2023-09-05T00:00:00Z"),
+							},
+							&ast.OperatorNode{Value: kql.BoolAND},
+							&ast.DateTimeNode{
+								Key:      "mtime",
+								Operator: &ast.OperatorNode{Value: "<="},
+								Value:    mustParseTime(t, "2023-09-05T23:59:59.999999999Z"),
+							},
+						}},
+						Boost: 2,
+					},
+				},
+			},
+		},
+		// Phrase and prefix matching
+		{
+			name: `content:"brown fox" fed*`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "brown fox"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "fed*"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
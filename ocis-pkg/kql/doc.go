@@ -19,6 +19,12 @@ The following spec parts are supported and tested:
   - 3.3.1.1.1 Implicit AND Operator
   - 3.3.5 Date Tokens
 
+Beyond that, the following parts are supported:
+  - NEAR and ONEAR Operators, with the distance written as NEAR(4) or NEAR(n=4), 8 by default
+  - XRANK Operator with a constant boost like XRANK(cb=100)
+  - Phrases like "brown fox" and prefix wildcards like fed*
+  - Numeric comparisons and ranges with units like size>10MB or size:1KB..5MB
+
 References:
   - https://learn.microsoft.com/en-us/sharepoint/dev/general-development/keyword-query-language-kql-syntax-reference
   - https://learn.microsoft.com/en-us/openspecs/sharepoint_protocols/ms-kql/3bbf06cd-8fc1-4277-bd92-8661ccd3c9b0
@@ -1,8 +1,10 @@
 package kql
 
 import (
+	"strconv"
 	"strings"
 
+	"github.com/jinzhu/now"
 	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
 )
 
@@ -101,6 +103,197 @@ func buildDateTimeNode(k, o, v interface{}, text []byte, pos position) (*ast.Dat
 		Value:    value,
 	}, nil
 }
+
+// buildDateTimeRangeNode groups the restrictions of a range like 'Mtime:2023-01-01..2023-01-31',
+// the range includes the end, a date without time includes the whole day.
+func buildDateTimeRangeNode(k, f, t interface{}, text []byte, pos position) (*ast.GroupNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := toTime(f)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := toTime(t)
+	if err != nil {
+		return nil, err
+	}
+	if ts, _ := toString(t); !strings.Contains(ts, "T") {
+		to = now.With(to).EndOfDay()
+	}
+
+	return &ast.GroupNode{
+		Base: b,
+		Nodes: []ast.Node{
+			&ast.DateTimeNode{
+				Base:     b,
+				Key:      key,
+				Operator: &ast.OperatorNode{Value: ">="},
+				Value:    from,
+			},
+			&ast.OperatorNode{Value: BoolAND},
+			&ast.DateTimeNode{
+				Base:     b,
+				Key:      key,
+				Operator: &ast.OperatorNode{Value: "<="},
+				Value:    to,
+			},
+		},
+	}, nil
+}
+
+func buildNumericNode(k, o, v interface{}, text []byte, pos position) (*ast.NumericNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toNode[*ast.OperatorNode](o)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.NumericNode{
+		Base:     b,
+		Key:      key,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+// buildNumericRangeNode groups the restrictions of a range like 'size:1KB..5MB', the range includes both bounds.
+func buildNumericRangeNode(k, f, t interface{}, text []byte, pos position) (*ast.GroupNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := toNumber(f)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := toNumber(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.GroupNode{
+		Base: b,
+		Nodes: []ast.Node{
+			&ast.NumericNode{
+				Base:     b,
+				Key:      key,
+				Operator: &ast.OperatorNode{Value: ">="},
+				Value:    from,
+			},
+			&ast.OperatorNode{Value: BoolAND},
+			&ast.NumericNode{
+				Base:     b,
+				Key:      key,
+				Operator: &ast.OperatorNode{Value: "<="},
+				Value:    to,
+			},
+		},
+	}, nil
+}
+
+// _defaultProximityDistance is the distance of NEAR and ONEAR if none is given, like defined by the spec
+const _defaultProximityDistance = 8
+
+func buildProximityNode(l, o, d, r interface{}, text []byte, pos position) (*ast.ProximityNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := toString(l)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := toString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toString(o)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := _defaultProximityDistance
+	if d != nil {
+		ds, err := toString(d)
+		if err != nil {
+			return nil, err
+		}
+
+		if distance, err = strconv.Atoi(ds); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.ProximityNode{
+		Base:     b,
+		Terms:    []string{left, right},
+		Distance: distance,
+		Ordered:  operator == ProximityONEAR,
+	}, nil
+}
+
+func buildRankNode(m, v, r interface{}, text []byte, pos position) (*ast.RankNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := toRankOperand(m)
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := toRankOperand(r)
+	if err != nil {
+		return nil, err
+	}
+
+	boost, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.RankNode{
+		Base:  b,
+		Match: match,
+		Rank:  rank,
+		Boost: boost,
+	}, nil
+}
+
 func buildNaturalLanguageDateTimeNodes(k, v interface{}, text []byte, pos position) ([]ast.Node, error) {
 	b, err := base(text, pos)
 	if err != nil {
@@ -18,6 +18,14 @@ const (
 	BoolNOT = "NOT"
 )
 
+// The proximity operator definition
+const (
+	// ProximityNEAR matches terms which are close to each other in any order
+	ProximityNEAR = "NEAR"
+	// ProximityONEAR matches terms which are close to each other in the given order
+	ProximityONEAR = "ONEAR"
+)
+
 // Builder implements kql Builder interface
 type Builder struct{}
 
@@ -0,0 +1,66 @@
+// Package lease lets one of several replicas of a service claim a recurring task in a shared store.
+package lease
+
+import (
+	"encoding/json"
+	"time"
+
+	"go-micro.dev/v4/store"
+)
+
+// lease is held by the replica doing a task which must not run on all replicas at the same time
+type lease struct {
+	Holder string    `json:"holder"`
+	Until  time.Time `json:"until"`
+}
+
+// Acquire returns true if the holder got or renewed the lease stored at key until now+d.
+// The store has no compare-and-swap, the lease is read again after writing it to detect
+// concurrent writes of other replicas.
+func Acquire(sto store.Store, key, holder string, now time.Time, d time.Duration) bool {
+	var l lease
+	if recs, err := sto.Read(key); err == nil && len(recs) == 1 {
+		if err := json.Unmarshal(recs[0].Value, &l); err == nil && l.Holder != holder && l.Until.After(now) {
+			return false
+		}
+	}
+
+	b, err := json.Marshal(lease{Holder: holder, Until: now.Add(d)})
+	if err != nil {
+		return false
+	}
+	if err := sto.Write(&store.Record{Key: key, Value: b}); err != nil {
+		return false
+	}
+
+	recs, err := sto.Read(key)
+	if err != nil || len(recs) != 1 {
+		return false
+	}
+	if err := json.Unmarshal(recs[0].Value, &l); err != nil {
+		return false
+	}
+	return l.Holder == holder
+}
+
+// Release gives up the lease stored at key if it is held by the holder
+func Release(sto store.Store, key, holder string) error {
+	recs, err := sto.Read(key)
+	switch {
+	case err == store.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	case len(recs) != 1:
+		return nil
+	}
+
+	var l lease
+	if err := json.Unmarshal(recs[0].Value, &l); err != nil {
+		return err
+	}
+	if l.Holder != holder {
+		return nil
+	}
+	return sto.Delete(key)
+}
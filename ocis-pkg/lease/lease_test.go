@@ -0,0 +1,43 @@
+package lease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go-micro.dev/v4/store"
+)
+
+func TestAcquire(t *testing.T) {
+	sto := store.NewMemoryStore()
+	now := time.Now()
+
+	assert.True(t, Acquire(sto, "lease-test", "replica-1", now, time.Minute))
+	assert.False(t, Acquire(sto, "lease-test", "replica-2", now.Add(30*time.Second), time.Minute))
+
+	// the holder renews its lease
+	assert.True(t, Acquire(sto, "lease-test", "replica-1", now.Add(30*time.Second), time.Minute))
+	assert.False(t, Acquire(sto, "lease-test", "replica-2", now.Add(time.Minute), time.Minute))
+
+	// another replica takes over once the lease expired
+	assert.True(t, Acquire(sto, "lease-test", "replica-2", now.Add(2*time.Minute), time.Minute))
+	assert.False(t, Acquire(sto, "lease-test", "replica-1", now.Add(2*time.Minute), time.Minute))
+
+	// leases with different keys are independent
+	assert.True(t, Acquire(sto, "lease-other", "replica-1", now.Add(2*time.Minute), time.Minute))
+}
+
+func TestRelease(t *testing.T) {
+	sto := store.NewMemoryStore()
+	now := time.Now()
+
+	assert.NoError(t, Release(sto, "lease-test", "replica-1"))
+
+	assert.True(t, Acquire(sto, "lease-test", "replica-1", now, time.Minute))
+	// only the holder can release the lease
+	assert.NoError(t, Release(sto, "lease-test", "replica-2"))
+	assert.False(t, Acquire(sto, "lease-test", "replica-2", now, time.Minute))
+
+	assert.NoError(t, Release(sto, "lease-test", "replica-1"))
+	assert.True(t, Acquire(sto, "lease-test", "replica-2", now, time.Minute))
+}
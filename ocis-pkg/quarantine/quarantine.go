@@ -0,0 +1,370 @@
+// Package quarantine stores infected files in an admin-only space of the system storage
+package quarantine
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// SpaceID is the id of the metadata space holding the quarantined files
+	SpaceID = "antivirus-quarantine"
+
+	_itemsFolder    = "items"
+	_blobsFolder    = "blobs"
+	_releasedFolder = "released"
+
+	// _chunkSize bounds the memory used for quarantined files, their content is stored in chunks of this size
+	_chunkSize = 8 << 20
+)
+
+// ErrNotFound is returned when a quarantined item does not exist
+var ErrNotFound = errors.New("quarantined item not found")
+
+// Item describes a quarantined file
+type Item struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Path         string    `json:"path,omitempty"`
+	SpaceID      string    `json:"spaceId,omitempty"`
+	ResourceID   string    `json:"resourceId,omitempty"`
+	Size         uint64    `json:"size"`
+	Checksum     string    `json:"checksum"`
+	UploaderID   string    `json:"uploaderId,omitempty"`
+	UploaderName string    `json:"uploaderName,omitempty"`
+	Virus        string    `json:"virus"`
+	Scandate     time.Time `json:"scandate"`
+	Quarantined  time.Time `json:"quarantined"`
+}
+
+// Quarantine manages the quarantined files
+type Quarantine struct {
+	storage metadata.Storage
+
+	mu          sync.Mutex
+	initialized bool
+}
+
+// New returns a Quarantine using the given storage
+func New(storage metadata.Storage) *Quarantine {
+	return &Quarantine{storage: storage}
+}
+
+// Checksum returns the checksum used to identify the content of quarantined files
+func Checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Add streams the content into quarantine, the id, size, checksum and quarantine date of the item are set by Add
+func (q *Quarantine) Add(ctx context.Context, item Item, content io.Reader) (Item, error) {
+	if err := q.init(ctx); err != nil {
+		return Item{}, err
+	}
+
+	item.ID = uuid.Must(uuid.NewV4()).String()
+	item.Size = 0
+	item.Quarantined = time.Now()
+
+	// the blob goes first, an item is only listed once its content is available
+	h := sha256.New()
+	buf := make([]byte, _chunkSize)
+	for n := 0; ; n++ {
+		read, err := io.ReadFull(content, buf)
+		if read > 0 {
+			if err := q.storage.SimpleUpload(ctx, chunkPath(item.ID, n), buf[:read]); err != nil {
+				q.deleteChunks(ctx, item.ID)
+				return Item{}, err
+			}
+			h.Write(buf[:read])
+			item.Size += uint64(read)
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			q.deleteChunks(ctx, item.ID)
+			return Item{}, err
+		}
+	}
+	item.Checksum = hex.EncodeToString(h.Sum(nil))
+
+	b, err := json.Marshal(item)
+	if err != nil {
+		q.deleteChunks(ctx, item.ID)
+		return Item{}, err
+	}
+	if err := q.storage.SimpleUpload(ctx, itemPath(item.ID), b); err != nil {
+		q.deleteChunks(ctx, item.ID)
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+// List returns all quarantined items, the most recent first
+func (q *Quarantine) List(ctx context.Context) ([]Item, error) {
+	if err := q.init(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := q.storage.ReadDir(ctx, _itemsFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(entries))
+	for _, e := range entries {
+		item, err := q.Get(ctx, strings.TrimSuffix(path.Base(e), ".json"))
+		switch {
+		case errors.Is(err, ErrNotFound):
+			// deleted in the meantime
+			continue
+		case err != nil:
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Quarantined.After(items[j].Quarantined)
+	})
+	return items, nil
+}
+
+// Get returns the quarantined item with the given id
+func (q *Quarantine) Get(ctx context.Context, id string) (Item, error) {
+	if err := q.init(ctx); err != nil {
+		return Item{}, err
+	}
+
+	if _, err := uuid.FromString(id); err != nil {
+		return Item{}, ErrNotFound
+	}
+
+	b, err := q.download(ctx, itemPath(id))
+	if err != nil {
+		return Item{}, err
+	}
+
+	var item Item
+	if err := json.Unmarshal(b, &item); err != nil {
+		return Item{}, err
+	}
+	return item, nil
+}
+
+// Content returns a reader streaming the content of the quarantined item with the given id
+func (q *Quarantine) Content(ctx context.Context, id string) (io.ReadCloser, error) {
+	item, err := q.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(&contentReader{ctx: ctx, q: q, item: item}), nil
+}
+
+// Delete permanently deletes the quarantined item with the given id
+func (q *Quarantine) Delete(ctx context.Context, id string) error {
+	if _, err := q.Get(ctx, id); err != nil {
+		return err
+	}
+
+	if err := q.storage.Delete(ctx, itemPath(id)); err != nil {
+		return err
+	}
+	return q.deleteChunks(ctx, id)
+}
+
+// Release records where an admin restored a quarantined item, the restored file is not quarantined again
+type Release struct {
+	ItemID     string    `json:"itemId"`
+	SpaceID    string    `json:"spaceId"`
+	Name       string    `json:"name"`
+	ResourceID string    `json:"resourceId,omitempty"`
+	Checksum   string    `json:"checksum"`
+	Released   time.Time `json:"released"`
+}
+
+// Matches returns true if the file is the restored item, identified by its resource id or by its name in the space
+func (r Release) Matches(spaceID, resourceID, name, checksum string) bool {
+	if r.SpaceID != spaceID || r.Checksum != checksum {
+		return false
+	}
+	return (r.ResourceID != "" && r.ResourceID == resourceID) || r.Name == name
+}
+
+// MarkReleased records the release of a quarantined item, an existing release of the item is replaced
+func (q *Quarantine) MarkReleased(ctx context.Context, r Release) error {
+	if err := q.init(ctx); err != nil {
+		return err
+	}
+
+	if _, err := uuid.FromString(r.ItemID); err != nil {
+		return ErrNotFound
+	}
+	if r.Released.IsZero() {
+		r.Released = time.Now()
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return q.storage.SimpleUpload(ctx, releasePath(r.ItemID), b)
+}
+
+// UnmarkReleased removes the release of a quarantined item, e.g. when it could not be restored
+func (q *Quarantine) UnmarkReleased(ctx context.Context, itemID string) error {
+	if err := q.init(ctx); err != nil {
+		return err
+	}
+
+	if _, err := uuid.FromString(itemID); err != nil {
+		return ErrNotFound
+	}
+	return q.storage.Delete(ctx, releasePath(itemID))
+}
+
+// IsReleased returns true if the file in the space is a quarantined item an admin restored before
+func (q *Quarantine) IsReleased(ctx context.Context, spaceID, resourceID, name, checksum string) (bool, error) {
+	if err := q.init(ctx); err != nil {
+		return false, err
+	}
+
+	entries, err := q.storage.ReadDir(ctx, _releasedFolder)
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range entries {
+		b, err := q.download(ctx, path.Join(_releasedFolder, path.Base(e)))
+		switch {
+		case errors.Is(err, ErrNotFound):
+			// removed in the meantime
+			continue
+		case err != nil:
+			return false, err
+		}
+
+		var r Release
+		if err := json.Unmarshal(b, &r); err != nil {
+			return false, err
+		}
+		if r.Matches(spaceID, resourceID, name, checksum) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// init creates the quarantine space on first use, the system storage might not be available on startup
+func (q *Quarantine) init(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.initialized {
+		return nil
+	}
+
+	if err := q.storage.Init(ctx, SpaceID); err != nil {
+		return err
+	}
+	for _, folder := range []string{_itemsFolder, _blobsFolder, _releasedFolder} {
+		if err := q.storage.MakeDirIfNotExist(ctx, folder); err != nil {
+			return err
+		}
+	}
+
+	q.initialized = true
+	return nil
+}
+
+func (q *Quarantine) download(ctx context.Context, p string) ([]byte, error) {
+	res, err := q.storage.Download(ctx, metadata.DownloadRequest{Path: p})
+	switch {
+	case isNotFound(err):
+		return nil, ErrNotFound
+	case err != nil:
+		return nil, err
+	}
+	return res.Content, nil
+}
+
+// deleteChunks deletes the content of an item, it stops at the first chunk which does not exist
+func (q *Quarantine) deleteChunks(ctx context.Context, id string) error {
+	for n := 0; ; n++ {
+		err := q.storage.Delete(ctx, chunkPath(id, n))
+		switch {
+		case isNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
+
+// contentReader reads the chunks of a quarantined item one after another
+type contentReader struct {
+	ctx   context.Context
+	q     *Quarantine
+	item  Item
+	next  int
+	read  uint64
+	chunk *bytes.Reader
+}
+
+func (r *contentReader) Read(p []byte) (int, error) {
+	for r.chunk == nil || r.chunk.Len() == 0 {
+		if r.read >= r.item.Size {
+			return 0, io.EOF
+		}
+
+		b, err := r.q.download(r.ctx, chunkPath(r.item.ID, r.next))
+		switch {
+		case errors.Is(err, ErrNotFound) || len(b) == 0:
+			return 0, fmt.Errorf("content of quarantined item %s is incomplete: %w", r.item.ID, io.ErrUnexpectedEOF)
+		case err != nil:
+			return 0, err
+		}
+		r.next++
+		r.read += uint64(len(b))
+		r.chunk = bytes.NewReader(b)
+	}
+
+	return r.chunk.Read(p)
+}
+
+func isNotFound(err error) bool {
+	var nf errtypes.IsNotFound
+	return errors.As(err, &nf) || errors.Is(err, fs.ErrNotExist)
+}
+
+func itemPath(id string) string {
+	return path.Join(_itemsFolder, id+".json")
+}
+
+func chunkPath(id string, n int) string {
+	return path.Join(_blobsFolder, id+"."+strconv.Itoa(n))
+}
+
+func releasePath(id string) string {
+	return path.Join(_releasedFolder, id+".json")
+}
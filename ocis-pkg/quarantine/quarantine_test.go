@@ -0,0 +1,126 @@
+package quarantine_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/quarantine"
+)
+
+func newQuarantine(t *testing.T) *quarantine.Quarantine {
+	s, err := metadata.NewDiskStorage(t.TempDir())
+	require.NoError(t, err)
+	return quarantine.New(s)
+}
+
+func TestQuarantine(t *testing.T) {
+	ctx := context.Background()
+	q := newQuarantine(t)
+	content := []byte("X5O!P%@AP[4\\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*")
+
+	item, err := q.Add(ctx, quarantine.Item{Name: "eicar.com", Path: "./Documents/eicar.com", Virus: "Eicar-Signature"}, bytes.NewReader(content))
+	require.NoError(t, err)
+	assert.NotEmpty(t, item.ID)
+	assert.Equal(t, uint64(len(content)), item.Size)
+	assert.Equal(t, quarantine.Checksum(content), item.Checksum)
+
+	items, err := q.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, item.ID, items[0].ID)
+	assert.Equal(t, "./Documents/eicar.com", items[0].Path)
+	assert.Equal(t, "Eicar-Signature", items[0].Virus)
+
+	r, err := q.Content(ctx, item.ID)
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, content, b)
+
+	require.NoError(t, q.Delete(ctx, item.ID))
+	_, err = q.Get(ctx, item.ID)
+	assert.ErrorIs(t, err, quarantine.ErrNotFound)
+
+	items, err = q.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestQuarantine_LargeContent(t *testing.T) {
+	ctx := context.Background()
+	q := newQuarantine(t)
+	// larger than a single chunk of the blob storage
+	content := bytes.Repeat([]byte("0123456789abcdef"), 1<<20+7)
+
+	item, err := q.Add(ctx, quarantine.Item{Name: "large.bin", Virus: "Test-Signature"}, bytes.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(content)), item.Size)
+	assert.Equal(t, quarantine.Checksum(content), item.Checksum)
+
+	r, err := q.Content(ctx, item.ID)
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, content, b)
+
+	require.NoError(t, q.Delete(ctx, item.ID))
+	_, err = q.Content(ctx, item.ID)
+	assert.ErrorIs(t, err, quarantine.ErrNotFound)
+}
+
+func TestQuarantine_Get(t *testing.T) {
+	ctx := context.Background()
+	q := newQuarantine(t)
+
+	_, err := q.Get(ctx, "00000000-0000-0000-0000-000000000000")
+	assert.ErrorIs(t, err, quarantine.ErrNotFound)
+
+	// ids are part of the path and need to be valid
+	_, err = q.Get(ctx, "../items/foo")
+	assert.ErrorIs(t, err, quarantine.ErrNotFound)
+
+	assert.ErrorIs(t, q.Delete(ctx, "../items/foo"), quarantine.ErrNotFound)
+}
+
+func TestQuarantine_Released(t *testing.T) {
+	ctx := context.Background()
+	q := newQuarantine(t)
+	checksum := quarantine.Checksum([]byte("false positive"))
+	itemID := "a0ca6a90-a365-4782-871e-d44447bbc668"
+
+	released, err := q.IsReleased(ctx, "storage$space", "", "report.pdf", checksum)
+	require.NoError(t, err)
+	assert.False(t, released)
+
+	require.NoError(t, q.MarkReleased(ctx, quarantine.Release{ItemID: itemID, SpaceID: "storage$space", Name: "report.pdf", Checksum: checksum}))
+
+	released, err = q.IsReleased(ctx, "storage$space", "", "report.pdf", checksum)
+	require.NoError(t, err)
+	assert.True(t, released)
+
+	// the same content uploaded by someone else is quarantined again
+	released, err = q.IsReleased(ctx, "storage$other", "", "report.pdf", checksum)
+	require.NoError(t, err)
+	assert.False(t, released)
+	released, err = q.IsReleased(ctx, "storage$space", "", "copy.pdf", checksum)
+	require.NoError(t, err)
+	assert.False(t, released)
+
+	// the restored file stays released when it is renamed
+	require.NoError(t, q.MarkReleased(ctx, quarantine.Release{ItemID: itemID, SpaceID: "storage$space", Name: "report.pdf", ResourceID: "storage$space!file", Checksum: checksum}))
+	released, err = q.IsReleased(ctx, "storage$space", "storage$space!file", "renamed.pdf", checksum)
+	require.NoError(t, err)
+	assert.True(t, released)
+
+	require.NoError(t, q.UnmarkReleased(ctx, itemID))
+	released, err = q.IsReleased(ctx, "storage$space", "storage$space!file", "report.pdf", checksum)
+	require.NoError(t, err)
+	assert.False(t, released)
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"crypto/x509"
 	"net/http"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
@@ -17,6 +18,7 @@ type Option func(o *Options)
 type Options struct {
 	Logger        log.Logger
 	TLSConfig     shared.HTTPServiceTLS
+	ClientCAs     *x509.CertPool
 	Namespace     string
 	Name          string
 	Version       string
@@ -96,6 +98,14 @@ func TLSConfig(config shared.HTTPServiceTLS) Option {
 	}
 }
 
+// ClientCAs provides a function to set the ClientCAs option. Client certificates are
+// requested and verified against these CAs when TLS is enabled.
+func ClientCAs(pool *x509.CertPool) Option {
+	return func(o *Options) {
+		o.ClientCAs = pool
+	}
+}
+
 // TraceProvider provides a function to set the TraceProvider option.
 func TraceProvider(tp trace.TracerProvider) Option {
 	return func(o *Options) {
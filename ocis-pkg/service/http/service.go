@@ -50,6 +50,11 @@ func NewService(opts ...Option) (Service, error) {
 		tlsConfig := &tls.Config{
 			Certificates: []tls.Certificate{cert},
 		}
+		if sopts.ClientCAs != nil {
+			// authenticating with a client certificate is optional, the handler decides what to do without one
+			tlsConfig.ClientCAs = sopts.ClientCAs
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
 		mServer = mhttps.NewServer(server.TLSConfig(tlsConfig))
 	} else {
 		mServer = mhttps.NewServer()
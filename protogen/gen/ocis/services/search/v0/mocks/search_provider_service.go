@@ -25,6 +25,80 @@ func (_m *SearchProviderService) EXPECT() *SearchProviderService_Expecter {
 	return &SearchProviderService_Expecter{mock: &_m.Mock}
 }
 
+// DeleteSavedSearch provides a mock function with given fields: ctx, in, opts
+func (_m *SearchProviderService) DeleteSavedSearch(ctx context.Context, in *v0.DeleteSavedSearchRequest, opts ...client.CallOption) (*v0.DeleteSavedSearchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavedSearch")
+	}
+
+	var r0 *v0.DeleteSavedSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) (*v0.DeleteSavedSearchResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) *v0.DeleteSavedSearchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.DeleteSavedSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProviderService_DeleteSavedSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavedSearch'
+type SearchProviderService_DeleteSavedSearch_Call struct {
+	*mock.Call
+}
+
+// DeleteSavedSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.DeleteSavedSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) DeleteSavedSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_DeleteSavedSearch_Call {
+	return &SearchProviderService_DeleteSavedSearch_Call{Call: _e.mock.On("DeleteSavedSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) Run(run func(ctx context.Context, in *v0.DeleteSavedSearchRequest, opts ...client.CallOption)) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v0.DeleteSavedSearchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) Return(_a0 *v0.DeleteSavedSearchResponse, _a1 error) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchProviderService_DeleteSavedSearch_Call) RunAndReturn(run func(context.Context, *v0.DeleteSavedSearchRequest, ...client.CallOption) (*v0.DeleteSavedSearchResponse, error)) *SearchProviderService_DeleteSavedSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexSpace provides a mock function with given fields: ctx, in, opts
 func (_m *SearchProviderService) IndexSpace(ctx context.Context, in *v0.IndexSpaceRequest, opts ...client.CallOption) (*v0.IndexSpaceResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -99,6 +173,154 @@ func (_c *SearchProviderService_IndexSpace_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListSavedSearches provides a mock function with given fields: ctx, in, opts
+func (_m *SearchProviderService) ListSavedSearches(ctx context.Context, in *v0.ListSavedSearchesRequest, opts ...client.CallOption) (*v0.ListSavedSearchesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSavedSearches")
+	}
+
+	var r0 *v0.ListSavedSearchesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) (*v0.ListSavedSearchesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) *v0.ListSavedSearchesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.ListSavedSearchesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProviderService_ListSavedSearches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSavedSearches'
+type SearchProviderService_ListSavedSearches_Call struct {
+	*mock.Call
+}
+
+// ListSavedSearches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.ListSavedSearchesRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) ListSavedSearches(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_ListSavedSearches_Call {
+	return &SearchProviderService_ListSavedSearches_Call{Call: _e.mock.On("ListSavedSearches",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) Run(run func(ctx context.Context, in *v0.ListSavedSearchesRequest, opts ...client.CallOption)) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v0.ListSavedSearchesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) Return(_a0 *v0.ListSavedSearchesResponse, _a1 error) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchProviderService_ListSavedSearches_Call) RunAndReturn(run func(context.Context, *v0.ListSavedSearchesRequest, ...client.CallOption) (*v0.ListSavedSearchesResponse, error)) *SearchProviderService_ListSavedSearches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSearch provides a mock function with given fields: ctx, in, opts
+func (_m *SearchProviderService) SaveSearch(ctx context.Context, in *v0.SaveSearchRequest, opts ...client.CallOption) (*v0.SaveSearchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSearch")
+	}
+
+	var r0 *v0.SaveSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.SaveSearchRequest, ...client.CallOption) (*v0.SaveSearchResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.SaveSearchRequest, ...client.CallOption) *v0.SaveSearchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.SaveSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.SaveSearchRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProviderService_SaveSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSearch'
+type SearchProviderService_SaveSearch_Call struct {
+	*mock.Call
+}
+
+// SaveSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.SaveSearchRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) SaveSearch(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_SaveSearch_Call {
+	return &SearchProviderService_SaveSearch_Call{Call: _e.mock.On("SaveSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_SaveSearch_Call) Run(run func(ctx context.Context, in *v0.SaveSearchRequest, opts ...client.CallOption)) *SearchProviderService_SaveSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v0.SaveSearchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_SaveSearch_Call) Return(_a0 *v0.SaveSearchResponse, _a1 error) *SearchProviderService_SaveSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchProviderService_SaveSearch_Call) RunAndReturn(run func(context.Context, *v0.SaveSearchRequest, ...client.CallOption) (*v0.SaveSearchResponse, error)) *SearchProviderService_SaveSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, in, opts
 func (_m *SearchProviderService) Search(ctx context.Context, in *v0.SearchRequest, opts ...client.CallOption) (*v0.SearchResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -173,6 +395,80 @@ func (_c *SearchProviderService_Search_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// VerifySpace provides a mock function with given fields: ctx, in, opts
+func (_m *SearchProviderService) VerifySpace(ctx context.Context, in *v0.VerifySpaceRequest, opts ...client.CallOption) (*v0.VerifySpaceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySpace")
+	}
+
+	var r0 *v0.VerifySpaceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.VerifySpaceRequest, ...client.CallOption) (*v0.VerifySpaceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.VerifySpaceRequest, ...client.CallOption) *v0.VerifySpaceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.VerifySpaceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.VerifySpaceRequest, ...client.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchProviderService_VerifySpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySpace'
+type SearchProviderService_VerifySpace_Call struct {
+	*mock.Call
+}
+
+// VerifySpace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.VerifySpaceRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) VerifySpace(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_VerifySpace_Call {
+	return &SearchProviderService_VerifySpace_Call{Call: _e.mock.On("VerifySpace",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_VerifySpace_Call) Run(run func(ctx context.Context, in *v0.VerifySpaceRequest, opts ...client.CallOption)) *SearchProviderService_VerifySpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]client.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(client.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*v0.VerifySpaceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_VerifySpace_Call) Return(_a0 *v0.VerifySpaceResponse, _a1 error) *SearchProviderService_VerifySpace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchProviderService_VerifySpace_Call) RunAndReturn(run func(context.Context, *v0.VerifySpaceRequest, ...client.CallOption) (*v0.VerifySpaceResponse, error)) *SearchProviderService_VerifySpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSearchProviderService creates a new instance of SearchProviderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSearchProviderService(t interface {
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+// Cache stores scan results by the checksum of the scanned content and the version of the scanner.
+// A new signature database results in a new version and therefore invalidates all previous results.
+type Cache struct {
+	store microstore.Store
+	ttl   time.Duration
+}
+
+// New returns a new cache using the given store
+func New(store microstore.Store, ttl time.Duration) *Cache {
+	return &Cache{store: store, ttl: ttl}
+}
+
+// Get returns the cached result for the given checksum and scanner version
+func (c *Cache) Get(checksum, version string) (scanners.Result, bool, error) {
+	recs, err := c.store.Read(key(checksum, version))
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return scanners.Result{}, false, nil
+	case err != nil:
+		return scanners.Result{}, false, err
+	case len(recs) == 0:
+		return scanners.Result{}, false, nil
+	}
+
+	var res scanners.Result
+	if err := json.Unmarshal(recs[0].Value, &res); err != nil {
+		return scanners.Result{}, false, err
+	}
+
+	return res, true, nil
+}
+
+// Put stores the result for the given checksum and scanner version
+func (c *Cache) Put(checksum, version string, res scanners.Result) error {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return c.store.Write(&microstore.Record{
+		Key:    key(checksum, version),
+		Value:  b,
+		Expiry: c.ttl,
+	})
+}
+
+// key combines the checksum with a hash of the version, versions can contain characters not allowed in keys
+func key(checksum, version string) string {
+	v := sha256.Sum256([]byte(version))
+	return checksum + "." + hex.EncodeToString(v[:8])
+}
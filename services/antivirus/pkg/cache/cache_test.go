@@ -0,0 +1,37 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/cache"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+func TestCache(t *testing.T) {
+	c := cache.New(microstore.NewMemoryStore(), 0)
+
+	_, ok, err := c.Get("checksum", "v1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	err = c.Put("checksum", "v1", scanners.Result{Infected: true, Description: "virus"})
+	assert.NoError(t, err)
+
+	res, ok, err := c.Get("checksum", "v1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, res.Infected)
+	assert.Equal(t, "virus", res.Description)
+
+	// a signature update invalidates the result
+	_, ok, err = c.Get("checksum", "v2")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = c.Get("other", "v1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
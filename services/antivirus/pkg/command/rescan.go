@@ -0,0 +1,59 @@
+package command
+
+import (
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/events/stream"
+	"github.com/urfave/cli/v2"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config/parser"
+)
+
+// Rescan is the entrypoint for the rescan command.
+func Rescan(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "rescan",
+		Usage: "rescan already stored files, e.g. after the virus signatures have been updated",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "space",
+				Usage: "only rescan the space with the given id",
+			},
+			&cli.DurationFlag{
+				Name:  "modified-within",
+				Usage: "only rescan files which have been modified within the given duration, e.g. '720h'. Rescans all files when not set",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			s, err := stream.NatsFromConfig(cfg.Service.Name+"-rescan", false, stream.NatsConfig(cfg.Events))
+			if err != nil {
+				return err
+			}
+
+			ev := event.StartRescan{
+				SpaceID:       c.String("space"),
+				ExecutionTime: time.Now(),
+			}
+			if d := c.Duration("modified-within"); d > 0 {
+				ev.ModifiedSince = ev.ExecutionTime.Add(-d)
+			}
+
+			if err := events.Publish(c.Context, s, ev); err != nil {
+				return err
+			}
+
+			// go-micro nats implementation uses async publishing,
+			// therefore we need to manually wait.
+			time.Sleep(5 * time.Second)
+
+			return nil
+		},
+	}
+}
@@ -3,10 +3,14 @@ package config
 import (
 	"context"
 	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 )
 
 // Config combines all available configuration parts.
 type Config struct {
+	Commons *shared.Commons `yaml:"-"` // don't use this directly as configuration for a service
+
 	File string
 	Log  *Log
 
@@ -16,13 +20,24 @@ type Config struct {
 
 	Tracing *Tracing `yaml:"tracing"`
 
-	InfectedFileHandling string `yaml:"infected-file-handling" env:"ANTIVIRUS_INFECTED_FILE_HANDLING" desc:"Defines the behaviour when a virus has been found. Supported options are: 'delete', 'continue' and 'abort '. Delete will delete the file. Continue will mark the file as infected but continues further processing. Abort will keep the file in the uploads folder for further admin inspection and will not move it to its final destination." introductionVersion:"pre5.0"`
+	InfectedFileHandling string `yaml:"infected-file-handling" env:"ANTIVIRUS_INFECTED_FILE_HANDLING" desc:"Defines the behaviour when a virus has been found. Supported options are: 'delete', 'continue', 'abort ' and 'quarantine'. Delete will delete the file. Continue will mark the file as infected but continues further processing. Abort will keep the file in the uploads folder for further admin inspection and will not move it to its final destination. Quarantine will move the file into an admin-only quarantine space, where it can be inspected, released or deleted via the graph API." introductionVersion:"pre5.0"`
 	Events               Events
 	Workers              int `yaml:"workers" env:"ANTIVIRUS_WORKERS" desc:"The number of concurrent go routines that fetch events from the event queue." introductionVersion:"7.0.0"`
 
 	Scanner     Scanner
 	MaxScanSize string `yaml:"max-scan-size" env:"ANTIVIRUS_MAX_SCAN_SIZE" desc:"The maximum scan size the virus scanner can handle. Only this many bytes of a file will be scanned. 0 means unlimited and is the default. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB." introductionVersion:"pre5.0"`
 
+	MaxScanSizeMode    string `yaml:"max-scan-size-mode" env:"ANTIVIRUS_MAX_SCAN_SIZE_MODE" desc:"Defines how files larger than ANTIVIRUS_MAX_SCAN_SIZE are handled. Supported values are 'skip' and 'chunked'. 'skip' does not scan those files at all. 'chunked' scans them in overlapping windows of ANTIVIRUS_MAX_SCAN_SIZE bytes." introductionVersion:"7.0.0"`
+	ChunkedScanOverlap string `yaml:"chunked-scan-overlap" env:"ANTIVIRUS_CHUNKED_SCAN_OVERLAP" desc:"The number of bytes two consecutive windows overlap when ANTIVIRUS_MAX_SCAN_SIZE_MODE is 'chunked'. Must be smaller than ANTIVIRUS_MAX_SCAN_SIZE. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 1MB." introductionVersion:"7.0.0"`
+
+	ScanCache  ScanCache  `yaml:"scan_cache"`
+	Rescan     Rescan     `yaml:"rescan"`
+	Quarantine Quarantine `yaml:"quarantine"`
+
+	RevaGateway    string                `yaml:"reva_gateway" env:"OCIS_REVA_GATEWAY" desc:"CS3 gateway used to walk the spaces and download files when rescanning stored files." introductionVersion:"7.0.0"`
+	GRPCClientTLS  *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
+	ServiceAccount ServiceAccount        `yaml:"service_account"`
+
 	Context context.Context `json:"-" yaml:"-"`
 
 	DebugScanOutcome string `yaml:"-" env:"ANTIVIRUS_DEBUG_SCAN_OUTCOME" desc:"A predefined outcome for virus scanning, FOR DEBUG PURPOSES ONLY! (example values: 'found,infected')" introductionVersion:"pre5.0"`
@@ -60,12 +75,52 @@ type Events struct {
 	AuthPassword         string `yaml:"password" env:"OCIS_EVENTS_AUTH_PASSWORD;ANTIVIRUS_EVENTS_AUTH_PASSWORD" desc:"The password to authenticate with the events broker. The events broker is the ocis service which receives and delivers events between the services." introductionVersion:"5.0"`
 }
 
+// ScanCache configures the cache for scan results
+type ScanCache struct {
+	Enabled      bool          `yaml:"enabled" env:"ANTIVIRUS_SCAN_CACHE_ENABLED" desc:"Cache scan results by the checksum of the scanned content and the signature version of the scanner. Identical files are not scanned again as long as the signatures did not change." introductionVersion:"7.0.0"`
+	Store        string        `yaml:"store" env:"OCIS_PERSISTENT_STORE;ANTIVIRUS_SCAN_CACHE_STORE" desc:"The type of the store. Supported values are: 'memory', 'redis-sentinel', 'nats-js-kv', 'noop'. See the text description for details." introductionVersion:"7.0.0"`
+	Nodes        []string      `yaml:"nodes" env:"OCIS_PERSISTENT_STORE_NODES;ANTIVIRUS_SCAN_CACHE_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	Database     string        `yaml:"database" env:"ANTIVIRUS_SCAN_CACHE_STORE_DATABASE" desc:"The database name the configured store should use." introductionVersion:"7.0.0"`
+	Table        string        `yaml:"table" env:"ANTIVIRUS_SCAN_CACHE_STORE_TABLE" desc:"The database table the store should use." introductionVersion:"7.0.0"`
+	TTL          time.Duration `yaml:"ttl" env:"ANTIVIRUS_SCAN_CACHE_TTL" desc:"Time to live for scan results in the store. Results are invalidated earlier when the signatures of the scanner change. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	AuthUsername string        `yaml:"username" env:"OCIS_PERSISTENT_STORE_AUTH_USERNAME;ANTIVIRUS_SCAN_CACHE_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+	AuthPassword string        `yaml:"password" env:"OCIS_PERSISTENT_STORE_AUTH_PASSWORD;ANTIVIRUS_SCAN_CACHE_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+}
+
+// Rescan configures the rescan of already stored files
+type Rescan struct {
+	Interval       time.Duration `yaml:"interval" env:"ANTIVIRUS_RESCAN_INTERVAL" desc:"The interval in which the antivirus service checks for signature updates of the scanner. A rescan of the stored files is started when the signatures changed. If the scanner does not report its signature version, a rescan is started in every interval. 0 disables scheduled rescans and is the default. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	ModifiedWithin time.Duration `yaml:"modified_within" env:"ANTIVIRUS_RESCAN_MODIFIED_WITHIN" desc:"Only files modified within this duration before the start of a scheduled rescan are scanned. 0 means all files are scanned. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+}
+
+// Quarantine configures the quarantine space for infected files
+type Quarantine struct {
+	StorageAddress   string `yaml:"storage_addr" env:"ANTIVIRUS_QUARANTINE_STORAGE_GRPC_ADDR" desc:"GRPC address of the STORAGE-SYSTEM service holding the quarantine space." introductionVersion:"7.0.0"`
+	SystemUserID     string `yaml:"system_user_id" env:"OCIS_SYSTEM_USER_ID;ANTIVIRUS_SYSTEM_USER_ID" desc:"ID of the oCIS STORAGE-SYSTEM system user. Admins need to set the ID for the STORAGE-SYSTEM system user in this config option which is then used to reference the user. Any reasonable long string is possible, preferably this would be an UUIDv4 format." introductionVersion:"7.0.0"`
+	SystemUserIDP    string `yaml:"system_user_idp" env:"OCIS_SYSTEM_USER_IDP;ANTIVIRUS_SYSTEM_USER_IDP" desc:"IDP of the oCIS STORAGE-SYSTEM system user." introductionVersion:"7.0.0"`
+	SystemUserAPIKey string `yaml:"system_user_api_key" env:"OCIS_SYSTEM_USER_API_KEY" desc:"API key for the STORAGE-SYSTEM system user." introductionVersion:"7.0.0"`
+}
+
+// ServiceAccount is the configuration for the used service account
+type ServiceAccount struct {
+	ServiceAccountID     string `yaml:"service_account_id" env:"OCIS_SERVICE_ACCOUNT_ID;ANTIVIRUS_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use. See the 'auth-service' service description for more details." introductionVersion:"7.0.0"`
+	ServiceAccountSecret string `yaml:"service_account_secret" env:"OCIS_SERVICE_ACCOUNT_SECRET;ANTIVIRUS_SERVICE_ACCOUNT_SECRET" desc:"The service account secret." introductionVersion:"7.0.0"`
+}
+
 // Scanner provides configuration options for the virus scanner
 type Scanner struct {
-	Type string `yaml:"type" env:"ANTIVIRUS_SCANNER_TYPE" desc:"The antivirus scanner to use. Supported values are 'clamav' and 'icap'." introductionVersion:"pre5.0"`
+	Type string `yaml:"type" env:"ANTIVIRUS_SCANNER_TYPE" desc:"The antivirus scanner to use. Supported values are 'clamav', 'icap' and 'pipeline'. Use 'pipeline' to combine multiple scanners." introductionVersion:"pre5.0"`
+
+	ClamAV   ClamAV   // only if Type == clamav or part of the pipeline
+	ICAP     ICAP     // only if Type == icap or part of the pipeline
+	Pipeline Pipeline // only if Type == pipeline
+}
 
-	ClamAV ClamAV // only if Type == clamav
-	ICAP   ICAP   // only if Type == icap
+// Pipeline provides configuration options for combining multiple scanners
+type Pipeline struct {
+	Scanners []string `yaml:"scanners" env:"ANTIVIRUS_PIPELINE_SCANNERS" desc:"An ordered list of scanners used when the scanner type is 'pipeline'. Supported values are 'clamav' and 'icap'. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	Policy   string   `yaml:"policy" env:"ANTIVIRUS_PIPELINE_POLICY" desc:"Defines how the results of the pipeline scanners are merged. Supported values are 'any', 'quorum' and 'fallback'. 'any' reports a file as infected if one scanner detects a virus, 'quorum' if at least ANTIVIRUS_PIPELINE_QUORUM scanners detect a virus and 'fallback' uses the result of the first scanner, in configured order, that is reachable." introductionVersion:"7.0.0"`
+	Quorum   int      `yaml:"quorum" env:"ANTIVIRUS_PIPELINE_QUORUM" desc:"The number of scanners that need to detect a virus when the pipeline policy is 'quorum'." introductionVersion:"7.0.0"`
 }
 
 // ClamAV provides configuration option for clamav
@@ -3,6 +3,8 @@ package defaults
 import (
 	"time"
 
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
+	"github.com/owncloud/ocis/v2/ocis-pkg/structs"
 	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config"
 )
 
@@ -30,6 +32,8 @@ func DefaultConfig() *config.Config {
 		},
 		Workers:              10,
 		InfectedFileHandling: "delete",
+		MaxScanSizeMode:      "skip",
+		ChunkedScanOverlap:   "1MB",
 		Scanner: config.Scanner{
 			Type: "clamav",
 			ClamAV: config.ClamAV{
@@ -40,7 +44,27 @@ func DefaultConfig() *config.Config {
 				Service: "avscan",
 				Timeout: 5 * time.Minute,
 			},
+			Pipeline: config.Pipeline{
+				Scanners: []string{"clamav", "icap"},
+				Policy:   "any",
+				Quorum:   2,
+			},
+		},
+		ScanCache: config.ScanCache{
+			Store:    "nats-js-kv",
+			Nodes:    []string{"127.0.0.1:9233"},
+			Database: "antivirus",
+			Table:    "",
+			TTL:      7 * 24 * time.Hour,
+		},
+		Rescan: config.Rescan{
+			ModifiedWithin: 30 * 24 * time.Hour,
 		},
+		Quarantine: config.Quarantine{
+			StorageAddress: "com.owncloud.api.storage-system",
+			SystemUserIDP:  "internal",
+		},
+		RevaGateway: shared.DefaultRevaConfig().Address,
 	}
 }
 
@@ -53,6 +77,18 @@ func EnsureDefaults(cfg *config.Config) {
 	if cfg.Tracing == nil {
 		cfg.Tracing = &config.Tracing{}
 	}
+
+	if cfg.GRPCClientTLS == nil && cfg.Commons != nil {
+		cfg.GRPCClientTLS = structs.CopyOrZeroValue(cfg.Commons.GRPCClientTLS)
+	}
+
+	if cfg.Quarantine.SystemUserAPIKey == "" && cfg.Commons != nil && cfg.Commons.SystemUserAPIKey != "" {
+		cfg.Quarantine.SystemUserAPIKey = cfg.Commons.SystemUserAPIKey
+	}
+
+	if cfg.Quarantine.SystemUserID == "" && cfg.Commons != nil && cfg.Commons.SystemUserID != "" {
+		cfg.Quarantine.SystemUserID = cfg.Commons.SystemUserID
+	}
 }
 
 // Sanitize sanitizes the configuration
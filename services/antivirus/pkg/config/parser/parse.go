@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config"
 	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config/defaults"
 
@@ -34,5 +35,23 @@ func ParseConfig(cfg *config.Config) error {
 
 // Validate validates our little config
 func Validate(cfg *config.Config) error {
+	if cfg.Rescan.Interval > 0 {
+		if cfg.ServiceAccount.ServiceAccountID == "" {
+			return shared.MissingServiceAccountID(cfg.Service.Name)
+		}
+		if cfg.ServiceAccount.ServiceAccountSecret == "" {
+			return shared.MissingServiceAccountSecret(cfg.Service.Name)
+		}
+	}
+
+	if cfg.InfectedFileHandling == "quarantine" {
+		if cfg.Quarantine.SystemUserAPIKey == "" {
+			return shared.MissingSystemUserApiKeyError(cfg.Service.Name)
+		}
+		if cfg.Quarantine.SystemUserID == "" {
+			return shared.MissingSystemUserID(cfg.Service.Name)
+		}
+	}
+
 	return nil
 }
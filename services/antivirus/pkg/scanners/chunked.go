@@ -0,0 +1,102 @@
+package scanners
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Window describes a range of bytes which was scanned
+type Window struct {
+	Offset int64
+	Size   int64
+}
+
+// NewChunked returns a Scanner which scans the input in overlapping windows of the given size.
+// The overlap makes sure that signatures crossing the border of two windows are detected as well.
+func NewChunked(engine Engine, window, overlap int64) (*Chunked, error) {
+	if window <= 0 {
+		return nil, errors.New("chunked scanning needs a window size")
+	}
+
+	if overlap < 0 || overlap >= window {
+		return nil, fmt.Errorf("chunked scan overlap must be smaller than the window size of %d bytes, got %d", window, overlap)
+	}
+
+	return &Chunked{engine: engine, window: window, overlap: overlap}, nil
+}
+
+// Chunked is a Scanner which is able to scan inputs larger than the underlying scanner can handle
+type Chunked struct {
+	engine  Engine
+	window  int64
+	overlap int64
+}
+
+// Version returns the version of the underlying scanner
+func (c *Chunked) Version() (string, error) {
+	v, ok := c.engine.(Versioner)
+	if !ok {
+		return "", ErrNoVersion
+	}
+
+	return v.Version()
+}
+
+// Scan scans the input window by window, it stops at the first window which is infected
+func (c *Chunked) Scan(in Input) (Result, error) {
+	// small inputs fit into a single window and don't need to be buffered
+	if 0 < in.Size && in.Size <= c.window {
+		res, err := c.engine.Scan(in)
+		res.Windows = []Window{{Offset: 0, Size: in.Size}}
+		return res, err
+	}
+
+	var (
+		buf     = make([]byte, c.window)
+		filled  int
+		offset  int64
+		windows []Window
+	)
+
+	for {
+		n, err := io.ReadFull(in.Body, buf[filled:])
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return Result{Windows: windows}, err
+		}
+
+		// nothing new was read, the remaining bytes are part of the last window already
+		if n == 0 && (filled == 0 || len(windows) > 0) {
+			break
+		}
+		filled += n
+
+		window := Window{Offset: offset, Size: int64(filled)}
+		windows = append(windows, window)
+
+		res, err := c.engine.Scan(Input{Body: bytes.NewReader(buf[:filled]), Size: int64(filled), Url: in.Url, Name: in.Name})
+		if err != nil {
+			return Result{Windows: windows}, fmt.Errorf("scanning bytes %d-%d: %w", window.Offset, window.Offset+window.Size-1, err)
+		}
+
+		if res.Infected {
+			res.Description = fmt.Sprintf("%s (bytes %d-%d)", res.Description, window.Offset, window.Offset+window.Size-1)
+			res.Windows = windows
+			return res, nil
+		}
+
+		if eof {
+			break
+		}
+
+		// keep the end of the current window as the beginning of the next one
+		copy(buf, buf[filled-int(c.overlap):filled])
+		offset += int64(filled) - c.overlap
+		filled = int(c.overlap)
+	}
+
+	return Result{ScanTime: time.Now(), Windows: windows}, nil
+}
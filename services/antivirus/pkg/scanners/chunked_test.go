@@ -0,0 +1,84 @@
+package scanners_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+// windowEngine records all windows and reports windows containing the signature as infected
+type windowEngine struct {
+	signature []byte
+	windows   [][]byte
+}
+
+func (e *windowEngine) Scan(in scanners.Input) (scanners.Result, error) {
+	b, err := io.ReadAll(in.Body)
+	if err != nil {
+		return scanners.Result{}, err
+	}
+	e.windows = append(e.windows, b)
+
+	if len(e.signature) > 0 && bytes.Contains(b, e.signature) {
+		return scanners.Result{Infected: true, Description: "found"}, nil
+	}
+	return scanners.Result{}, nil
+}
+
+func TestNewChunked(t *testing.T) {
+	_, err := scanners.NewChunked(&windowEngine{}, 0, 0)
+	assert.Error(t, err)
+
+	_, err = scanners.NewChunked(&windowEngine{}, 10, 10)
+	assert.Error(t, err)
+
+	_, err = scanners.NewChunked(&windowEngine{}, 10, 2)
+	assert.NoError(t, err)
+}
+
+func TestChunked_Scan(t *testing.T) {
+	t.Run("input fits into a single window", func(t *testing.T) {
+		e := &windowEngine{}
+		c, _ := scanners.NewChunked(e, 10, 2)
+
+		res, err := c.Scan(scanners.Input{Body: bytes.NewReader([]byte("0123456789")), Size: 10})
+		assert.NoError(t, err)
+		assert.False(t, res.Infected)
+		assert.Equal(t, []scanners.Window{{Offset: 0, Size: 10}}, res.Windows)
+	})
+
+	t.Run("windows overlap", func(t *testing.T) {
+		e := &windowEngine{}
+		c, _ := scanners.NewChunked(e, 10, 2)
+
+		res, err := c.Scan(scanners.Input{Body: bytes.NewReader([]byte("0123456789abcdefghij")), Size: 20})
+		assert.NoError(t, err)
+		assert.False(t, res.Infected)
+		assert.Equal(t, []scanners.Window{{Offset: 0, Size: 10}, {Offset: 8, Size: 10}, {Offset: 16, Size: 4}}, res.Windows)
+		assert.Equal(t, [][]byte{[]byte("0123456789"), []byte("89abcdefgh"), []byte("ghij")}, e.windows)
+	})
+
+	t.Run("signature crossing the window border is found", func(t *testing.T) {
+		e := &windowEngine{signature: []byte("9a")}
+		c, _ := scanners.NewChunked(e, 10, 2)
+
+		res, err := c.Scan(scanners.Input{Body: bytes.NewReader([]byte("0123456789abcdefghij")), Size: 20})
+		assert.NoError(t, err)
+		assert.True(t, res.Infected)
+		assert.Equal(t, "found (bytes 8-17)", res.Description)
+		assert.Len(t, res.Windows, 2)
+	})
+
+	t.Run("errors are reported with the window", func(t *testing.T) {
+		c, _ := scanners.NewChunked(&engine{err: errors.New("unreachable")}, 10, 2)
+
+		res, err := c.Scan(scanners.Input{Body: bytes.NewReader(make([]byte, 20)), Size: 20})
+		assert.ErrorContains(t, err, "bytes 0-9")
+		assert.Len(t, res.Windows, 1)
+	})
+}
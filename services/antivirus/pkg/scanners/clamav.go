@@ -1,6 +1,7 @@
 package scanners
 
 import (
+	"errors"
 	"time"
 
 	"github.com/dutchcoders/go-clamd"
@@ -32,3 +33,17 @@ func (s ClamAV) Scan(in Input) (Result, error) {
 		ScanTime:    time.Now(),
 	}, nil
 }
+
+// Version returns the version of clamav including the version of its signature database
+func (s ClamAV) Version() (string, error) {
+	ch, err := s.clamd.Version()
+	if err != nil {
+		return "", err
+	}
+
+	r, ok := <-ch
+	if !ok || r == nil {
+		return "", errors.New("no version reported by clamav")
+	}
+	return r.Raw, nil
+}
@@ -2,6 +2,7 @@ package scanners
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -45,6 +46,25 @@ type ICAP struct {
 	URL    string
 }
 
+// Version returns the ISTag of the ICAP service which changes whenever the service, e.g. its signature database, is updated
+func (s ICAP) Version() (string, error) {
+	optReq, err := ic.NewRequest(context.TODO(), ic.MethodOPTIONS, s.URL, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	optRes, err := s.Client.Do(optReq)
+	if err != nil {
+		return "", err
+	}
+
+	tag := optRes.Header.Get("ISTag")
+	if tag == "" {
+		return "", errors.New("no ISTag reported by icap service")
+	}
+	return tag, nil
+}
+
 // Scan scans a file using the ICAP server
 func (s ICAP) Scan(in Input) (Result, error) {
 	ctx := context.TODO()
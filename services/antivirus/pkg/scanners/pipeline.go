@@ -0,0 +1,224 @@
+package scanners
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PipelinePolicy defines how the results of multiple scanners are merged into a single verdict
+type PipelinePolicy string
+
+const (
+	// PolicyAny reports a file as infected as soon as one scanner detects a virus.
+	// A file is only reported as clean if all scanners were able to scan it.
+	PolicyAny PipelinePolicy = "any"
+	// PolicyQuorum reports a file as infected if at least quorum scanners detect a virus.
+	// A file is reported as clean once the quorum can not be reached anymore.
+	PolicyQuorum PipelinePolicy = "quorum"
+	// PolicyFallback uses the verdict of the first scanner, in configured order, which was able to scan the file.
+	PolicyFallback PipelinePolicy = "fallback"
+)
+
+var (
+	// ErrNoVerdict is returned when the pipeline can not come to a verdict because too many scanners failed
+	ErrNoVerdict = errors.New("no verdict")
+
+	// ErrNoVersion is returned when a scanner is not able to report its version
+	ErrNoVersion = errors.New("scanner does not report a version")
+
+	errStageDone = errors.New("stage done")
+)
+
+// Engine is the interface every scanner taking part in a Pipeline needs to implement
+type Engine interface {
+	Scan(in Input) (Result, error)
+}
+
+// Stage is a named scanner of a Pipeline
+type Stage struct {
+	Name   string
+	Engine Engine
+}
+
+// NewPipeline returns a Scanner which runs all stages on the same input and merges the results according to the policy
+func NewPipeline(policy PipelinePolicy, quorum int, stages ...Stage) (*Pipeline, error) {
+	if len(stages) == 0 {
+		return nil, errors.New("pipeline needs at least one scanner")
+	}
+
+	switch policy {
+	case PolicyAny, PolicyFallback:
+	case PolicyQuorum:
+		if quorum < 1 || quorum > len(stages) {
+			return nil, fmt.Errorf("pipeline quorum must be between 1 and %d, got %d", len(stages), quorum)
+		}
+	default:
+		return nil, fmt.Errorf("unknown pipeline policy: '%s'", policy)
+	}
+
+	return &Pipeline{policy: policy, quorum: quorum, stages: stages}, nil
+}
+
+// Pipeline is a Scanner combining the verdicts of multiple scanners
+type Pipeline struct {
+	policy PipelinePolicy
+	quorum int
+	stages []Stage
+}
+
+type stageOutcome struct {
+	result Result
+	err    error
+}
+
+// Scan streams the input to all scanners concurrently and merges their results
+func (p *Pipeline) Scan(in Input) (Result, error) {
+	outcomes := make([]stageOutcome, len(p.stages))
+	readers := make([]*io.PipeReader, len(p.stages))
+	writers := make([]*io.PipeWriter, len(p.stages))
+	for i := range p.stages {
+		readers[i], writers[i] = io.Pipe()
+	}
+
+	wg := sync.WaitGroup{}
+	for i, stage := range p.stages {
+		wg.Add(1)
+		go func(i int, stage Stage) {
+			defer wg.Done()
+
+			stageIn := in
+			if in.Body != nil {
+				stageIn.Body = readers[i]
+			}
+
+			res, err := stage.Engine.Scan(stageIn)
+			// the scanner might not have consumed the whole body, unblock the fan out
+			readers[i].CloseWithError(errStageDone)
+			outcomes[i] = stageOutcome{result: res, err: err}
+		}(i, stage)
+	}
+
+	var err error
+	if in.Body != nil {
+		_, err = io.Copy(&fanOut{writers: append([]*io.PipeWriter(nil), writers...)}, in.Body)
+		if errors.Is(err, errStageDone) {
+			err = nil
+		}
+	}
+	for _, w := range writers {
+		_ = w.CloseWithError(err)
+	}
+	wg.Wait()
+
+	return p.merge(outcomes)
+}
+
+// Version combines the versions of all scanners, it fails if one of the scanners can not report its version
+func (p *Pipeline) Version() (string, error) {
+	versions := make([]string, 0, len(p.stages))
+	for _, stage := range p.stages {
+		v, ok := stage.Engine.(Versioner)
+		if !ok {
+			return "", fmt.Errorf("%s: %w", stage.Name, ErrNoVersion)
+		}
+
+		version, err := v.Version()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", stage.Name, err)
+		}
+		versions = append(versions, stage.Name+": "+version)
+	}
+
+	return strings.Join(versions, "; "), nil
+}
+
+func (p *Pipeline) merge(outcomes []stageOutcome) (Result, error) {
+	var (
+		detections []Detection
+		errs       []error
+	)
+	for i, o := range outcomes {
+		name := p.stages[i].Name
+		switch {
+		case o.err != nil:
+			errs = append(errs, fmt.Errorf("%s: %w", name, o.err))
+		case o.result.Infected:
+			detections = append(detections, Detection{Scanner: name, Description: o.result.Description})
+		}
+	}
+
+	switch p.policy {
+	case PolicyAny:
+		if len(detections) > 0 {
+			return newMergedResult(detections), nil
+		}
+		if len(errs) > 0 {
+			return Result{}, fmt.Errorf("%w: %w", ErrNoVerdict, errors.Join(errs...))
+		}
+	case PolicyQuorum:
+		if len(detections) >= p.quorum {
+			return newMergedResult(detections), nil
+		}
+		// the failed scanners could have reached the quorum, so the file can't be considered clean
+		if len(detections)+len(errs) >= p.quorum {
+			return Result{}, fmt.Errorf("%w: %w", ErrNoVerdict, errors.Join(errs...))
+		}
+	case PolicyFallback:
+		for i, o := range outcomes {
+			if o.err != nil {
+				continue
+			}
+			if o.result.Infected {
+				return newMergedResult([]Detection{{Scanner: p.stages[i].Name, Description: o.result.Description}}), nil
+			}
+			return Result{ScanTime: time.Now()}, nil
+		}
+		return Result{}, fmt.Errorf("%w: %w", ErrNoVerdict, errors.Join(errs...))
+	}
+
+	return Result{ScanTime: time.Now()}, nil
+}
+
+func newMergedResult(detections []Detection) Result {
+	descriptions := make([]string, 0, len(detections))
+	for _, d := range detections {
+		descriptions = append(descriptions, d.Scanner+": "+d.Description)
+	}
+
+	return Result{
+		Infected:    true,
+		Description: strings.Join(descriptions, "; "),
+		ScanTime:    time.Now(),
+		Detections:  detections,
+	}
+}
+
+// fanOut writes to all pipes which are still consumed by their scanner
+type fanOut struct {
+	writers []*io.PipeWriter
+}
+
+func (f *fanOut) Write(b []byte) (int, error) {
+	active := 0
+	for i, w := range f.writers {
+		if w == nil {
+			continue
+		}
+
+		if _, err := w.Write(b); err != nil {
+			f.writers[i] = nil
+			continue
+		}
+		active++
+	}
+
+	if active == 0 {
+		return 0, errStageDone
+	}
+
+	return len(b), nil
+}
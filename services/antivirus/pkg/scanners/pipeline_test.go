@@ -0,0 +1,170 @@
+package scanners_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+type engine struct {
+	result scanners.Result
+	err    error
+	read   []byte
+}
+
+func (e *engine) Scan(in scanners.Input) (scanners.Result, error) {
+	// a broken engine does not consume the body at all
+	if e.err != nil || in.Body == nil {
+		return e.result, e.err
+	}
+
+	e.read, _ = io.ReadAll(in.Body)
+	return e.result, nil
+}
+
+func TestNewPipeline(t *testing.T) {
+	stage := scanners.Stage{Name: "test", Engine: &engine{}}
+
+	_, err := scanners.NewPipeline(scanners.PolicyAny, 0)
+	assert.Error(t, err)
+
+	_, err = scanners.NewPipeline("unknown", 0, stage)
+	assert.Error(t, err)
+
+	_, err = scanners.NewPipeline(scanners.PolicyQuorum, 2, stage)
+	assert.Error(t, err)
+
+	_, err = scanners.NewPipeline(scanners.PolicyQuorum, 1, stage)
+	assert.NoError(t, err)
+}
+
+func TestPipeline_Scan(t *testing.T) {
+	var (
+		clean    = func() *engine { return &engine{} }
+		infected = func(desc string) *engine {
+			return &engine{result: scanners.Result{Infected: true, Description: desc}}
+		}
+		broken = func() *engine { return &engine{err: errors.New("unreachable")} }
+	)
+
+	t.Run("all scanners receive the whole body", func(t *testing.T) {
+		a, b := clean(), clean()
+		p, err := scanners.NewPipeline(scanners.PolicyAny, 0, scanners.Stage{Name: "a", Engine: a}, scanners.Stage{Name: "b", Engine: b})
+		assert.NoError(t, err)
+
+		body := bytes.Repeat([]byte("x"), 1<<20)
+		res, err := p.Scan(scanners.Input{Body: bytes.NewReader(body)})
+		assert.NoError(t, err)
+		assert.False(t, res.Infected)
+		assert.Equal(t, body, a.read)
+		assert.Equal(t, body, b.read)
+	})
+
+	t.Run("a scanner that stops reading does not block the others", func(t *testing.T) {
+		a, b := broken(), clean()
+		p, err := scanners.NewPipeline(scanners.PolicyFallback, 0, scanners.Stage{Name: "a", Engine: a}, scanners.Stage{Name: "b", Engine: b})
+		assert.NoError(t, err)
+
+		res, err := p.Scan(scanners.Input{Body: bytes.NewReader(make([]byte, 1<<20))})
+		assert.NoError(t, err)
+		assert.False(t, res.Infected)
+		assert.Len(t, b.read, 1<<20)
+	})
+
+	t.Run("any", func(t *testing.T) {
+		t.Run("one detection wins", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyAny, 0,
+				scanners.Stage{Name: "clamav", Engine: infected("Eicar-Signature")},
+				scanners.Stage{Name: "icap", Engine: broken()},
+			)
+			res, err := p.Scan(scanners.Input{})
+			assert.NoError(t, err)
+			assert.True(t, res.Infected)
+			assert.Equal(t, "clamav: Eicar-Signature", res.Description)
+			assert.Equal(t, []scanners.Detection{{Scanner: "clamav", Description: "Eicar-Signature"}}, res.Detections)
+		})
+
+		t.Run("all detections are recorded", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyAny, 0,
+				scanners.Stage{Name: "clamav", Engine: infected("Eicar-Signature")},
+				scanners.Stage{Name: "icap", Engine: infected("EICAR test file")},
+			)
+			res, err := p.Scan(scanners.Input{})
+			assert.NoError(t, err)
+			assert.True(t, res.Infected)
+			assert.Equal(t, "clamav: Eicar-Signature; icap: EICAR test file", res.Description)
+			assert.Len(t, res.Detections, 2)
+		})
+
+		t.Run("clean requires all scanners", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyAny, 0,
+				scanners.Stage{Name: "clamav", Engine: clean()},
+				scanners.Stage{Name: "icap", Engine: broken()},
+			)
+			_, err := p.Scan(scanners.Input{})
+			assert.ErrorIs(t, err, scanners.ErrNoVerdict)
+		})
+	})
+
+	t.Run("quorum", func(t *testing.T) {
+		t.Run("quorum reached", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyQuorum, 2,
+				scanners.Stage{Name: "a", Engine: infected("virus")},
+				scanners.Stage{Name: "b", Engine: infected("virus")},
+				scanners.Stage{Name: "c", Engine: clean()},
+			)
+			res, err := p.Scan(scanners.Input{})
+			assert.NoError(t, err)
+			assert.True(t, res.Infected)
+		})
+
+		t.Run("quorum can not be reached anymore", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyQuorum, 2,
+				scanners.Stage{Name: "a", Engine: infected("virus")},
+				scanners.Stage{Name: "b", Engine: clean()},
+				scanners.Stage{Name: "c", Engine: clean()},
+			)
+			res, err := p.Scan(scanners.Input{})
+			assert.NoError(t, err)
+			assert.False(t, res.Infected)
+		})
+
+		t.Run("undecided", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyQuorum, 2,
+				scanners.Stage{Name: "a", Engine: infected("virus")},
+				scanners.Stage{Name: "b", Engine: broken()},
+				scanners.Stage{Name: "c", Engine: clean()},
+			)
+			_, err := p.Scan(scanners.Input{})
+			assert.ErrorIs(t, err, scanners.ErrNoVerdict)
+		})
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		t.Run("first reachable scanner decides", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyFallback, 0,
+				scanners.Stage{Name: "a", Engine: broken()},
+				scanners.Stage{Name: "b", Engine: infected("virus")},
+				scanners.Stage{Name: "c", Engine: clean()},
+			)
+			res, err := p.Scan(scanners.Input{})
+			assert.NoError(t, err)
+			assert.True(t, res.Infected)
+			assert.Equal(t, "b: virus", res.Description)
+		})
+
+		t.Run("all scanners unreachable", func(t *testing.T) {
+			p, _ := scanners.NewPipeline(scanners.PolicyFallback, 0,
+				scanners.Stage{Name: "a", Engine: broken()},
+				scanners.Stage{Name: "b", Engine: broken()},
+			)
+			_, err := p.Scan(scanners.Input{})
+			assert.ErrorIs(t, err, scanners.ErrNoVerdict)
+		})
+	})
+}
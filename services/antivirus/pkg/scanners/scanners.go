@@ -5,11 +5,24 @@ import (
 	"time"
 )
 
+// Versioner is implemented by scanners which are able to report the version of their signature database
+type Versioner interface {
+	Version() (string, error)
+}
+
 // The Result is the common scan result to all scanners
 type Result struct {
 	Infected    bool
 	ScanTime    time.Time
 	Description string
+	Detections  []Detection
+	Windows     []Window
+}
+
+// Detection is a single finding of one scanner
+type Detection struct {
+	Scanner     string
+	Description string
 }
 
 // The Input is the common input to all scanners
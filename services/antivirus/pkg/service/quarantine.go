@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/quarantine"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+// _outcomeQuarantine moves infected files into the quarantine space. It is not a postprocessing outcome,
+// postprocessing is told to delete the upload once the file is quarantined.
+const _outcomeQuarantine events.PostprocessingOutcome = "quarantine"
+
+// quarantineUpload moves an infected upload into the quarantine space and returns the outcome for postprocessing.
+// Uploads with content an admin released before are reported as clean.
+func (av Antivirus) quarantineUpload(ctx context.Context, ev events.StartPostprocessingStep, res scanners.Result) (scanners.Result, events.PostprocessingOutcome) {
+	l := log.Logger{Logger: av.l.With().Str("uploadid", ev.UploadID).Logger()}
+
+	rrc, err := av.download(ev)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot download infected file for quarantine")
+		return res, events.PPOutcomeAbort
+	}
+	defer rrc.Close()
+
+	item := quarantine.Item{
+		Name:         ev.Filename,
+		UploaderID:   ev.ExecutingUser.GetId().GetOpaqueId(),
+		UploaderName: ev.ExecutingUser.GetUsername(),
+		Virus:        res.Description,
+		Scandate:     res.ScanTime,
+	}
+	if rid := ev.ResourceID; rid != nil {
+		item.SpaceID = storagespace.FormatStorageID(rid.GetStorageId(), rid.GetSpaceId())
+		item.ResourceID = storagespace.FormatResourceID(rid)
+		item.Path = av.originalPath(ctx, rid, l)
+	}
+
+	// the checksum is only known once the content was streamed into quarantine
+	item, err = av.q.Add(ctx, item, rrc)
+	if err != nil {
+		// keep the file in the uploads folder, it must not be lost
+		l.Error().Err(err).Msg("cannot move infected file to quarantine")
+		return res, events.PPOutcomeAbort
+	}
+
+	if av.isReleased(ctx, item, l) {
+		return scanners.Result{ScanTime: res.ScanTime, Description: "released from quarantine: " + res.Description}, events.PPOutcomeContinue
+	}
+
+	l.Info().Str("quarantineid", item.ID).Msg("Infected file moved to quarantine")
+	return res, events.PPOutcomeDelete
+}
+
+// quarantineStoredFile moves an infected stored file into the quarantine space and deletes it from its space.
+// The second return value is true if an admin released the content before.
+func (av Antivirus) quarantineStoredFile(ctx context.Context, gwc gateway.GatewayAPIClient, space *provider.StorageSpace, info *provider.ResourceInfo, p string, res scanners.Result, l log.Logger) (events.PostprocessingOutcome, bool) {
+	rrc, err := av.downloadStoredFile(ctx, gwc, info)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot download infected file for quarantine")
+		return events.PPOutcomeContinue, false
+	}
+	defer rrc.Close()
+
+	item, err := av.q.Add(ctx, quarantine.Item{
+		Name:       info.GetName(),
+		Path:       p,
+		SpaceID:    space.GetId().GetOpaqueId(),
+		ResourceID: storagespace.FormatResourceID(info.GetId()),
+		Virus:      res.Description,
+		Scandate:   res.ScanTime,
+	}, rrc)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot move infected file to quarantine")
+		return events.PPOutcomeContinue, false
+	}
+
+	if av.isReleased(ctx, item, l) {
+		return events.PPOutcomeContinue, true
+	}
+	l.Info().Str("quarantineid", item.ID).Msg("Infected file moved to quarantine")
+
+	if !av.deleteStoredFile(ctx, gwc, info, l) {
+		// the file could not be deleted, it is still there
+		return events.PPOutcomeContinue, false
+	}
+	return _outcomeQuarantine, false
+}
+
+// isReleased returns true if the quarantined file is an item an admin restored to its space before.
+// The new item is removed from quarantine again in that case.
+func (av Antivirus) isReleased(ctx context.Context, item quarantine.Item, l log.Logger) bool {
+	released, err := av.q.IsReleased(ctx, item.SpaceID, item.ResourceID, item.Name, item.Checksum)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot check if the file was released from quarantine")
+		return false
+	}
+	if !released {
+		return false
+	}
+
+	l.Info().Msg("File was released from quarantine by an admin, it is not quarantined again")
+	if err := av.q.Delete(ctx, item.ID); err != nil {
+		l.Error().Err(err).Str("quarantineid", item.ID).Msg("cannot remove released file from quarantine")
+	}
+	return true
+}
+
+// originalPath looks up the path of the upload, it is only available when a service account is configured
+func (av Antivirus) originalPath(ctx context.Context, rid *provider.ResourceId, l log.Logger) string {
+	if av.c.ServiceAccount.ServiceAccountID == "" {
+		return ""
+	}
+
+	gwc, err := av.gatewaySelector.Next()
+	if err != nil {
+		l.Error().Err(err).Msg("cannot get gateway client")
+		return ""
+	}
+
+	ctx, err = av.serviceUserContext(ctx, gwc)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot authenticate service account")
+		return ""
+	}
+
+	res, err := gwc.GetPath(ctx, &provider.GetPathRequest{ResourceId: rid})
+	switch {
+	case err != nil:
+		l.Error().Err(err).Msg("cannot get path of infected file")
+		return ""
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		l.Error().Str("status", res.GetStatus().GetMessage()).Msg("cannot get path of infected file")
+		return ""
+	}
+
+	return utils.MakeRelativePath(res.GetPath())
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/storage/utils/walker"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/ocis-pkg/lease"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
+)
+
+const (
+	_spaceTypePersonal = "personal"
+	_spaceTypeProject  = "project"
+
+	// _rescanLeaseKey is the store key of the lease of the replica which requests the rescans
+	_rescanLeaseKey = "lease-rescan"
+)
+
+// scheduleRescans checks the signature version of the scanner in the configured interval
+// and requests a rescan of the stored files whenever it changed.
+func (av Antivirus) scheduleRescans(ctx context.Context, s events.Publisher) {
+	// the version stays empty if the scanner is not reachable yet, it is picked up with a later tick
+	version, err := av.signatureVersion()
+	versioned := !errors.Is(err, scanners.ErrNoVersion)
+
+	ticker := time.NewTicker(av.c.Rescan.Interval)
+	defer ticker.Stop()
+	for {
+		var now time.Time
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-ticker.C:
+		}
+
+		// every replica keeps track of the signature version, but only the holder of the lease requests
+		// the rescan. The lease outlives a missed tick, another replica takes over if the holder is gone.
+		holder := lease.Acquire(av.store, _rescanLeaseKey, av.id, now, 2*av.c.Rescan.Interval)
+
+		if versioned {
+			current, err := av.signatureVersion()
+			switch {
+			case err != nil:
+				// the scanner is not reachable, check again with the next tick
+				continue
+			case version == "":
+				// without a known version it is unclear whether the signatures changed
+				version = current
+				continue
+			case current == version:
+				continue
+			}
+			version = current
+		}
+
+		if !holder {
+			continue
+		}
+
+		ev := event.StartRescan{
+			SignatureVersion: version,
+			ExecutionTime:    time.Now(),
+		}
+		if av.c.Rescan.ModifiedWithin > 0 {
+			ev.ModifiedSince = ev.ExecutionTime.Add(-av.c.Rescan.ModifiedWithin)
+		}
+
+		av.l.Info().Str("version", version).Time("modifiedSince", ev.ModifiedSince).Msg("Requesting rescan of stored files")
+		if err := events.Publish(ctx, s, ev); err != nil {
+			av.l.Error().Err(err).Msg("cannot publish rescan event")
+		}
+	}
+}
+
+// signatureVersion returns the current signature version of the scanner, scanners.ErrNoVersion if it reports none
+func (av Antivirus) signatureVersion() (string, error) {
+	v, ok := av.s.(scanners.Versioner)
+	if !ok {
+		return "", scanners.ErrNoVersion
+	}
+
+	version, err := v.Version()
+	switch {
+	case errors.Is(err, scanners.ErrNoVersion):
+		return "", err
+	case err != nil:
+		av.l.Error().Err(err).Msg("cannot get the signature version of the scanner")
+		return "", err
+	case version == "":
+		return "", errors.New("the scanner reported an empty signature version")
+	}
+
+	return version, nil
+}
+
+func (av Antivirus) processRescan(e events.Event, s events.Publisher) error {
+	ctx := e.GetTraceContext(context.Background())
+	ctx, span := av.tp.Tracer("antivirus").Start(ctx, "processRescan")
+	defer span.End()
+	ev := e.Event.(event.StartRescan)
+
+	gwc, err := av.gatewaySelector.Next()
+	if err != nil {
+		return fmt.Errorf("%w: cannot get gateway client: %s", ErrEvent, err)
+	}
+
+	ctx, err = av.serviceUserContext(ctx, gwc)
+	if err != nil {
+		return fmt.Errorf("%w: cannot authenticate service account: %s", ErrEvent, err)
+	}
+
+	spaces, err := av.rescanSpaces(ctx, gwc, ev.SpaceID)
+	if err != nil {
+		return fmt.Errorf("%w: cannot list spaces: %s", ErrEvent, err)
+	}
+
+	av.l.Info().Int("spaces", len(spaces)).Time("modifiedSince", ev.ModifiedSince).Str("version", ev.SignatureVersion).Msg("Starting rescan of stored files")
+	var scanned, infected int
+	for _, space := range spaces {
+		st, err := av.rescanSpace(ctx, gwc, space, ev.ModifiedSince, s)
+		scanned += st.scanned
+		infected += st.infected
+		if err != nil {
+			av.l.Error().Err(err).Str("spaceid", space.GetId().GetOpaqueId()).Msg("cannot rescan space")
+		}
+	}
+	av.l.Info().Int("spaces", len(spaces)).Int("scanned", scanned).Int("infected", infected).Msg("Finished rescan of stored files")
+
+	return nil
+}
+
+// serviceUserContext returns a context authenticated as the service account
+func (av Antivirus) serviceUserContext(ctx context.Context, gwc gateway.GatewayAPIClient) (context.Context, error) {
+	token, err := utils.GetServiceUserToken(ctx, gwc, av.c.ServiceAccount.ServiceAccountID, av.c.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.AppendToOutgoingContext(ctxpkg.ContextSetToken(ctx, token), ctxpkg.TokenHeader, token), nil
+}
+
+func (av Antivirus) rescanSpaces(ctx context.Context, gwc gateway.GatewayAPIClient, spaceID string) ([]*provider.StorageSpace, error) {
+	req := &provider.ListStorageSpacesRequest{}
+	if spaceID != "" {
+		id, err := storagespace.ParseID(spaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Filters = []*provider.ListStorageSpacesRequest_Filter{{
+			Type: provider.ListStorageSpacesRequest_Filter_TYPE_ID,
+			Term: &provider.ListStorageSpacesRequest_Filter_Id{Id: &provider.StorageSpaceId{OpaqueId: storagespace.FormatResourceID(&id)}},
+		}}
+	}
+
+	res, err := gwc.ListStorageSpaces(ctx, req)
+	switch {
+	case err != nil:
+		return nil, err
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return nil, errors.New(res.GetStatus().GetMessage())
+	}
+
+	spaces := make([]*provider.StorageSpace, 0, len(res.GetStorageSpaces()))
+	for _, space := range res.GetStorageSpaces() {
+		switch space.GetSpaceType() {
+		case _spaceTypePersonal, _spaceTypeProject:
+			spaces = append(spaces, space)
+		}
+	}
+
+	return spaces, nil
+}
+
+type rescanStats struct {
+	scanned  int
+	infected int
+}
+
+func (av Antivirus) rescanSpace(ctx context.Context, gwc gateway.GatewayAPIClient, space *provider.StorageSpace, since time.Time, s events.Publisher) (rescanStats, error) {
+	var stats rescanStats
+
+	w := walker.NewWalker(av.gatewaySelector)
+	err := w.Walk(ctx, space.GetRoot(), func(wd string, info *provider.ResourceInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// the mtime of folders is propagated, unchanged subtrees can be skipped entirely
+		if !since.IsZero() && utils.TSToTime(info.GetMtime()).Before(since) {
+			if info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.GetType() != provider.ResourceType_RESOURCE_TYPE_FILE {
+			return nil
+		}
+
+		p := utils.MakeRelativePath(filepath.Join(wd, info.GetPath()))
+		l := log.Logger{Logger: av.l.With().Str("resourceID", storagespace.FormatResourceID(info.GetId())).Str("path", p).Logger()}
+		res, err := av.rescanFile(ctx, gwc, info, l)
+		if err != nil {
+			l.Error().Err(err).Msg("cannot rescan file")
+			return nil
+		}
+		stats.scanned++
+
+		if !res.Infected {
+			return nil
+		}
+
+		outcome, released := av.handleInfectedStoredFile(ctx, gwc, space, info, p, res, l)
+		if released {
+			return nil
+		}
+		stats.infected++
+
+		l.Info().Str("virus", res.Description).Str("outcome", string(outcome)).Msg("Stored file is infected")
+		if err := events.Publish(ctx, s, event.StoredFileInfected{
+			ResourceID:  info.GetId(),
+			SpaceID:     space.GetId().GetOpaqueId(),
+			SpaceOwner:  space.GetOwner().GetId(),
+			Path:        p,
+			Filename:    info.GetName(),
+			Description: res.Description,
+			Scandate:    res.ScanTime,
+			Outcome:     outcome,
+			Timestamp:   utils.TSNow(),
+		}); err != nil {
+			return fmt.Errorf("%w: cannot publish events", ErrFatal)
+		}
+		return nil
+	})
+
+	return stats, err
+}
+
+func (av Antivirus) rescanFile(ctx context.Context, gwc gateway.GatewayAPIClient, info *provider.ResourceInfo, l log.Logger) (scanners.Result, error) {
+	if info.GetSize() == 0 || (0 < av.m && av.m < info.GetSize() && !av.chunked) {
+		l.Debug().Uint64("limit", av.m).Uint64("filesize", info.GetSize()).Msg("Skipping file to be virus scanned because its file size is higher than the defined limit.")
+		return scanners.Result{ScanTime: time.Now()}, nil
+	}
+
+	rrc, err := av.downloadStoredFile(ctx, gwc, info)
+	if err != nil {
+		return scanners.Result{}, err
+	}
+	defer rrc.Close()
+
+	return av.scan(scanners.Input{Body: rrc, Size: int64(info.GetSize()), Name: info.GetName()}, l)
+}
+
+func (av Antivirus) downloadStoredFile(ctx context.Context, gwc gateway.GatewayAPIClient, info *provider.ResourceInfo) (io.ReadCloser, error) {
+	res, err := gwc.InitiateFileDownload(ctx, &provider.InitiateFileDownloadRequest{Ref: &provider.Reference{ResourceId: info.GetId(), Path: "."}})
+	switch {
+	case err != nil:
+		return nil, err
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return nil, errors.New(res.GetStatus().GetMessage())
+	}
+
+	var ep, tt string
+	for _, p := range res.GetProtocols() {
+		if p.GetProtocol() == "spaces" {
+			ep, tt = p.GetDownloadEndpoint(), p.GetToken()
+			break
+		}
+	}
+	if (ep == "" || tt == "") && len(res.GetProtocols()) > 0 {
+		ep, tt = res.GetProtocols()[0].GetDownloadEndpoint(), res.GetProtocols()[0].GetToken()
+	}
+
+	token, _ := ctxpkg.ContextGetToken(ctx)
+	return av.downloadViaReva(ep, tt, token)
+}
+
+// handleInfectedStoredFile applies the infected file handling to an already stored file.
+// The second return value is true if an admin released the content from quarantine before.
+func (av Antivirus) handleInfectedStoredFile(ctx context.Context, gwc gateway.GatewayAPIClient, space *provider.StorageSpace, info *provider.ResourceInfo, p string, res scanners.Result, l log.Logger) (events.PostprocessingOutcome, bool) {
+	switch av.o {
+	case _outcomeQuarantine:
+		return av.quarantineStoredFile(ctx, gwc, space, info, p, res, l)
+	case events.PPOutcomeDelete:
+		if !av.deleteStoredFile(ctx, gwc, info, l) {
+			// the file could not be deleted, it is still there
+			return events.PPOutcomeContinue, false
+		}
+		return events.PPOutcomeDelete, false
+	default:
+		// stored files are not part of an upload which could be aborted, they are kept in place
+		return events.PPOutcomeContinue, false
+	}
+}
+
+func (av Antivirus) deleteStoredFile(ctx context.Context, gwc gateway.GatewayAPIClient, info *provider.ResourceInfo, l log.Logger) bool {
+	res, err := gwc.Delete(ctx, &provider.DeleteRequest{Ref: &provider.Reference{ResourceId: info.GetId(), Path: "."}})
+	switch {
+	case err != nil:
+		l.Error().Err(err).Msg("cannot delete infected file")
+		return false
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		l.Error().Str("status", res.GetStatus().GetMessage()).Msg("cannot delete infected file")
+		return false
+	}
+	return true
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -12,14 +14,23 @@ import (
 	"sync"
 	"time"
 
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/cs3org/reva/v2/pkg/bytesize"
 	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
 	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/events/stream"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/cs3org/reva/v2/pkg/rhttp"
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
+	"github.com/cs3org/reva/v2/pkg/store"
+	"github.com/google/uuid"
+	microstore "go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/quarantine"
+	"github.com/owncloud/ocis/v2/services/antivirus/pkg/cache"
 	"github.com/owncloud/ocis/v2/services/antivirus/pkg/config"
 	"github.com/owncloud/ocis/v2/services/antivirus/pkg/scanners"
 )
@@ -37,31 +48,55 @@ type Scanner interface {
 }
 
 // NewAntivirus returns a service implementation for Service.
-func NewAntivirus(c *config.Config, l log.Logger, tp trace.TracerProvider) (Antivirus, error) {
+func NewAntivirus(c *config.Config, l log.Logger, tp trace.TracerProvider, gatewaySelector pool.Selectable[gateway.GatewayAPIClient]) (Antivirus, error) {
 
 	var scanner Scanner
 	var err error
 	switch c.Scanner.Type {
+	case "pipeline":
+		scanner, err = newPipeline(c.Scanner)
 	default:
-		return Antivirus{}, fmt.Errorf("unknown av scanner: '%s'", c.Scanner.Type)
-	case "clamav":
-		scanner = scanners.NewClamAV(c.Scanner.ClamAV.Socket)
-	case "icap":
-		scanner, err = scanners.NewICAP(c.Scanner.ICAP.URL, c.Scanner.ICAP.Service, c.Scanner.ICAP.Timeout)
+		scanner, err = newScanner(c.Scanner.Type, c.Scanner)
 	}
 	if err != nil {
 		return Antivirus{}, err
 	}
 
-	av := Antivirus{c: c, l: l, tp: tp, s: scanner, client: rhttp.GetHTTPClient(rhttp.Insecure(true))}
+	av := Antivirus{c: c, l: l, tp: tp, s: scanner, id: uuid.New().String(), client: rhttp.GetHTTPClient(rhttp.Insecure(true)), gatewaySelector: gatewaySelector}
 
 	switch o := events.PostprocessingOutcome(c.InfectedFileHandling); o {
 	case events.PPOutcomeContinue, events.PPOutcomeAbort, events.PPOutcomeDelete:
 		av.o = o
+	case _outcomeQuarantine:
+		s, err := metadata.NewCS3Storage(c.RevaGateway, c.Quarantine.StorageAddress, c.Quarantine.SystemUserID, c.Quarantine.SystemUserIDP, c.Quarantine.SystemUserAPIKey)
+		if err != nil {
+			return av, err
+		}
+		av.o = o
+		av.q = quarantine.New(s)
 	default:
 		return av, fmt.Errorf("unknown infected file handling '%s'", o)
 	}
 
+	if c.ScanCache.Enabled || c.Rescan.Interval > 0 {
+		// the store is shared by all replicas, it also holds the lease of the replica scheduling rescans
+		av.store = store.Create(
+			store.Store(c.ScanCache.Store),
+			microstore.Nodes(c.ScanCache.Nodes...),
+			microstore.Database(c.ScanCache.Database),
+			microstore.Table(c.ScanCache.Table),
+			store.Authentication(c.ScanCache.AuthUsername, c.ScanCache.AuthPassword),
+		)
+	}
+
+	if c.ScanCache.Enabled {
+		if _, ok := scanner.(scanners.Versioner); !ok {
+			return av, fmt.Errorf("av scanner '%s' does not support the scan cache", c.Scanner.Type)
+		}
+
+		av.cache = cache.New(av.store, c.ScanCache.TTL)
+	}
+
 	if c.MaxScanSize != "" {
 		b, err := bytesize.Parse(c.MaxScanSize)
 		if err != nil {
@@ -71,9 +106,55 @@ func NewAntivirus(c *config.Config, l log.Logger, tp trace.TracerProvider) (Anti
 		av.m = b.Bytes()
 	}
 
+	switch c.MaxScanSizeMode {
+	case "", "skip":
+	case "chunked":
+		if av.m == 0 {
+			break
+		}
+
+		overlap, err := bytesize.Parse(c.ChunkedScanOverlap)
+		if err != nil {
+			return av, err
+		}
+
+		av.s, err = scanners.NewChunked(av.s, int64(av.m), int64(overlap.Bytes()))
+		if err != nil {
+			return av, err
+		}
+		av.chunked = true
+	default:
+		return av, fmt.Errorf("unknown max scan size mode '%s'", c.MaxScanSizeMode)
+	}
+
 	return av, nil
 }
 
+func newScanner(t string, c config.Scanner) (Scanner, error) {
+	switch t {
+	case "clamav":
+		return scanners.NewClamAV(c.ClamAV.Socket), nil
+	case "icap":
+		return scanners.NewICAP(c.ICAP.URL, c.ICAP.Service, c.ICAP.Timeout)
+	default:
+		return nil, fmt.Errorf("unknown av scanner: '%s'", t)
+	}
+}
+
+func newPipeline(c config.Scanner) (Scanner, error) {
+	stages := make([]scanners.Stage, 0, len(c.Pipeline.Scanners))
+	for _, t := range c.Pipeline.Scanners {
+		s, err := newScanner(t, c)
+		if err != nil {
+			return nil, err
+		}
+
+		stages = append(stages, scanners.Stage{Name: t, Engine: s})
+	}
+
+	return scanners.NewPipeline(scanners.PipelinePolicy(c.Pipeline.Policy), c.Pipeline.Quorum, stages...)
+}
+
 // Antivirus defines implements the business logic for Service.
 type Antivirus struct {
 	c  *config.Config
@@ -83,7 +164,14 @@ type Antivirus struct {
 	m  uint64
 	tp trace.TracerProvider
 
-	client *http.Client
+	cache   *cache.Cache
+	store   microstore.Store
+	id      string
+	chunked bool
+	q       *quarantine.Quarantine
+	client  *http.Client
+
+	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 }
 
 // Run runs the service
@@ -112,18 +200,30 @@ func (av Antivirus) Run() error {
 		return err
 	}
 
-	ch, err := events.Consume(natsStream, "antivirus", events.StartPostprocessingStep{})
+	ch, err := events.Consume(natsStream, "antivirus", events.StartPostprocessingStep{}, event.StartRescan{})
 	if err != nil {
 		return err
 	}
 
+	if av.c.Rescan.Interval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go av.scheduleRescans(ctx, natsStream)
+	}
+
 	wg := sync.WaitGroup{}
 	for i := 0; i < av.c.Workers; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			for e := range ch {
-				err := av.processEvent(e, natsStream)
+				var err error
+				switch e.Event.(type) {
+				case event.StartRescan:
+					err = av.processRescan(e, natsStream)
+				default:
+					err = av.processEvent(e, natsStream)
+				}
 				if err != nil {
 					switch {
 					case errors.Is(err, ErrFatal):
@@ -195,7 +295,11 @@ func (av Antivirus) processEvent(e events.Event, s events.Publisher) error {
 		outcome = events.PPOutcomeAbort
 	}
 
-	av.l.Info().Str("uploadid", ev.UploadID).Interface("resourceID", ev.ResourceID).Str("virus", res.Description).Str("outcome", string(outcome)).Str("filename", ev.Filename).Str("user", ev.ExecutingUser.GetId().GetOpaqueId()).Bool("infected", res.Infected).Dur("duration", duration).Msg("File scanned")
+	if outcome == _outcomeQuarantine {
+		res, outcome = av.quarantineUpload(ctx, ev, res)
+	}
+
+	av.l.Info().Str("uploadid", ev.UploadID).Interface("resourceID", ev.ResourceID).Str("virus", res.Description).Str("outcome", string(outcome)).Str("filename", ev.Filename).Str("user", ev.ExecutingUser.GetId().GetOpaqueId()).Bool("infected", res.Infected).Interface("windows", res.Windows).Dur("duration", duration).Msg("File scanned")
 	if err := events.Publish(ctx, s, events.PostprocessingStepFinished{
 		FinishedStep:  events.PPStepAntivirus,
 		Outcome:       outcome,
@@ -218,22 +322,14 @@ func (av Antivirus) processEvent(e events.Event, s events.Publisher) error {
 
 // process the scan
 func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result, error) {
-	if ev.Filesize == 0 || (0 < av.m && av.m < ev.Filesize) {
+	if ev.Filesize == 0 || (0 < av.m && av.m < ev.Filesize && !av.chunked) {
 		av.l.Info().Str("uploadid", ev.UploadID).Uint64("limit", av.m).Uint64("filesize", ev.Filesize).Msg("Skipping file to be virus scanned because its file size is higher than the defined limit.")
 		return scanners.Result{
 			ScanTime: time.Now(),
 		}, nil
 	}
 
-	var err error
-	var rrc io.ReadCloser
-
-	switch ev.UploadID {
-	default:
-		rrc, err = av.downloadViaToken(ev.URL)
-	case "":
-		rrc, err = av.downloadViaReva(ev.URL, ev.Token, ev.RevaToken)
-	}
+	rrc, err := av.download(ev)
 	if err != nil {
 		av.l.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error downloading file")
 		return scanners.Result{}, err
@@ -241,14 +337,86 @@ func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result,
 	defer rrc.Close()
 	av.l.Debug().Str("uploadid", ev.UploadID).Msg("Downloaded file successfully, starting virusscan")
 
-	res, err := av.s.Scan(scanners.Input{Body: rrc, Size: int64(ev.Filesize), Url: ev.URL, Name: ev.Filename})
+	l := log.Logger{Logger: av.l.With().Str("uploadid", ev.UploadID).Logger()}
+	return av.scan(scanners.Input{Body: rrc, Size: int64(ev.Filesize), Url: ev.URL, Name: ev.Filename}, l)
+}
+
+// scan scans the input, using the scan cache if it is enabled
+func (av Antivirus) scan(in scanners.Input, l log.Logger) (scanners.Result, error) {
+	var (
+		res scanners.Result
+		err error
+	)
+	if av.cache != nil {
+		res, err = av.scanCached(in, l)
+	} else {
+		res, err = av.s.Scan(in)
+	}
 	if err != nil {
-		av.l.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error scanning file")
+		l.Error().Err(err).Msg("error scanning file")
 	}
 
 	return res, err
 }
 
+// scanCached computes the checksum of the input and only scans it if there is no result for the current signatures
+func (av Antivirus) scanCached(in scanners.Input, l log.Logger) (scanners.Result, error) {
+	version, err := av.s.(scanners.Versioner).Version()
+	if err != nil {
+		return scanners.Result{}, err
+	}
+
+	// the input is spooled to a temporary file, the checksum is needed before the scan starts
+	f, err := os.CreateTemp("", "antivirus-")
+	if err != nil {
+		return scanners.Result{}, err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), in.Body); err != nil {
+		return scanners.Result{}, err
+	}
+	checksum := hex.EncodeToString(h.Sum(nil))
+
+	res, ok, err := av.cache.Get(checksum, version)
+	switch {
+	case err != nil:
+		l.Error().Err(err).Msg("cannot read scan cache")
+	case ok:
+		l.Debug().Str("checksum", checksum).Msg("Using cached scan result")
+		return res, nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return scanners.Result{}, err
+	}
+	in.Body = f
+
+	res, err = av.s.Scan(in)
+	if err != nil {
+		return res, err
+	}
+
+	if err := av.cache.Put(checksum, version, res); err != nil {
+		l.Error().Err(err).Msg("cannot write scan cache")
+	}
+	return res, nil
+}
+
+// download downloads the file of the postprocessing step
+func (av Antivirus) download(ev events.StartPostprocessingStep) (io.ReadCloser, error) {
+	switch ev.UploadID {
+	default:
+		return av.downloadViaToken(ev.URL)
+	case "":
+		return av.downloadViaReva(ev.URL, ev.Token, ev.RevaToken)
+	}
+}
+
 // download will download the file
 func (av Antivirus) downloadViaToken(url string) (io.ReadCloser, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/config"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/types"
@@ -75,6 +76,8 @@ func StartAuditLogger(ctx context.Context, ch <-chan events.Event, log log.Logge
 				auditEvent = types.ItemTrashed(ev)
 			case events.ItemPurged:
 				auditEvent = types.ItemPurged(ev)
+			case event.StoredFileInfected:
+				auditEvent = types.StoredFileInfected(ev)
 			case events.ItemRestored:
 				auditEvent = types.ItemRestored(ev)
 			case events.FileVersionRestored:
@@ -111,6 +114,10 @@ func StartAuditLogger(ctx context.Context, ch <-chan events.Event, log log.Logge
 				auditEvent = types.GroupMemberRemoved(ev)
 			case events.ScienceMeshInviteTokenGenerated:
 				auditEvent = types.ScienceMeshInviteTokenGenerated(ev)
+			case event.AuthenticationLockedOut:
+				auditEvent = types.AuthenticationLockedOut(ev)
+			case event.AuthenticationLockoutCleared:
+				auditEvent = types.AuthenticationLockoutCleared(ev)
 			default:
 				log.Error().Interface("event", ev).Msg(fmt.Sprintf("can't handle event of type '%T'", ev))
 				continue
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/audit/pkg/types"
 
@@ -586,6 +587,53 @@ var testCases = []struct {
 			require.Equal(t, "http://ocis.test/invite", ev.InviteLink)
 		},
 	},
+	{
+		Alias: "Authentication - LockedOut",
+		SystemEvent: events.Event{
+			Event: event.AuthenticationLockedOut{
+				Kind:        "user",
+				Value:       "alice",
+				ClientIP:    "192.0.2.1",
+				Failures:    10,
+				LockedUntil: timestamp(10e8 + 900),
+				Timestamp:   timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventAuthenticationLockedOut{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "", "2001-09-09T01:46:40Z", "user 'alice' was locked out after 10 failed authentication attempts until 2001-09-09T02:01:40Z", "authentication_locked_out")
+			// AuditEventAuthenticationLockedOut fields
+			require.Equal(t, "192.0.2.1", ev.ClientIP)
+			require.Equal(t, "user", ev.Kind)
+			require.Equal(t, "alice", ev.Value)
+			require.Equal(t, 10, ev.Failures)
+			require.Equal(t, "2001-09-09T02:01:40Z", ev.LockedUntil)
+		},
+	},
+	{
+		Alias: "Authentication - LockoutCleared",
+		SystemEvent: events.Event{
+			Event: event.AuthenticationLockoutCleared{
+				Executant: userID("admin-user-id"),
+				Kind:      "link",
+				Value:     "link-token",
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventAuthenticationLockoutCleared{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-user-id", "2001-09-09T01:46:40Z", "user 'admin-user-id' cleared the lockout of link 'link-token'", "authentication_lockout_cleared")
+			// AuditEventAuthenticationLockoutCleared fields
+			require.Equal(t, "link", ev.Kind)
+			require.Equal(t, "link-token", ev.Value)
+		},
+	},
 }
 
 func TestAuditLogging(t *testing.T) {
@@ -15,6 +15,8 @@ import (
 	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
 
 	sdk "github.com/cs3org/reva/v2/pkg/sdk/common"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
 )
 
 const _linktype = "link"
@@ -299,6 +301,18 @@ func ItemPurged(ev events.ItemPurged) AuditEventFilePurged {
 	}
 }
 
+// StoredFileInfected converts a StoredFileInfected event to an AuditEventFileInfected
+func StoredFileInfected(ev event.StoredFileInfected) AuditEventFileInfected {
+	iid := storagespace.FormatResourceID(ev.ResourceID)
+	uid := ev.SpaceOwner.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageFileInfected(iid, ev.Description, string(ev.Outcome)), ActionFileInfected)
+	return AuditEventFileInfected{
+		AuditEventFiles: FilesAuditEvent(base, iid, uid, ev.Path),
+		Virus:           ev.Description,
+		Outcome:         string(ev.Outcome),
+	}
+}
+
 // ItemRestored converts a ItemRestored event to an AuditEventFileRestored
 func ItemRestored(ev events.ItemRestored) AuditEventFileRestored {
 	iid, path, uid := extractFileDetails(ev.Ref, ev.Owner)
@@ -520,6 +534,31 @@ func ScienceMeshInviteTokenGenerated(ev events.ScienceMeshInviteTokenGenerated)
 	}
 }
 
+// AuthenticationLockedOut converts a AuthenticationLockedOut event to an AuditEventAuthenticationLockedOut
+func AuthenticationLockedOut(ev event.AuthenticationLockedOut) AuditEventAuthenticationLockedOut {
+	until := formatTime(ev.LockedUntil)
+	base := BasicAuditEvent("", formatTime(ev.Timestamp), MessageAuthenticationLockedOut(ev.Kind, ev.Value, ev.Failures, until), ActionAuthenticationLockedOut)
+	return AuditEventAuthenticationLockedOut{
+		AuditEvent:  base,
+		ClientIP:    ev.ClientIP,
+		Kind:        ev.Kind,
+		Value:       ev.Value,
+		Failures:    ev.Failures,
+		LockedUntil: until,
+	}
+}
+
+// AuthenticationLockoutCleared converts a AuthenticationLockoutCleared event to an AuditEventAuthenticationLockoutCleared
+func AuthenticationLockoutCleared(ev event.AuthenticationLockoutCleared) AuditEventAuthenticationLockoutCleared {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageAuthenticationLockoutCleared(uid, ev.Kind, ev.Value), ActionAuthenticationLockoutCleared)
+	return AuditEventAuthenticationLockoutCleared{
+		AuditEvent: base,
+		Kind:       ev.Kind,
+		Value:      ev.Value,
+	}
+}
+
 func extractGrantee(uid *user.UserId, gid *group.GroupId) (string, string) {
 	switch {
 	case uid != nil && uid.OpaqueId != "":
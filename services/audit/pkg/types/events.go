@@ -2,6 +2,8 @@ package types
 
 import (
 	"github.com/cs3org/reva/v2/pkg/events"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
 )
 
 // RegisteredEvents returns the events the service is registered for
@@ -22,6 +24,7 @@ func RegisteredEvents() []events.Unmarshaller {
 		events.ItemTrashed{},
 		events.ItemMoved{},
 		events.ItemPurged{},
+		event.StoredFileInfected{},
 		events.ItemRestored{},
 		events.FileVersionRestored{},
 		events.SpaceCreated{},
@@ -41,5 +44,7 @@ func RegisteredEvents() []events.Unmarshaller {
 		events.GroupMemberRemoved{},
 		events.BackchannelLogout{},
 		events.ScienceMeshInviteTokenGenerated{},
+		event.AuthenticationLockedOut{},
+		event.AuthenticationLockoutCleared{},
 	}
 }
@@ -30,6 +30,7 @@ const (
 	ActionFilePurged          = "file_trash_delete"
 	ActionFileRestored        = "file_trash_restore"
 	ActionFileVersionRestored = "file_version_restore"
+	ActionFileInfected        = "file_infected"
 
 	// Spaces
 	ActionSpaceCreated  = "space_created"
@@ -54,6 +55,10 @@ const (
 
 	// ScienceMesh
 	ActionScienceMeshInviteTokenGenerated = "science_mesh_invite_token_generated"
+
+	// Authentication
+	ActionAuthenticationLockedOut      = "authentication_locked_out"
+	ActionAuthenticationLockoutCleared = "authentication_lockout_cleared"
 )
 
 // MessageShareCreated returns the human-readable string that describes the action
@@ -131,6 +136,11 @@ func MessageFilePurged(executant, item string) string {
 	return fmt.Sprintf("user '%s' removed file '%s' from trashbin", executant, item)
 }
 
+// MessageFileInfected returns the human-readable string that describes the action
+func MessageFileInfected(item, virus, outcome string) string {
+	return fmt.Sprintf("rescan found virus '%s' in file '%s', outcome '%s'", virus, item, outcome)
+}
+
 // MessageFileRestored returns the human-readable string that describes the action
 func MessageFileRestored(executant, item, path string) string {
 	return fmt.Sprintf("user '%s' restored file '%s' from trashbin to '%s'", executant, item, path)
@@ -242,3 +252,13 @@ func MessageGroupMemberRemoved(executant, userID, groupID string) string {
 func MessageScienceMeshInviteTokenGenerated(user, token string) string {
 	return fmt.Sprintf("user '%s' generated a ScienceMesh invite with token '%s'", user, token)
 }
+
+// MessageAuthenticationLockedOut returns the human-readable string that describes the action
+func MessageAuthenticationLockedOut(kind, value string, failures int, until string) string {
+	return fmt.Sprintf("%s '%s' was locked out after %d failed authentication attempts until %s", kind, value, failures, until)
+}
+
+// MessageAuthenticationLockoutCleared returns the human-readable string that describes the action
+func MessageAuthenticationLockoutCleared(executant, kind, value string) string {
+	return fmt.Sprintf("user '%s' cleared the lockout of %s '%s'", executant, kind, value)
+}
@@ -142,6 +142,14 @@ type AuditEventFilePurged struct {
 	AuditEventFiles
 }
 
+// AuditEventFileInfected is the event logged when a rescan finds a virus in a stored file
+type AuditEventFileInfected struct {
+	AuditEventFiles
+
+	Virus   string
+	Outcome string
+}
+
 // AuditEventFileRestored is the event logged when a file is restored (from trash-bin)
 type AuditEventFileRestored struct {
 	AuditEventFiles
@@ -284,3 +292,20 @@ type AuditEventScienceMeshInviteTokenGenerated struct {
 	Expiration    uint64
 	InviteLink    string
 }
+
+// AuditEventAuthenticationLockedOut is the event logged when a username, client IP or public link is locked out
+type AuditEventAuthenticationLockedOut struct {
+	AuditEvent
+	ClientIP    string // the client IP of the last failed attempt
+	Kind        string // user, ip or link
+	Value       string // the username, client IP or public link token
+	Failures    int
+	LockedUntil string
+}
+
+// AuditEventAuthenticationLockoutCleared is the event logged when a lockout is cleared
+type AuditEventAuthenticationLockoutCleared struct {
+	AuditEvent
+	Kind  string // user, ip or link
+	Value string // the username, client IP or public link token
+}
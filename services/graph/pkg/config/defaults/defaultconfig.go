@@ -63,6 +63,10 @@ func DefaultConfig() *config.Config {
 			IdentitySearchMinLength: 3,
 		},
 		Reva: shared.DefaultRevaConfig(),
+		Quarantine: config.Quarantine{
+			StorageAddress: "com.owncloud.api.storage-system",
+			SystemUserIDP:  "internal",
+		},
 		Spaces: config.Spaces{
 			StorageUsersAddress: "com.owncloud.api.storage-users",
 			WebDavBase:          "https://localhost:9200",
@@ -176,6 +180,14 @@ func EnsureDefaults(cfg *config.Config) {
 		cfg.HTTP.TLS = cfg.Commons.HTTPServiceTLS
 	}
 
+	if cfg.Quarantine.SystemUserAPIKey == "" && cfg.Commons != nil && cfg.Commons.SystemUserAPIKey != "" {
+		cfg.Quarantine.SystemUserAPIKey = cfg.Commons.SystemUserAPIKey
+	}
+
+	if cfg.Quarantine.SystemUserID == "" && cfg.Commons != nil && cfg.Commons.SystemUserID != "" {
+		cfg.Quarantine.SystemUserID = cfg.Commons.SystemUserID
+	}
+
 	if cfg.Identity.LDAP.GroupCreateBaseDN == "" {
 		cfg.Identity.LDAP.GroupCreateBaseDN = cfg.Identity.LDAP.GroupBaseDN
 	}
@@ -0,0 +1,331 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/cs3org/reva/v2/pkg/rhttp"
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/quarantine"
+	"github.com/owncloud/ocis/v2/services/graph/pkg/config"
+	"github.com/owncloud/ocis/v2/services/graph/pkg/errorcode"
+)
+
+var (
+	// ErrQuarantinedItemNotFound is returned when a quarantined item does not exist
+	ErrQuarantinedItemNotFound = errorcode.New(errorcode.ItemNotFound, "quarantined item not found")
+
+	// ErrQuarantinedItemTargetExists is returned when a quarantined item can not be released because its original location is taken
+	ErrQuarantinedItemTargetExists = errorcode.New(errorcode.NameAlreadyExists, "original location of the quarantined item already exists")
+
+	// ErrQuarantinedItemNoSpace is returned when a quarantined item can not be released because its space is unknown
+	ErrQuarantinedItemNoSpace = errorcode.New(errorcode.NotAllowed, "the space of the quarantined item is unknown")
+)
+
+// QuarantineProvider is the interface that needs to be implemented by the individual quarantine service
+type QuarantineProvider interface {
+	// List returns all quarantined items
+	List(ctx context.Context) ([]quarantine.Item, error)
+
+	// Get returns a quarantined item
+	Get(ctx context.Context, id string) (quarantine.Item, error)
+
+	// Content returns a reader streaming the content of a quarantined item
+	Content(ctx context.Context, id string) (io.ReadCloser, error)
+
+	// Release restores a quarantined item to its original location
+	Release(ctx context.Context, id string) (quarantine.Item, error)
+
+	// Delete permanently deletes a quarantined item
+	Delete(ctx context.Context, id string) error
+}
+
+// QuarantineService contains the production business logic for everything that relates to the quarantine
+type QuarantineService struct {
+	logger          log.Logger
+	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	quarantine      *quarantine.Quarantine
+	config          *config.Config
+}
+
+// NewQuarantineService creates a new QuarantineService
+func NewQuarantineService(logger log.Logger, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], storage metadata.Storage, c *config.Config) (QuarantineService, error) {
+	return QuarantineService{
+		logger:          log.Logger{Logger: logger.With().Str("graph api", "QuarantineService").Logger()},
+		gatewaySelector: gatewaySelector,
+		quarantine:      quarantine.New(storage),
+		config:          c,
+	}, nil
+}
+
+// List returns all quarantined items
+func (s QuarantineService) List(ctx context.Context) ([]quarantine.Item, error) {
+	return s.quarantine.List(ctx)
+}
+
+// Get returns a quarantined item
+func (s QuarantineService) Get(ctx context.Context, id string) (quarantine.Item, error) {
+	item, err := s.quarantine.Get(ctx, id)
+	return item, fromQuarantineError(err)
+}
+
+// Content returns a reader streaming the content of a quarantined item
+func (s QuarantineService) Content(ctx context.Context, id string) (io.ReadCloser, error) {
+	content, err := s.quarantine.Content(ctx, id)
+	return content, fromQuarantineError(err)
+}
+
+// Delete permanently deletes a quarantined item
+func (s QuarantineService) Delete(ctx context.Context, id string) error {
+	return fromQuarantineError(s.quarantine.Delete(ctx, id))
+}
+
+// Release restores a quarantined item to its original location. An existing file is never overwritten.
+// The restored file is marked as released, the antivirus service does not quarantine it again.
+func (s QuarantineService) Release(ctx context.Context, id string) (quarantine.Item, error) {
+	item, err := s.quarantine.Get(ctx, id)
+	if err != nil {
+		return quarantine.Item{}, fromQuarantineError(err)
+	}
+
+	if item.SpaceID == "" {
+		return quarantine.Item{}, ErrQuarantinedItemNoSpace
+	}
+	root, err := storagespace.ParseID(item.SpaceID)
+	if err != nil {
+		return quarantine.Item{}, ErrQuarantinedItemNoSpace
+	}
+	root.OpaqueId = root.GetSpaceId()
+
+	p := item.Path
+	if p == "" {
+		p = item.Name
+	}
+
+	gatewayClient, err := s.gatewaySelector.Next()
+	if err != nil {
+		return quarantine.Item{}, err
+	}
+
+	// admins do not necessarily have access to the space of the item
+	sctx, err := utils.GetServiceUserContextWithContext(ctx, gatewayClient, s.config.ServiceAccount.ServiceAccountID, s.config.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		return quarantine.Item{}, err
+	}
+
+	content, err := s.quarantine.Content(ctx, id)
+	if err != nil {
+		return quarantine.Item{}, fromQuarantineError(err)
+	}
+	defer content.Close()
+
+	// the postprocessing of the restored file scans it again, the release must be known by then
+	release := quarantine.Release{
+		ItemID:   item.ID,
+		SpaceID:  item.SpaceID,
+		Name:     path.Base(p),
+		Checksum: item.Checksum,
+	}
+	if err := s.quarantine.MarkReleased(ctx, release); err != nil {
+		return quarantine.Item{}, err
+	}
+
+	release.ResourceID, err = s.restore(sctx, gatewayClient, &provider.Reference{ResourceId: &root, Path: utils.MakeRelativePath(p)}, content, item.Size)
+	if err != nil {
+		// the item was not restored, its content must not pass the antivirus service
+		if err := s.quarantine.UnmarkReleased(ctx, item.ID); err != nil {
+			s.logger.Error().Err(err).Str("id", id).Msg("release of the quarantined item could not be revoked")
+		}
+
+		return quarantine.Item{}, err
+	}
+
+	// the restored file keeps being released when it is renamed
+	if release.ResourceID != "" {
+		if err := s.quarantine.MarkReleased(ctx, release); err != nil {
+			s.logger.Error().Err(err).Str("id", id).Msg("resource id of the released item could not be recorded")
+		}
+	}
+
+	if err := s.quarantine.Delete(ctx, id); err != nil {
+		s.logger.Error().Err(err).Str("id", id).Msg("released item could not be removed from quarantine")
+	}
+
+	item.Path = p
+	return item, nil
+}
+
+// restore streams the content to a file which must not exist yet and returns the id of the new file
+func (s QuarantineService) restore(ctx context.Context, gatewayClient gateway.GatewayAPIClient, ref *provider.Reference, content io.Reader, size uint64) (string, error) {
+	res, err := gatewayClient.InitiateFileUpload(ctx, &provider.InitiateFileUploadRequest{
+		Ref:     ref,
+		Opaque:  utils.AppendPlainToOpaque(nil, "Upload-Length", strconv.FormatUint(size, 10)),
+		Options: &provider.InitiateFileUploadRequest_IfNotExist{IfNotExist: true},
+	})
+	switch {
+	case err != nil:
+		return "", err
+	case res.GetStatus().GetCode() == rpc.Code_CODE_ALREADY_EXISTS, res.GetStatus().GetCode() == rpc.Code_CODE_FAILED_PRECONDITION:
+		return "", ErrQuarantinedItemTargetExists
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return "", errtypes.NewErrtypeFromStatus(res.GetStatus())
+	}
+
+	var endpoint, token string
+	for _, p := range res.GetProtocols() {
+		if p.GetProtocol() == "simple" {
+			endpoint, token = p.GetUploadEndpoint(), p.GetToken()
+			break
+		}
+	}
+	if endpoint == "" {
+		return "", errors.New("the storage does not support the simple upload protocol")
+	}
+
+	req, err := rhttp.NewRequest(ctx, http.MethodPut, endpoint, content)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = int64(size)
+	req.Header.Set(TokenTransportHeader, token)
+
+	resp, err := rhttp.GetHTTPClient(rhttp.Insecure(true)).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return resp.Header.Get("OC-Fileid"), nil
+	case http.StatusPreconditionFailed:
+		return "", ErrQuarantinedItemTargetExists
+	default:
+		return "", fmt.Errorf("wrong status uploading file: %d", resp.StatusCode)
+	}
+}
+
+func fromQuarantineError(err error) error {
+	if errors.Is(err, quarantine.ErrNotFound) {
+		return ErrQuarantinedItemNotFound
+	}
+	return err
+}
+
+// QuarantineApi is the api that registers the http endpoints which expose the quarantine of the antivirus service.
+// All endpoints are restricted to admins.
+type QuarantineApi struct {
+	logger            log.Logger
+	quarantineService QuarantineProvider
+}
+
+// NewQuarantineApi creates a new QuarantineApi
+func NewQuarantineApi(quarantineService QuarantineProvider, logger log.Logger) (QuarantineApi, error) {
+	return QuarantineApi{
+		logger:            log.Logger{Logger: logger.With().Str("graph api", "QuarantineApi").Logger()},
+		quarantineService: quarantineService,
+	}, nil
+}
+
+// ListQuarantinedItems lists all quarantined items
+func (api QuarantineApi) ListQuarantinedItems(w http.ResponseWriter, r *http.Request) {
+	items, err := api.quarantineService.List(r.Context())
+	if err != nil {
+		api.logger.Debug().Err(err).Msg("could not list quarantined items")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &ListResponse{Value: items})
+}
+
+// GetQuarantinedItem returns a quarantined item
+func (api QuarantineApi) GetQuarantinedItem(w http.ResponseWriter, r *http.Request) {
+	item, err := api.quarantineService.Get(r.Context(), chi.URLParam(r, "itemID"))
+	if err != nil {
+		api.logger.Debug().Err(err).Msg("could not get quarantined item")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, item)
+}
+
+// GetQuarantinedItemContent downloads the content of a quarantined item
+func (api QuarantineApi) GetQuarantinedItemContent(w http.ResponseWriter, r *http.Request) {
+	itemID := chi.URLParam(r, "itemID")
+	item, err := api.quarantineService.Get(r.Context(), itemID)
+	if err != nil {
+		api.logger.Debug().Err(err).Msg("could not get quarantined item")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	content, err := api.quarantineService.Content(r.Context(), itemID)
+	if err != nil {
+		api.logger.Debug().Err(err).Msg("could not get content of quarantined item")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	defer content.Close()
+
+	api.logger.Info().Str("id", item.ID).Str("virus", item.Virus).Msg("quarantined item downloaded")
+
+	// never let a browser render the infected content
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(path.Base(item.Name)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Length", strconv.FormatUint(item.Size, 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		api.logger.Error().Err(err).Str("id", item.ID).Msg("could not stream content of quarantined item")
+	}
+}
+
+// ReleaseQuarantinedItem restores a quarantined item to its original location
+func (api QuarantineApi) ReleaseQuarantinedItem(w http.ResponseWriter, r *http.Request) {
+	item, err := api.quarantineService.Release(r.Context(), chi.URLParam(r, "itemID"))
+	if err != nil {
+		api.logger.Debug().Err(err).Msg("could not release quarantined item")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	api.logger.Info().Str("id", item.ID).Str("virus", item.Virus).Str("spaceid", item.SpaceID).Str("path", item.Path).Msg("quarantined item released")
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, item)
+}
+
+// DeleteQuarantinedItem permanently deletes a quarantined item
+func (api QuarantineApi) DeleteQuarantinedItem(w http.ResponseWriter, r *http.Request) {
+	itemID := chi.URLParam(r, "itemID")
+	if err := api.quarantineService.Delete(r.Context(), itemID); err != nil {
+		api.logger.Debug().Err(err).Msg("could not delete quarantined item")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	api.logger.Info().Str("id", itemID).Msg("quarantined item deleted")
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
@@ -0,0 +1,127 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tidwall/gjson"
+
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/quarantine"
+	"github.com/owncloud/ocis/v2/services/graph/pkg/config/defaults"
+	svc "github.com/owncloud/ocis/v2/services/graph/pkg/service/v0"
+)
+
+var _ = Describe("QuarantineApi", func() {
+	var (
+		quarantineApi svc.QuarantineApi
+		q             *quarantine.Quarantine
+		item          quarantine.Item
+		rCTX          *chi.Context
+		content       = []byte("X5O!P%@AP[4\\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*")
+	)
+
+	BeforeEach(func() {
+		logger := log.NewLogger()
+
+		storage, err := metadata.NewDiskStorage(GinkgoT().TempDir())
+		Expect(err).ToNot(HaveOccurred())
+
+		// the quarantine of the antivirus service and the one of the graph service share the storage
+		q = quarantine.New(storage)
+		item, err = q.Add(context.Background(), quarantine.Item{Name: "eicar.com", Path: "./eicar.com", Virus: "Eicar-Signature"}, bytes.NewReader(content))
+		Expect(err).ToNot(HaveOccurred())
+
+		quarantineService, err := svc.NewQuarantineService(logger, nil, storage, defaults.FullDefaultConfig())
+		Expect(err).ToNot(HaveOccurred())
+
+		quarantineApi, err = svc.NewQuarantineApi(quarantineService, logger)
+		Expect(err).ToNot(HaveOccurred())
+
+		rCTX = chi.NewRouteContext()
+	})
+
+	newRequest := func(method string) *http.Request {
+		return httptest.NewRequest(method, "/", nil).
+			WithContext(
+				context.WithValue(context.Background(), chi.RouteCtxKey, rCTX),
+			)
+	}
+
+	failOnUnknownItem := func(handler func() http.HandlerFunc, method string) {
+		It("fails on unknown items", func() {
+			rCTX.URLParams.Add("itemID", "a0ca6a90-a365-4782-871e-d44447bbc668")
+			w := httptest.NewRecorder()
+			handler()(w, newRequest(method))
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+
+			jsonData := gjson.Get(w.Body.String(), "error")
+			Expect(jsonData.Get("code").String() + ": " + jsonData.Get("message").String()).To(Equal(svc.ErrQuarantinedItemNotFound.Error()))
+		})
+	}
+
+	Describe("ListQuarantinedItems", func() {
+		It("lists all quarantined items", func() {
+			w := httptest.NewRecorder()
+			quarantineApi.ListQuarantinedItems(w, newRequest(http.MethodGet))
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			value := gjson.Get(w.Body.String(), "value")
+			Expect(value.Array()).To(HaveLen(1))
+			Expect(value.Get("0.id").String()).To(Equal(item.ID))
+			Expect(value.Get("0.path").String()).To(Equal("./eicar.com"))
+			Expect(value.Get("0.virus").String()).To(Equal("Eicar-Signature"))
+		})
+	})
+
+	Describe("GetQuarantinedItem", func() {
+		failOnUnknownItem(func() http.HandlerFunc { return quarantineApi.GetQuarantinedItem }, http.MethodGet)
+
+		It("returns the item", func() {
+			rCTX.URLParams.Add("itemID", item.ID)
+			w := httptest.NewRecorder()
+			quarantineApi.GetQuarantinedItem(w, newRequest(http.MethodGet))
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(gjson.Get(w.Body.String(), "name").String()).To(Equal("eicar.com"))
+		})
+	})
+
+	Describe("GetQuarantinedItemContent", func() {
+		failOnUnknownItem(func() http.HandlerFunc { return quarantineApi.GetQuarantinedItemContent }, http.MethodGet)
+
+		It("downloads the content as attachment", func() {
+			rCTX.URLParams.Add("itemID", item.ID)
+			w := httptest.NewRecorder()
+			quarantineApi.GetQuarantinedItemContent(w, newRequest(http.MethodGet))
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.Bytes()).To(Equal(content))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/octet-stream"))
+			Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="eicar.com"`))
+		})
+	})
+
+	Describe("ReleaseQuarantinedItem", func() {
+		failOnUnknownItem(func() http.HandlerFunc { return quarantineApi.ReleaseQuarantinedItem }, http.MethodPost)
+	})
+
+	Describe("DeleteQuarantinedItem", func() {
+		failOnUnknownItem(func() http.HandlerFunc { return quarantineApi.DeleteQuarantinedItem }, http.MethodDelete)
+
+		It("deletes the item", func() {
+			rCTX.URLParams.Add("itemID", item.ID)
+			w := httptest.NewRecorder()
+			quarantineApi.DeleteQuarantinedItem(w, newRequest(http.MethodDelete))
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			_, err := q.Get(context.Background(), item.ID)
+			Expect(err).To(MatchError(quarantine.ErrNotFound))
+		})
+	})
+})
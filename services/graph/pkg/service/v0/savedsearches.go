@@ -0,0 +1,169 @@
+package svc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	revaCtx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	merrors "go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/graph/pkg/errorcode"
+)
+
+// SavedSearch is a search query saved by the current user
+type SavedSearch struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	// Alert notifies the user about new or moved resources matching the query
+	Alert bool `json:"alert"`
+}
+
+// savedSearchChanges are the properties of a saved search which are changed by an update
+type savedSearchChanges struct {
+	Name  *string `json:"name"`
+	Query *string `json:"query"`
+	Alert *bool   `json:"alert"`
+}
+
+// ListSavedSearches lists the saved searches of the current user
+func (g Graph) ListSavedSearches(w http.ResponseWriter, r *http.Request) {
+	res, err := g.searchService.ListSavedSearches(searchContext(r), &searchsvc.ListSavedSearchesRequest{})
+	if err != nil {
+		g.renderSearchError(w, r, err)
+		return
+	}
+
+	searches := make([]SavedSearch, 0, len(res.GetSavedSearches()))
+	for _, s := range res.GetSavedSearches() {
+		searches = append(searches, fromSearchsvc(s))
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &ListResponse{Value: searches})
+}
+
+// CreateSavedSearch saves a search of the current user
+func (g Graph) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	search := SavedSearch{}
+	if err := StrictJSONUnmarshal(r.Body, &search); err != nil {
+		g.logger.Debug().Err(err).Interface("body", r.Body).Msg("could not decode saved search request")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid body schema definition")
+		return
+	}
+	search.ID = ""
+
+	g.saveSearch(w, r, search, http.StatusCreated)
+}
+
+// UpdateSavedSearch updates the given properties of a saved search of the current user
+func (g Graph) UpdateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	id, err := url.PathUnescape(chi.URLParam(r, "savedSearchID"))
+	if err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "unescaping saved search id failed")
+		return
+	}
+
+	changes := savedSearchChanges{}
+	if err := StrictJSONUnmarshal(r.Body, &changes); err != nil {
+		g.logger.Debug().Err(err).Interface("body", r.Body).Msg("could not decode saved search request")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid body schema definition")
+		return
+	}
+
+	res, err := g.searchService.ListSavedSearches(searchContext(r), &searchsvc.ListSavedSearchesRequest{})
+	if err != nil {
+		g.renderSearchError(w, r, err)
+		return
+	}
+
+	for _, s := range res.GetSavedSearches() {
+		if s.GetId() != id {
+			continue
+		}
+
+		search := fromSearchsvc(s)
+		if changes.Name != nil {
+			search.Name = *changes.Name
+		}
+		if changes.Query != nil {
+			search.Query = *changes.Query
+		}
+		if changes.Alert != nil {
+			search.Alert = *changes.Alert
+		}
+
+		g.saveSearch(w, r, search, http.StatusOK)
+		return
+	}
+
+	errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, "saved search not found")
+}
+
+// DeleteSavedSearch deletes a saved search of the current user
+func (g Graph) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	id, err := url.PathUnescape(chi.URLParam(r, "savedSearchID"))
+	if err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "unescaping saved search id failed")
+		return
+	}
+
+	if _, err := g.searchService.DeleteSavedSearch(searchContext(r), &searchsvc.DeleteSavedSearchRequest{Id: id}); err != nil {
+		g.renderSearchError(w, r, err)
+		return
+	}
+
+	render.NoContent(w, r)
+}
+
+func (g Graph) saveSearch(w http.ResponseWriter, r *http.Request, search SavedSearch, status int) {
+	res, err := g.searchService.SaveSearch(searchContext(r), &searchsvc.SaveSearchRequest{
+		SavedSearch: &searchsvc.SavedSearch{
+			Id:    search.ID,
+			Name:  search.Name,
+			Query: search.Query,
+			Alert: search.Alert,
+		},
+	})
+	if err != nil {
+		g.renderSearchError(w, r, err)
+		return
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, fromSearchsvc(res.GetSavedSearch()))
+}
+
+func (g Graph) renderSearchError(w http.ResponseWriter, r *http.Request, err error) {
+	merr := merrors.FromError(err)
+	switch merr.Code {
+	case http.StatusBadRequest:
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, merr.Detail)
+	case http.StatusNotFound:
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, merr.Detail)
+	default:
+		g.logger.Error().Err(err).Msg("could not call the search service")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not call the search service")
+	}
+}
+
+// searchContext passes the token of the request on to the search service
+func searchContext(r *http.Request) context.Context {
+	th := r.Header.Get(revaCtx.TokenHeader)
+	ctx := revaCtx.ContextSetToken(r.Context(), th)
+	return metadata.Set(ctx, revaCtx.TokenHeader, th)
+}
+
+func fromSearchsvc(s *searchsvc.SavedSearch) SavedSearch {
+	return SavedSearch{
+		ID:    s.GetId(),
+		Name:  s.GetName(),
+		Query: s.GetQuery(),
+		Alert: s.GetAlert(),
+	}
+}
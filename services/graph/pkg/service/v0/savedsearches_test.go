@@ -0,0 +1,114 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	merrors "go-micro.dev/v4/errors"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	searchmocks "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0/mocks"
+	"github.com/owncloud/ocis/v2/services/graph/pkg/config/defaults"
+	service "github.com/owncloud/ocis/v2/services/graph/pkg/service/v0"
+)
+
+var _ = Describe("SavedSearches", func() {
+	var (
+		svc           service.Service
+		searchService *searchmocks.SearchProviderService
+		rr            *httptest.ResponseRecorder
+		saved         = &searchsvc.SavedSearch{Id: "search-id", Name: "reports", Query: "name:*.pdf", Alert: true}
+		withID        = func(r *http.Request, id string) *http.Request {
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("savedSearchID", id)
+			return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+		}
+	)
+
+	BeforeEach(func() {
+		searchService = &searchmocks.SearchProviderService{}
+		rr = httptest.NewRecorder()
+
+		cfg := defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+
+		svc, _ = service.NewService(
+			service.Config(cfg),
+			service.WithSearchService(searchService),
+		)
+	})
+
+	It("lists the saved searches", func() {
+		searchService.On("ListSavedSearches", mock.Anything, mock.Anything).Return(&searchsvc.ListSavedSearchesResponse{
+			SavedSearches: []*searchsvc.SavedSearch{saved},
+		}, nil)
+
+		svc.ListSavedSearches(rr, httptest.NewRequest(http.MethodGet, "/graph/v1beta1/me/savedSearches", nil))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := struct{ Value []service.SavedSearch }{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res.Value).To(Equal([]service.SavedSearch{{ID: "search-id", Name: "reports", Query: "name:*.pdf", Alert: true}}))
+	})
+
+	It("creates a saved search", func() {
+		searchService.On("SaveSearch", mock.Anything, mock.MatchedBy(func(req *searchsvc.SaveSearchRequest) bool {
+			return req.GetSavedSearch().GetId() == "" && req.GetSavedSearch().GetQuery() == "name:*.pdf"
+		})).Return(&searchsvc.SaveSearchResponse{SavedSearch: saved}, nil)
+
+		body := bytes.NewBufferString(`{"name":"reports","query":"name:*.pdf","alert":true}`)
+		svc.CreateSavedSearch(rr, httptest.NewRequest(http.MethodPost, "/graph/v1beta1/me/savedSearches", body))
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+	})
+
+	It("passes on invalid queries as bad requests", func() {
+		searchService.On("SaveSearch", mock.Anything, mock.Anything).Return(nil, merrors.BadRequest("search", "invalid query"))
+
+		body := bytes.NewBufferString(`{"name":"reports","query":"AND"}`)
+		svc.CreateSavedSearch(rr, httptest.NewRequest(http.MethodPost, "/graph/v1beta1/me/savedSearches", body))
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("updates only the given properties", func() {
+		searchService.On("ListSavedSearches", mock.Anything, mock.Anything).Return(&searchsvc.ListSavedSearchesResponse{
+			SavedSearches: []*searchsvc.SavedSearch{saved},
+		}, nil)
+		searchService.On("SaveSearch", mock.Anything, mock.MatchedBy(func(req *searchsvc.SaveSearchRequest) bool {
+			s := req.GetSavedSearch()
+			return s.GetId() == "search-id" && s.GetName() == "reports" && s.GetQuery() == "name:*.pdf" && !s.GetAlert()
+		})).Return(&searchsvc.SaveSearchResponse{SavedSearch: saved}, nil)
+
+		r := httptest.NewRequest(http.MethodPatch, "/graph/v1beta1/me/savedSearches/search-id", bytes.NewBufferString(`{"alert":false}`))
+		svc.UpdateSavedSearch(rr, withID(r, "search-id"))
+		Expect(rr.Code).To(Equal(http.StatusOK))
+	})
+
+	It("fails to update unknown saved searches", func() {
+		searchService.On("ListSavedSearches", mock.Anything, mock.Anything).Return(&searchsvc.ListSavedSearchesResponse{}, nil)
+
+		r := httptest.NewRequest(http.MethodPatch, "/graph/v1beta1/me/savedSearches/unknown", bytes.NewBufferString(`{"alert":false}`))
+		svc.UpdateSavedSearch(rr, withID(r, "unknown"))
+		Expect(rr.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes a saved search", func() {
+		searchService.On("DeleteSavedSearch", mock.Anything, mock.MatchedBy(func(req *searchsvc.DeleteSavedSearchRequest) bool {
+			return req.GetId() == "search-id"
+		})).Return(&searchsvc.DeleteSavedSearchResponse{}, nil)
+
+		r := httptest.NewRequest(http.MethodDelete, "/graph/v1beta1/me/savedSearches/search-id", nil)
+		svc.DeleteSavedSearch(rr, withID(r, "search-id"))
+		Expect(rr.Code).To(Equal(http.StatusNoContent))
+	})
+})
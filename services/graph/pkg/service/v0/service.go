@@ -19,6 +19,7 @@ import (
 
 	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/cs3org/reva/v2/pkg/storage/utils/metadata"
 	"github.com/cs3org/reva/v2/pkg/store"
 	"github.com/cs3org/reva/v2/pkg/utils"
 
@@ -119,6 +120,11 @@ type Service interface { //nolint:interfacebloat
 	GetTags(w http.ResponseWriter, r *http.Request)
 	AssignTags(w http.ResponseWriter, r *http.Request)
 	UnassignTags(w http.ResponseWriter, r *http.Request)
+
+	ListSavedSearches(w http.ResponseWriter, r *http.Request)
+	CreateSavedSearch(w http.ResponseWriter, r *http.Request)
+	UpdateSavedSearch(w http.ResponseWriter, r *http.Request)
+	DeleteSavedSearch(w http.ResponseWriter, r *http.Request)
 }
 
 // NewService returns a service implementation for Service.
@@ -223,6 +229,21 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		return svc, err
 	}
 
+	quarantineStorage, err := metadata.NewCS3Storage(options.Config.Reva.Address, options.Config.Quarantine.StorageAddress, options.Config.Quarantine.SystemUserID, options.Config.Quarantine.SystemUserIDP, options.Config.Quarantine.SystemUserAPIKey)
+	if err != nil {
+		return svc, err
+	}
+
+	quarantineService, err := NewQuarantineService(options.Logger, options.GatewaySelector, quarantineStorage, options.Config)
+	if err != nil {
+		return svc, err
+	}
+
+	quarantineApi, err := NewQuarantineApi(quarantineService, options.Logger)
+	if err != nil {
+		return svc, err
+	}
+
 	m.Route(options.Config.HTTP.Root, func(r chi.Router) {
 		r.Use(middleware.StripSlashes)
 
@@ -233,6 +254,12 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 					r.Get("/sharedByMe", svc.GetSharedByMe)
 					r.Get("/sharedWithMe", svc.ListSharedWithMe)
 				})
+				r.Route("/savedSearches", func(r chi.Router) {
+					r.Get("/", svc.ListSavedSearches)
+					r.Post("/", svc.CreateSavedSearch)
+					r.Patch("/{savedSearchID}", svc.UpdateSavedSearch)
+					r.Delete("/{savedSearchID}", svc.DeleteSavedSearch)
+				})
 			})
 			r.Route("/drives", func(r chi.Router) {
 				r.Get("/", svc.GetAllDrives(APIVersion_1_Beta_1))
@@ -271,6 +298,15 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 				r.Get("/", svc.GetRoleDefinitions)
 				r.Get("/{roleID}", svc.GetRoleDefinition)
 			})
+			r.With(requireAdmin).Route("/quarantine/items", func(r chi.Router) {
+				r.Get("/", quarantineApi.ListQuarantinedItems)
+				r.Route("/{itemID}", func(r chi.Router) {
+					r.Get("/", quarantineApi.GetQuarantinedItem)
+					r.Delete("/", quarantineApi.DeleteQuarantinedItem)
+					r.Get("/content", quarantineApi.GetQuarantinedItemContent)
+					r.Post("/release", quarantineApi.ReleaseQuarantinedItem)
+				})
+			})
 		})
 		r.Route("/v1.0", func(r chi.Router) {
 			r.Route("/extensions/org.libregraph", func(r chi.Router) {
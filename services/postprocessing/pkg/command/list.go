@@ -0,0 +1,160 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/store"
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config/parser"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/logging"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/service"
+)
+
+// Upload is the representation of an upload in a postprocessing step
+type Upload struct {
+	ID       string                    `json:"id"`
+	Filename string                    `json:"filename"`
+	Step     events.Postprocessingstep `json:"step"`
+	Started  time.Time                 `json:"started"`
+	Age      time.Duration             `json:"age"`
+	Timeout  time.Duration             `json:"timeout"`
+	Stuck    bool                      `json:"stuck"`
+}
+
+// ListPostprocessing cli command to list uploads in postprocessing
+func ListPostprocessing(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "list uploads in postprocessing per step with their age",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "step",
+				Aliases: []string{"s"},
+				Usage:   "only list uploads in the given postprocessing step",
+			},
+			&cli.BoolFlag{
+				Name:  "stuck",
+				Usage: "only list uploads in a step which exceeded its timeout or the duration set by --stuck-after",
+			},
+			&cli.DurationFlag{
+				Name:  "stuck-after",
+				Usage: "the age after which an upload in a step without timeout is considered stuck",
+				Value: time.Hour,
+			},
+			&cli.BoolFlag{
+				Name:  "dead-letters",
+				Usage: "list the steps which timed out instead",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "output as json",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+			st := store.Create(
+				store.Store(cfg.Store.Store),
+				store.TTL(cfg.Store.TTL),
+				microstore.Nodes(cfg.Store.Nodes...),
+				microstore.Database(cfg.Store.Database),
+				microstore.Table(cfg.Store.Table),
+				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+			)
+
+			if c.Bool("dead-letters") {
+				dls := service.DeadLetters(st, logger)
+				rows := make([][]string, 0, len(dls))
+				for _, dl := range dls {
+					rows = append(rows, []string{string(dl.Step), dl.UploadID, dl.Filename, dl.Started.Format(time.RFC3339), dl.Timeout.String(), string(dl.Outcome), dl.Timestamp.Format(time.RFC3339)})
+				}
+				return render(c.Bool("json"), dls, []string{"Step", "Upload Id", "Name", "Started", "Timeout", "Outcome", "Timed Out"}, rows)
+			}
+
+			now := time.Now()
+			var uploads []Upload
+			for _, pp := range service.FindUploads(st, cfg.Postprocessing, logger, events.Postprocessingstep(c.String("step"))) {
+				if pp.Finished || pp.Status.CurrentStep == events.PPStepFinished {
+					continue
+				}
+
+				for _, s := range pp.RunningSteps() {
+					if c.IsSet("step") && s != events.Postprocessingstep(c.String("step")) {
+						continue
+					}
+
+					timeout, _ := pp.Timeout(s)
+					u := Upload{
+						ID:       pp.ID,
+						Filename: pp.Filename,
+						Step:     s,
+						Started:  pp.Status.Steps[s].Started,
+						Age:      pp.Age(s, now),
+						Timeout:  timeout,
+					}
+					stuckAfter := timeout
+					if stuckAfter <= 0 {
+						stuckAfter = c.Duration("stuck-after")
+					}
+					// steps stored by older versions have no start time, they are not known to be in time
+					u.Stuck = u.Started.IsZero() || u.Age > stuckAfter
+
+					if c.Bool("stuck") && !u.Stuck {
+						continue
+					}
+					uploads = append(uploads, u)
+				}
+			}
+
+			// group the uploads per step, the oldest first
+			sort.Slice(uploads, func(i, j int) bool {
+				if uploads[i].Step != uploads[j].Step {
+					return uploads[i].Step < uploads[j].Step
+				}
+				return uploads[i].Age > uploads[j].Age
+			})
+
+			rows := make([][]string, 0, len(uploads))
+			for _, u := range uploads {
+				started := "unknown"
+				if !u.Started.IsZero() {
+					started = u.Started.Format(time.RFC3339)
+				}
+				rows = append(rows, []string{string(u.Step), u.ID, u.Filename, started, u.Age.Round(time.Second).String(), u.Timeout.String(), strconv.FormatBool(u.Stuck)})
+			}
+			return render(c.Bool("json"), uploads, []string{"Step", "Upload Id", "Name", "Started", "Age", "Timeout", "Stuck"}, rows)
+		},
+	}
+}
+
+// render prints v as json or the rows as table
+func render(asJSON bool, v interface{}, header []string, rows [][]string) error {
+	if asJSON {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(j))
+		return nil
+	}
+
+	table := tw.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	table.SetAutoFormatHeaders(false)
+	table.AppendBulk(rows)
+	table.Render()
+	return nil
+}
@@ -16,6 +16,7 @@ func GetCommands(cfg *config.Config) cli.Commands {
 
 		// interaction with this service
 		RestartPostprocessing(cfg),
+		ListPostprocessing(cfg),
 
 		// infos about this service
 		Health(cfg),
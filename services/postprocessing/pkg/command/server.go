@@ -16,6 +16,7 @@ import (
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config/parser"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/logging"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/server/debug"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/server/http"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/service"
 )
 
@@ -46,21 +47,21 @@ func Server(cfg *config.Config) *cli.Command {
 				return err
 			}
 
+			st := store.Create(
+				store.Store(cfg.Store.Store),
+				store.TTL(cfg.Store.TTL),
+				microstore.Nodes(cfg.Store.Nodes...),
+				microstore.Database(cfg.Store.Database),
+				microstore.Table(cfg.Store.Table),
+				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+			)
+
 			{
 				bus, err := stream.NatsFromConfig(cfg.Service.Name, false, stream.NatsConfig(cfg.Postprocessing.Events))
 				if err != nil {
 					return err
 				}
 
-				st := store.Create(
-					store.Store(cfg.Store.Store),
-					store.TTL(cfg.Store.TTL),
-					microstore.Nodes(cfg.Store.Nodes...),
-					microstore.Database(cfg.Store.Database),
-					microstore.Table(cfg.Store.Table),
-					store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
-				)
-
 				svc, err := service.NewPostprocessingService(ctx, bus, logger, st, traceProvider, cfg.Postprocessing)
 				if err != nil {
 					return err
@@ -91,6 +92,30 @@ func Server(cfg *config.Config) *cli.Command {
 				})
 			}
 
+			{
+				bus, err := stream.NatsFromConfig(cfg.Service.Name+"-http", false, stream.NatsConfig(cfg.Postprocessing.Events))
+				if err != nil {
+					return err
+				}
+
+				server, err := http.Server(
+					http.Logger(logger),
+					http.Context(ctx),
+					http.Config(cfg),
+					http.Publisher(bus),
+					http.Store(st),
+					http.TracerProvider(traceProvider),
+				)
+				if err != nil {
+					logger.Info().Err(err).Str("transport", "http").Msg("Failed to initialize server")
+					return err
+				}
+
+				gr.Add(server.Run, func(_ error) {
+					cancel()
+				})
+			}
+
 			{
 				debugServer, err := debug.Server(
 					debug.Logger(logger),
@@ -17,6 +17,8 @@ type Config struct {
 	Log     *Log     `yaml:"log"`
 	Debug   Debug    `yaml:"debug"`
 
+	HTTP           HTTP           `yaml:"http"`
+	TokenManager   *TokenManager  `yaml:"token_manager"`
 	Store          Store          `yaml:"store"`
 	Postprocessing Postprocessing `yaml:"postprocessing"`
 
@@ -28,11 +30,51 @@ type Postprocessing struct {
 	Events  Events `yaml:"events"`
 	Workers int    `yaml:"workers" env:"POSTPROCESSING_WORKERS" desc:"The number of concurrent go routines that fetch events from the event queue." introductionVersion:"6.7"`
 
-	Steps           []string      `yaml:"steps" env:"POSTPROCESSING_STEPS" desc:"A list of postprocessing steps processed in order of their appearance. Currently supported values by the system are: 'virusscan', 'policies' and 'delay'. Custom steps are allowed. See the documentation for instructions. See the Environment Variable Types description for more details." introductionVersion:"pre5.0"`
+	Steps           []string      `yaml:"steps" env:"POSTPROCESSING_STEPS" desc:"A list of postprocessing steps processed in order of their appearance. Steps joined by '|' like 'virusscan|policies' run in parallel. Currently supported values by the system are: 'virusscan', 'policies' and 'delay'. Custom steps are allowed. See the documentation for instructions. See the Environment Variable Types description for more details." introductionVersion:"pre5.0"`
 	Delayprocessing time.Duration `yaml:"delayprocessing" env:"POSTPROCESSING_DELAY" desc:"After uploading a file but before making it available for download, a delay step can be added. Intended for developing purposes only. If a duration is set but the keyword 'delay' is not explicitely added to 'POSTPROCESSING_STEPS', the delay step will be processed as last step. In such a case, a log entry will be written on service startup to remind the admin about that situation. See the Environment Variable Types description for more details." introductionVersion:"pre5.0"`
 
+	// StepConfig configures the dependencies and conditions of the steps by their name. It can only be set in the config file.
+	StepConfig map[string]Step `yaml:"step_config"`
+
 	RetryBackoffDuration time.Duration `yaml:"retry_backoff_duration" env:"POSTPROCESSING_RETRY_BACKOFF_DURATION" desc:"The base for the exponential backoff duration before retrying a failed postprocessing step. See the Environment Variable Types description for more details." introductionVersion:"5.0"`
 	MaxRetries           int           `yaml:"max_retries" env:"POSTPROCESSING_MAX_RETRIES" desc:"The maximum number of retries for a failed postprocessing step." introductionVersion:"5.0"`
+
+	StepTimeout        time.Duration `yaml:"step_timeout" env:"POSTPROCESSING_STEP_TIMEOUT" desc:"The time a postprocessing step may take before it is treated as timed out. A step which timed out is finished with the outcome defined by POSTPROCESSING_STEP_TIMEOUT_OUTCOME and recorded as dead letter. Set to 0 to wait forever. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	StepTimeoutOutcome string        `yaml:"step_timeout_outcome" env:"POSTPROCESSING_STEP_TIMEOUT_OUTCOME" desc:"The outcome of a postprocessing step which timed out. Supported values are 'abort', 'delete', 'retry' and 'continue'." introductionVersion:"7.0.0"`
+	DeadLetterTTL      time.Duration `yaml:"dead_letter_ttl" env:"POSTPROCESSING_DEAD_LETTER_TTL" desc:"The time dead letters of steps which timed out are kept in the store. Set to 0 to keep them forever. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+
+	WebhookCallbackURL string `yaml:"webhook_callback_url" env:"POSTPROCESSING_WEBHOOK_CALLBACK_URL" desc:"The base URL of the postprocessing service which is sent to webhooks to report their results asynchronously. Defaults to OCIS_URL with the path '/postprocessing', which the proxy routes to the service. Without OCIS_URL, the address of the HTTP service is used. Must be reachable by the webhooks." introductionVersion:"7.0.0"`
+}
+
+// Step configures a single postprocessing step.
+type Step struct {
+	// Needs overrides the steps which need to be finished before the step starts.
+	// By default, a step waits for the steps of the previous entry in Steps.
+	Needs      []string       `yaml:"needs"`
+	Conditions StepConditions `yaml:"conditions"`
+
+	// Timeout and TimeoutOutcome override StepTimeout and StepTimeoutOutcome for the step.
+	Timeout        time.Duration `yaml:"timeout"`
+	TimeoutOutcome string        `yaml:"timeout_outcome"`
+
+	// Webhook lets the postprocessing service handle the step by calling a webhook.
+	Webhook Webhook `yaml:"webhook"`
+}
+
+// Webhook configures the webhook of a postprocessing step.
+type Webhook struct {
+	URL      string        `yaml:"url"`
+	Secret   string        `yaml:"secret"` // signs the requests and the callback urls
+	Timeout  time.Duration `yaml:"timeout"`
+	Insecure bool          `yaml:"insecure"`
+}
+
+// StepConditions restrict a postprocessing step to matching uploads. Unset conditions match every upload.
+type StepConditions struct {
+	MimeTypes  []string `yaml:"mimetypes"` // patterns like 'image/*' are supported
+	MinSize    uint64   `yaml:"min_size"`
+	MaxSize    uint64   `yaml:"max_size"`
+	SpaceTypes []string `yaml:"space_types"` // 'personal' or 'project'
 }
 
 // Events combines the configuration options for the event bus.
@@ -47,6 +89,21 @@ type Events struct {
 	AuthPassword         string `yaml:"password" env:"OCIS_EVENTS_AUTH_PASSWORD;POSTPROCESSING_EVENTS_AUTH_PASSWORD" desc:"The password to authenticate with the events broker. The events broker is the ocis service which receives and delivers events between the services." introductionVersion:"5.0"`
 }
 
+// HTTP defines the available http configuration.
+type HTTP struct {
+	Addr      string                `yaml:"addr" env:"POSTPROCESSING_HTTP_ADDR" desc:"The bind address of the HTTP service." introductionVersion:"7.0.0"`
+	Namespace string                `yaml:"-"`
+	Root      string                `yaml:"root" env:"POSTPROCESSING_HTTP_ROOT" desc:"Subdirectory that serves as the root for this HTTP service." introductionVersion:"7.0.0"`
+	TLS       shared.HTTPServiceTLS `yaml:"tls"`
+
+	EnableProgressAPI bool `yaml:"enable_progress_api" env:"POSTPROCESSING_ENABLE_PROGRESS_API" desc:"Serve the postprocessing state of their uploads to clients. The API needs the jwt secret to authenticate the users. Disabled by default." introductionVersion:"7.0.0"`
+}
+
+// TokenManager is the config for using the reva token manager
+type TokenManager struct {
+	JWTSecret string `yaml:"jwt_secret" env:"OCIS_JWT_SECRET;POSTPROCESSING_JWT_SECRET" desc:"The secret to mint and validate jwt tokens." introductionVersion:"7.0.0"`
+}
+
 // Debug defines the available debug configuration.
 type Debug struct {
 	Addr   string `yaml:"addr" env:"POSTPROCESSING_DEBUG_ADDR" desc:"Bind address of the debug server, where metrics, health, config and debug endpoints will be exposed." introductionVersion:"pre5.0"`
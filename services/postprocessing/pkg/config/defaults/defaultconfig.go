@@ -1,6 +1,7 @@
 package defaults
 
 import (
+	"strings"
 	"time"
 
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
@@ -23,6 +24,11 @@ func DefaultConfig() *config.Config {
 			Pprof:  false,
 			Zpages: false,
 		},
+		HTTP: config.HTTP{
+			Addr:      "127.0.0.1:9256",
+			Root:      "/postprocessing",
+			Namespace: "com.owncloud.web",
+		},
 		Service: config.Service{
 			Name: "postprocessing",
 		},
@@ -34,6 +40,8 @@ func DefaultConfig() *config.Config {
 			Workers:              3,
 			RetryBackoffDuration: 5 * time.Second,
 			MaxRetries:           14,
+			StepTimeoutOutcome:   "abort",
+			DeadLetterTTL:        7 * 24 * time.Hour,
 		},
 		Store: config.Store{
 			Store:    "nats-js-kv",
@@ -69,8 +77,38 @@ func EnsureDefaults(cfg *config.Config) {
 	} else if cfg.Tracing == nil {
 		cfg.Tracing = &config.Tracing{}
 	}
+
+	if cfg.TokenManager == nil && cfg.Commons != nil && cfg.Commons.TokenManager != nil {
+		cfg.TokenManager = &config.TokenManager{
+			JWTSecret: cfg.Commons.TokenManager.JWTSecret,
+		}
+	} else if cfg.TokenManager == nil {
+		cfg.TokenManager = &config.TokenManager{}
+	}
+
+	if cfg.Commons != nil {
+		cfg.HTTP.TLS = cfg.Commons.HTTPServiceTLS
+	}
 }
 
-// Sanitize does nothing atm
+// Sanitize sanitizes the config
 func Sanitize(cfg *config.Config) {
+	// sanitize config
+	if cfg.HTTP.Root != "/" {
+		cfg.HTTP.Root = strings.TrimSuffix(cfg.HTTP.Root, "/")
+	}
+
+	switch {
+	case cfg.Postprocessing.WebhookCallbackURL != "":
+	case cfg.Commons != nil && cfg.Commons.OcisURL != "" && cfg.HTTP.Root == "/postprocessing":
+		// the proxy routes the callbacks of webhooks to the service without user authentication
+		cfg.Postprocessing.WebhookCallbackURL = strings.TrimSuffix(cfg.Commons.OcisURL, "/") + cfg.HTTP.Root
+	default:
+		scheme := "http"
+		if cfg.HTTP.TLS.Enabled {
+			scheme = "https"
+		}
+		cfg.Postprocessing.WebhookCallbackURL = scheme + "://" + cfg.HTTP.Addr + strings.TrimSuffix(cfg.HTTP.Root, "/")
+	}
+	cfg.Postprocessing.WebhookCallbackURL = strings.TrimSuffix(cfg.Postprocessing.WebhookCallbackURL, "/")
 }
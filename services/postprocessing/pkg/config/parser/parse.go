@@ -7,8 +7,10 @@ import (
 
 	"github.com/cs3org/reva/v2/pkg/events"
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/envdecode"
 )
@@ -37,6 +39,10 @@ func ParseConfig(cfg *config.Config) error {
 
 // Validate validates the config
 func Validate(cfg *config.Config) error {
+	if cfg.HTTP.EnableProgressAPI && cfg.TokenManager.JWTSecret == "" {
+		return shared.MissingJWTTokenError(cfg.Service.Name)
+	}
+
 	if cfg.Postprocessing.Delayprocessing != 0 {
 		if !contains(cfg.Postprocessing.Steps, events.PPStepDelay) {
 			if len(cfg.Postprocessing.Steps) > 0 {
@@ -47,13 +53,43 @@ func Validate(cfg *config.Config) error {
 			cfg.Postprocessing.Steps = append(cfg.Postprocessing.Steps, string(events.PPStepDelay))
 		}
 	}
+
+	if _, _, err := postprocessing.ParseSteps(cfg.Postprocessing); err != nil {
+		return err
+	}
+
+	if err := validateOutcome(cfg.Postprocessing.StepTimeoutOutcome); err != nil {
+		return err
+	}
+	for name, s := range cfg.Postprocessing.StepConfig {
+		if s.Webhook.URL != "" && s.Webhook.Secret == "" {
+			return fmt.Errorf("postprocessing step '%s': the webhook needs a secret", name)
+		}
+		if s.TimeoutOutcome == "" {
+			continue
+		}
+		if err := validateOutcome(s.TimeoutOutcome); err != nil {
+			return fmt.Errorf("postprocessing step '%s': %w", name, err)
+		}
+	}
 	return nil
 }
 
+func validateOutcome(outcome string) error {
+	switch events.PostprocessingOutcome(outcome) {
+	case events.PPOutcomeAbort, events.PPOutcomeDelete, events.PPOutcomeRetry, events.PPOutcomeContinue:
+		return nil
+	default:
+		return fmt.Errorf("unknown timeout outcome '%s'", outcome)
+	}
+}
+
 func contains(all []string, candidate events.Postprocessingstep) bool {
-	for _, s := range all {
-		if s == string(candidate) {
-			return true
+	for _, entry := range all {
+		for _, s := range strings.Split(entry, "|") {
+			if strings.TrimSpace(s) == string(candidate) {
+				return true
+			}
 		}
 	}
 	return false
@@ -19,7 +19,9 @@ type Postprocessing struct {
 	Filename          string
 	Filesize          uint64
 	ResourceID        *provider.ResourceId
+	SpaceType         string
 	Steps             []events.Postprocessingstep
+	Needs             map[events.Postprocessingstep][]events.Postprocessingstep
 	Status            Status
 	Failures          int
 	InitiatorID       string
@@ -32,6 +34,7 @@ type Postprocessing struct {
 type Status struct {
 	CurrentStep events.Postprocessingstep
 	Outcome     events.PostprocessingOutcome
+	Steps       map[events.Postprocessingstep]StepStatus
 }
 
 // New returns a new postprocessing instance
@@ -41,61 +44,185 @@ func New(config config.Postprocessing) *Postprocessing {
 	}
 }
 
-// Init is the first step of the postprocessing
-func (pp *Postprocessing) Init(_ events.BytesReceived) interface{} {
-	if len(pp.Steps) == 0 {
-		return pp.finished(events.PPOutcomeContinue)
+// Init is the first step of the postprocessing. It starts all steps which do not need other steps.
+func (pp *Postprocessing) Init(ev events.BytesReceived) []interface{} {
+	pp.SpaceType = SpaceTypeProject
+	if ev.SpaceOwner != nil && ev.SpaceOwner.GetOpaqueId() == ev.ResourceID.GetSpaceId() {
+		// the id of a personal space is the id of its owner
+		pp.SpaceType = SpaceTypePersonal
 	}
 
-	return pp.step(pp.Steps[0])
+	pp.Status.Steps = make(map[events.Postprocessingstep]StepStatus, len(pp.Steps))
+	return pp.next()
 }
 
-// NextStep returns the next postprocessing step
-func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) interface{} {
+// NextStep handles the outcome of a step and returns the steps which can be started now
+func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) []interface{} {
+	pp.migrate()
+
+	st, ok := pp.Status.Steps[ev.FinishedStep]
+	if !ok || st.State != StepRunning || pp.Status.CurrentStep == events.PPStepFinished {
+		// the step is unknown or reported twice, e.g. after resuming the postprocessing
+		return nil
+	}
+
+	st.Outcome = ev.Outcome
+	st.Reason = resultReason(ev.Result)
 	switch ev.Outcome {
 	case events.PPOutcomeContinue:
-		return pp.next(ev.FinishedStep)
+		st.State = StepDone
+		pp.Status.Steps[ev.FinishedStep] = st
+		return pp.next()
 	case events.PPOutcomeRetry:
 		pp.Failures++
-		if pp.Failures > pp.config.MaxRetries {
-			return pp.finished(events.PPOutcomeAbort)
+		st.Failures++
+		// the timeout of the step starts again when it is retried
+		st.Started = time.Now().Add(pp.config.RetryBackoffDuration * time.Duration(math.Pow(2, float64(st.Failures-1))))
+		pp.Status.Steps[ev.FinishedStep] = st
+		if st.Failures > pp.config.MaxRetries {
+			return []interface{}{pp.finished(events.PPOutcomeAbort)}
 		}
-		return pp.retry()
+		return []interface{}{pp.retry(ev.FinishedStep)}
 	default:
-		return pp.finished(ev.Outcome)
+		st.State = StepDone
+		pp.Status.Steps[ev.FinishedStep] = st
+		return []interface{}{pp.finished(ev.Outcome)}
 	}
 }
 
-// CurrentStep returns the current postprocessing step
-func (pp *Postprocessing) CurrentStep() interface{} {
+// CurrentSteps returns the events to resume the postprocessing. Finished steps are not started again.
+func (pp *Postprocessing) CurrentSteps() []interface{} {
 	if pp.Status.CurrentStep == events.PPStepFinished {
-		return pp.finished(pp.Status.Outcome)
+		return []interface{}{pp.finished(pp.Status.Outcome)}
+	}
+
+	pp.migrate()
+
+	var next []interface{}
+	for _, s := range pp.Steps {
+		if st := pp.Status.Steps[s]; st.State == StepRunning {
+			st.Started = time.Now()
+			pp.Status.Steps[s] = st
+			next = append(next, pp.step(s))
+		}
 	}
-	return pp.step(pp.Status.CurrentStep)
+	return append(next, pp.next()...)
 }
 
-// Delay will sleep the configured time then continue
-func (pp *Postprocessing) Delay(f func(next interface{})) {
-	next := pp.next(events.PPStepDelay)
+// RunningSteps returns the steps which were started but did not report back yet
+func (pp *Postprocessing) RunningSteps() []events.Postprocessingstep {
+	pp.migrate()
+
+	var running []events.Postprocessingstep
+	for _, s := range pp.Steps {
+		if pp.Status.Steps[s].State == StepRunning {
+			running = append(running, s)
+		}
+	}
+	return running
+}
+
+// Delay will sleep the configured time then report the delay step as finished
+func (pp *Postprocessing) Delay(f func(finished events.PostprocessingStepFinished)) {
+	finished := events.PostprocessingStepFinished{
+		UploadID:      pp.ID,
+		ExecutingUser: pp.User,
+		Filename:      pp.Filename,
+		FinishedStep:  events.PPStepDelay,
+		Outcome:       events.PPOutcomeContinue,
+	}
 	go func() {
 		time.Sleep(pp.config.Delayprocessing)
-		f(next)
+		f(finished)
 	}()
 }
 
-// BackoffDuration calculates the duration for exponential backoff based on the number of failures.
-func (pp *Postprocessing) BackoffDuration() time.Duration {
-	return pp.config.RetryBackoffDuration * time.Duration(math.Pow(2, float64(pp.Failures-1)))
+// BackoffDuration calculates the duration for exponential backoff based on the number of failures of the step.
+func (pp *Postprocessing) BackoffDuration(step events.Postprocessingstep) time.Duration {
+	return pp.config.RetryBackoffDuration * time.Duration(math.Pow(2, float64(pp.Status.Steps[step].Failures-1)))
 }
 
-func (pp *Postprocessing) next(current events.Postprocessingstep) interface{} {
-	l := len(pp.Steps)
+// next starts all pending steps whose needed steps are done. Steps not matching their conditions are skipped.
+// The postprocessing is finished when no step is left.
+func (pp *Postprocessing) next() []interface{} {
+	var next []interface{}
+	for progress := true; progress; {
+		progress = false
+		for _, s := range pp.Steps {
+			if pp.Status.Steps[s].State != StepPending || !pp.ready(s) {
+				continue
+			}
+
+			if !pp.matches(s) {
+				pp.Status.Steps[s] = StepStatus{State: StepSkipped}
+				// skipping a step can make others ready
+				progress = true
+				continue
+			}
+
+			pp.Status.Steps[s] = StepStatus{State: StepRunning, Started: time.Now()}
+			next = append(next, pp.step(s))
+		}
+	}
+
+	if len(next) > 0 {
+		return next
+	}
+
+	for _, s := range pp.Steps {
+		switch pp.Status.Steps[s].State {
+		case StepDone, StepSkipped:
+		default:
+			// still waiting for running steps
+			return nil
+		}
+	}
+	return []interface{}{pp.finished(events.PPOutcomeContinue)}
+}
+
+// ready checks if all steps needed by the step are done or skipped
+func (pp *Postprocessing) ready(step events.Postprocessingstep) bool {
+	for _, n := range pp.needs(step) {
+		switch pp.Status.Steps[n].State {
+		case StepDone, StepSkipped:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (pp *Postprocessing) needs(step events.Postprocessingstep) []events.Postprocessingstep {
+	if pp.Needs != nil {
+		return pp.Needs[step]
+	}
+
+	// postprocessings stored by older versions process their steps in order
 	for i, s := range pp.Steps {
-		if s == current && i+1 < l {
-			return pp.step(pp.Steps[i+1])
+		if s == step && i > 0 {
+			return pp.Steps[i-1 : i]
+		}
+	}
+	return nil
+}
+
+// migrate converts the status of postprocessings stored by older versions, which only know the current step
+func (pp *Postprocessing) migrate() {
+	if pp.Status.Steps != nil {
+		return
+	}
+
+	pp.Status.Steps = make(map[events.Postprocessingstep]StepStatus, len(pp.Steps))
+	if pp.Status.CurrentStep == "" || pp.Status.CurrentStep == events.PPStepFinished {
+		return
+	}
+	for _, s := range pp.Steps {
+		if s == pp.Status.CurrentStep {
+			pp.Status.Steps[s] = StepStatus{State: StepRunning, Failures: pp.Failures}
+			return
 		}
+		pp.Status.Steps[s] = StepStatus{State: StepDone, Outcome: events.PPOutcomeContinue}
 	}
-	return pp.finished(events.PPOutcomeContinue)
 }
 
 func (pp *Postprocessing) step(next events.Postprocessingstep) events.StartPostprocessingStep {
@@ -124,13 +251,12 @@ func (pp *Postprocessing) finished(outcome events.PostprocessingOutcome) events.
 	}
 }
 
-func (pp *Postprocessing) retry() events.PostprocessingRetry {
-	pp.Status.Outcome = events.PPOutcomeRetry
+func (pp *Postprocessing) retry(step events.Postprocessingstep) events.PostprocessingRetry {
 	return events.PostprocessingRetry{
 		UploadID:        pp.ID,
 		ExecutingUser:   pp.User,
 		Filename:        pp.Filename,
-		Failures:        pp.Failures,
-		BackoffDuration: pp.BackoffDuration(),
+		Failures:        pp.Status.Steps[step].Failures,
+		BackoffDuration: pp.BackoffDuration(step),
 	}
 }
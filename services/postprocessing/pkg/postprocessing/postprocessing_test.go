@@ -0,0 +1,233 @@
+package postprocessing_test
+
+import (
+	"testing"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+)
+
+func newPostprocessing(t *testing.T, c config.Postprocessing, filename string, size uint64) *postprocessing.Postprocessing {
+	steps, needs, err := postprocessing.ParseSteps(c)
+	require.NoError(t, err)
+
+	pp := postprocessing.New(c)
+	pp.ID = "upload"
+	pp.Filename = filename
+	pp.Filesize = size
+	pp.ResourceID = &provider.ResourceId{SpaceId: "space"}
+	pp.Steps = steps
+	pp.Needs = needs
+	return pp
+}
+
+func started(next []interface{}) []events.Postprocessingstep {
+	var steps []events.Postprocessingstep
+	for _, n := range next {
+		if ev, ok := n.(events.StartPostprocessingStep); ok {
+			steps = append(steps, ev.StepToStart)
+		}
+	}
+	return steps
+}
+
+func finish(step events.Postprocessingstep, outcome events.PostprocessingOutcome) events.PostprocessingStepFinished {
+	return events.PostprocessingStepFinished{UploadID: "upload", FinishedStep: step, Outcome: outcome}
+}
+
+func TestParseSteps(t *testing.T) {
+	steps, needs, err := postprocessing.ParseSteps(config.Postprocessing{
+		Steps: []string{"virusscan|policies", "classifier", "delay"},
+		StepConfig: map[string]config.Step{
+			"delay": {Needs: []string{"virusscan"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []events.Postprocessingstep{"virusscan", "policies", "classifier", "delay"}, steps)
+	assert.Empty(t, needs["virusscan"])
+	assert.Empty(t, needs["policies"])
+	assert.Equal(t, []events.Postprocessingstep{"virusscan", "policies"}, needs["classifier"])
+	assert.Equal(t, []events.Postprocessingstep{"virusscan"}, needs["delay"])
+
+	for name, c := range map[string]config.Postprocessing{
+		"duplicate": {Steps: []string{"virusscan", "virusscan"}},
+		"empty":     {Steps: []string{"virusscan|"}},
+		"unknown":   {Steps: []string{"virusscan"}, StepConfig: map[string]config.Step{"policies": {}}},
+		"needs":     {Steps: []string{"virusscan"}, StepConfig: map[string]config.Step{"virusscan": {Needs: []string{"policies"}}}},
+		"cycle": {Steps: []string{"virusscan", "policies"}, StepConfig: map[string]config.Step{
+			"virusscan": {Needs: []string{"policies"}},
+		}},
+	} {
+		_, _, err := postprocessing.ParseSteps(c)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestParallelSteps(t *testing.T) {
+	pp := newPostprocessing(t, config.Postprocessing{Steps: []string{"virusscan|policies", "classifier"}}, "file.txt", 10)
+
+	next := pp.Init(events.BytesReceived{})
+	assert.ElementsMatch(t, []events.Postprocessingstep{"virusscan", "policies"}, started(next))
+
+	// classifier needs both steps
+	assert.Empty(t, pp.NextStep(finish("policies", events.PPOutcomeContinue)))
+	assert.Equal(t, []events.Postprocessingstep{"classifier"}, started(pp.NextStep(finish("virusscan", events.PPOutcomeContinue))))
+
+	// reporting a step twice does nothing
+	assert.Empty(t, pp.NextStep(finish("virusscan", events.PPOutcomeContinue)))
+
+	next = pp.NextStep(finish("classifier", events.PPOutcomeContinue))
+	require.Len(t, next, 1)
+	assert.Equal(t, events.PPOutcomeContinue, next[0].(events.PostprocessingFinished).Outcome)
+	assert.Equal(t, postprocessing.StepDone, pp.Status.Steps["classifier"].State)
+}
+
+func TestParallelStepsAbort(t *testing.T) {
+	pp := newPostprocessing(t, config.Postprocessing{Steps: []string{"virusscan|policies"}}, "file.txt", 10)
+	pp.Init(events.BytesReceived{})
+
+	next := pp.NextStep(finish("virusscan", events.PPOutcomeDelete))
+	require.Len(t, next, 1)
+	assert.Equal(t, events.PPOutcomeDelete, next[0].(events.PostprocessingFinished).Outcome)
+
+	// the outcome of the other step does not matter anymore
+	assert.Empty(t, pp.NextStep(finish("policies", events.PPOutcomeContinue)))
+	assert.Equal(t, events.PPOutcomeDelete, pp.Status.Outcome)
+}
+
+func TestStepConditions(t *testing.T) {
+	c := config.Postprocessing{
+		Steps: []string{"virusscan", "thumbnails", "classifier"},
+		StepConfig: map[string]config.Step{
+			"virusscan":  {Conditions: config.StepConditions{MaxSize: 100}},
+			"thumbnails": {Conditions: config.StepConditions{MimeTypes: []string{"image/*"}}},
+			"classifier": {Conditions: config.StepConditions{SpaceTypes: []string{postprocessing.SpaceTypeProject}}},
+		},
+	}
+
+	pp := newPostprocessing(t, c, "image.png", 10)
+	assert.Equal(t, []events.Postprocessingstep{"virusscan"}, started(pp.Init(events.BytesReceived{})))
+	assert.Equal(t, []events.Postprocessingstep{"thumbnails"}, started(pp.NextStep(finish("virusscan", events.PPOutcomeContinue))))
+	assert.Equal(t, []events.Postprocessingstep{"classifier"}, started(pp.NextStep(finish("thumbnails", events.PPOutcomeContinue))))
+
+	// a large document in a personal space skips all steps
+	pp = newPostprocessing(t, c, "file.txt", 1000)
+	next := pp.Init(events.BytesReceived{SpaceOwner: &user.UserId{OpaqueId: "space"}, ResourceID: pp.ResourceID})
+	require.Len(t, next, 1)
+	assert.Equal(t, events.PPOutcomeContinue, next[0].(events.PostprocessingFinished).Outcome)
+	assert.Equal(t, postprocessing.SpaceTypePersonal, pp.SpaceType)
+	for _, s := range pp.Steps {
+		assert.Equal(t, postprocessing.StepSkipped, pp.Status.Steps[s].State)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	pp := newPostprocessing(t, config.Postprocessing{Steps: []string{"virusscan|policies"}, MaxRetries: 1}, "file.txt", 10)
+	pp.Init(events.BytesReceived{})
+
+	next := pp.NextStep(finish("virusscan", events.PPOutcomeRetry))
+	require.Len(t, next, 1)
+	assert.Equal(t, 1, next[0].(events.PostprocessingRetry).Failures)
+
+	// failures are counted per step
+	next = pp.NextStep(finish("policies", events.PPOutcomeRetry))
+	assert.Equal(t, 1, next[0].(events.PostprocessingRetry).Failures)
+
+	next = pp.NextStep(finish("virusscan", events.PPOutcomeRetry))
+	require.Len(t, next, 1)
+	assert.Equal(t, events.PPOutcomeAbort, next[0].(events.PostprocessingFinished).Outcome)
+}
+
+func TestCurrentSteps(t *testing.T) {
+	pp := newPostprocessing(t, config.Postprocessing{Steps: []string{"virusscan|policies", "classifier"}}, "file.txt", 10)
+	pp.Init(events.BytesReceived{})
+	pp.NextStep(finish("virusscan", events.PPOutcomeContinue))
+
+	// resuming a partially completed graph only starts the unfinished steps
+	assert.Equal(t, []events.Postprocessingstep{"policies"}, started(pp.CurrentSteps()))
+	assert.Equal(t, []events.Postprocessingstep{"policies"}, pp.RunningSteps())
+}
+
+func TestLegacyStatus(t *testing.T) {
+	// postprocessings stored by older versions neither know the dependencies nor the status of each step
+	pp := postprocessing.New(config.Postprocessing{})
+	pp.Steps = []events.Postprocessingstep{"virusscan", "policies", "classifier"}
+	pp.Status.CurrentStep = "policies"
+
+	assert.Equal(t, []events.Postprocessingstep{"policies"}, started(pp.CurrentSteps()))
+	assert.Equal(t, []events.Postprocessingstep{"classifier"}, started(pp.NextStep(finish("policies", events.PPOutcomeContinue))))
+}
+
+func TestTimedOut(t *testing.T) {
+	c := config.Postprocessing{
+		Steps:              []string{"virusscan|classifier"},
+		StepTimeout:        time.Hour,
+		StepTimeoutOutcome: "abort",
+		StepConfig: map[string]config.Step{
+			"classifier": {Timeout: time.Minute, TimeoutOutcome: "continue"},
+		},
+	}
+	pp := newPostprocessing(t, c, "file.txt", 10)
+	pp.Init(events.BytesReceived{})
+	now := time.Now()
+
+	assert.Empty(t, pp.TimedOut(now))
+
+	timedOut := pp.TimedOut(now.Add(2 * time.Minute))
+	require.Len(t, timedOut, 1)
+	assert.Equal(t, events.Postprocessingstep("classifier"), timedOut[0].Step)
+	assert.Equal(t, events.PPOutcomeContinue, timedOut[0].Outcome)
+	assert.Equal(t, time.Minute, timedOut[0].Timeout)
+
+	timedOut = pp.TimedOut(now.Add(2 * time.Hour))
+	require.Len(t, timedOut, 2)
+	assert.Equal(t, events.Postprocessingstep("virusscan"), timedOut[0].Step)
+	assert.Equal(t, events.PPOutcomeAbort, timedOut[0].Outcome)
+
+	// finished steps do not time out
+	pp.NextStep(finish("virusscan", events.PPOutcomeContinue))
+	assert.Len(t, pp.TimedOut(now.Add(2*time.Hour)), 1)
+}
+
+func TestProgress(t *testing.T) {
+	pp := newPostprocessing(t, config.Postprocessing{
+		Steps:                []string{"virusscan|policies", "delay"},
+		RetryBackoffDuration: time.Minute,
+		MaxRetries:           3,
+		StepTimeout:          time.Hour,
+	}, "a.txt", 1)
+	pp.Init(events.BytesReceived{ResourceID: pp.ResourceID})
+
+	ev := finish("virusscan", events.PPOutcomeRetry)
+	ev.Result = map[string]interface{}{"ErrorMsg": "scanner unavailable"}
+	pp.NextStep(ev)
+
+	now := time.Now()
+	p := pp.Progress(now)
+	assert.Equal(t, postprocessing.ProgressProcessing, p.Status)
+	require.Len(t, p.Steps, 3)
+
+	assert.Equal(t, "running", p.Steps[0].State)
+	assert.Equal(t, "scanner unavailable", p.Steps[0].Reason)
+	assert.Equal(t, 1, p.Steps[0].Failures)
+	require.NotNil(t, p.Steps[0].RetryAt)
+	assert.True(t, p.Steps[0].RetryAt.After(now))
+	assert.Nil(t, p.Steps[0].Started)
+
+	require.NotNil(t, p.Steps[1].Started)
+	require.NotNil(t, p.Steps[1].TimeoutAt)
+	assert.Equal(t, p.Steps[1].Started.Add(time.Hour), *p.Steps[1].TimeoutAt)
+	assert.Equal(t, "pending", p.Steps[2].State)
+
+	pp.NextStep(finish("policies", events.PPOutcomeDelete))
+	p = pp.Progress(now)
+	assert.Equal(t, postprocessing.ProgressFailed, p.Status)
+	assert.Equal(t, events.PPOutcomeDelete, p.Outcome)
+}
@@ -0,0 +1,118 @@
+package postprocessing
+
+import (
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+)
+
+const (
+	// ProgressProcessing means the upload is still in postprocessing
+	ProgressProcessing = "processing"
+	// ProgressFinished means all steps are done and the upload is available
+	ProgressFinished = "finished"
+	// ProgressFailed means the postprocessing was aborted or the file was deleted
+	ProgressFailed = "failed"
+)
+
+// Progress is the postprocessing state of an upload as shown to clients
+type Progress struct {
+	UploadID   string                       `json:"uploadId"`
+	Filename   string                       `json:"filename"`
+	ResourceID string                       `json:"resourceId,omitempty"`
+	SpaceID    string                       `json:"spaceId,omitempty"`
+	Status     string                       `json:"status"`
+	Outcome    events.PostprocessingOutcome `json:"outcome,omitempty"`
+	Failures   int                          `json:"failures"`
+	Steps      []StepProgress               `json:"steps"`
+}
+
+// StepProgress is the state of a single postprocessing step as shown to clients
+type StepProgress struct {
+	Name     events.Postprocessingstep    `json:"name"`
+	State    string                       `json:"state"`
+	Outcome  events.PostprocessingOutcome `json:"outcome,omitempty"`
+	Reason   string                       `json:"reason,omitempty"`
+	Failures int                          `json:"failures"`
+	Started  *time.Time                   `json:"started,omitempty"`
+	// RetryAt is set while a failed step waits for its next attempt
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+	// TimeoutAt is the latest time a running step finishes with its timeout outcome
+	TimeoutAt *time.Time `json:"timeoutAt,omitempty"`
+}
+
+// Progress returns the postprocessing state of the upload
+func (pp *Postprocessing) Progress(now time.Time) Progress {
+	pp.migrate()
+
+	p := Progress{
+		UploadID: pp.ID,
+		Filename: pp.Filename,
+		Status:   ProgressProcessing,
+		Failures: pp.Failures,
+		Steps:    make([]StepProgress, 0, len(pp.Steps)),
+	}
+	if rid := pp.ResourceID; rid != nil {
+		p.ResourceID = storagespace.FormatResourceID(rid)
+		p.SpaceID = storagespace.FormatStorageID(rid.GetStorageId(), rid.GetSpaceId())
+	}
+
+	switch {
+	case pp.Status.CurrentStep == events.PPStepFinished && pp.Status.Outcome == events.PPOutcomeContinue:
+		p.Status, p.Outcome = ProgressFinished, pp.Status.Outcome
+	case pp.Status.CurrentStep == events.PPStepFinished, pp.Finished:
+		p.Status, p.Outcome = ProgressFailed, pp.Status.Outcome
+	}
+
+	for _, s := range pp.Steps {
+		st := pp.Status.Steps[s]
+		sp := StepProgress{
+			Name:     s,
+			State:    string(st.State),
+			Outcome:  st.Outcome,
+			Reason:   st.Reason,
+			Failures: st.Failures,
+		}
+		if st.State == StepPending {
+			sp.State = "pending"
+		}
+
+		if st.State == StepRunning && !st.Started.IsZero() {
+			started := st.Started
+			if started.After(now) {
+				// retried steps start again after their backoff duration
+				sp.RetryAt = &started
+			} else {
+				sp.Started = &started
+			}
+			if timeout, _ := pp.Timeout(s); timeout > 0 {
+				t := started.Add(timeout)
+				sp.TimeoutAt = &t
+			}
+		}
+		p.Steps = append(p.Steps, sp)
+	}
+	return p
+}
+
+// resultReason extracts a human readable reason from the result of a finished step. Results of other services
+// are unmarshalled from the event payload and arrive as map.
+func resultReason(result interface{}) string {
+	switch r := result.(type) {
+	case string:
+		return r
+	case events.VirusscanResult:
+		if r.ErrorMsg != "" {
+			return r.ErrorMsg
+		}
+		return r.Description
+	case map[string]interface{}:
+		for _, k := range []string{"ErrorMsg", "Description", "reason", "Reason"} {
+			if v, ok := r[k].(string); ok && v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package postprocessing
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/mime"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+)
+
+const (
+	// SpaceTypePersonal is the space type of personal spaces
+	SpaceTypePersonal = "personal"
+	// SpaceTypeProject is the space type of project spaces
+	SpaceTypeProject = "project"
+)
+
+// StepState is the state of a single postprocessing step
+type StepState string
+
+const (
+	// StepPending means the step waits for the steps it needs
+	StepPending StepState = ""
+	// StepRunning means the step was started and did not report back yet
+	StepRunning StepState = "running"
+	// StepDone means the step reported its outcome
+	StepDone StepState = "done"
+	// StepSkipped means the conditions of the step did not match the upload
+	StepSkipped StepState = "skipped"
+)
+
+// StepStatus is the status of a single postprocessing step
+type StepStatus struct {
+	State    StepState
+	Outcome  events.PostprocessingOutcome `json:",omitempty"`
+	Failures int                          `json:",omitempty"`
+	Reason   string                       `json:",omitempty"` // the reason reported with the last outcome
+	Started  time.Time
+}
+
+// ParseSteps builds the step graph from the configuration. Each entry of the configured steps waits for all steps of
+// the previous entry, steps joined by '|' within an entry run in parallel. The step config can override the dependencies.
+// It returns the steps in configured order and the steps each of them needs.
+func ParseSteps(c config.Postprocessing) ([]events.Postprocessingstep, map[events.Postprocessingstep][]events.Postprocessingstep, error) {
+	var (
+		steps    []events.Postprocessingstep
+		needs    = make(map[events.Postprocessingstep][]events.Postprocessingstep)
+		previous []events.Postprocessingstep
+	)
+
+	for _, entry := range c.Steps {
+		var group []events.Postprocessingstep
+		for _, name := range strings.Split(entry, "|") {
+			s := events.Postprocessingstep(strings.TrimSpace(name))
+			if s == "" {
+				return nil, nil, fmt.Errorf("empty postprocessing step in '%s'", entry)
+			}
+			if _, ok := needs[s]; ok {
+				return nil, nil, fmt.Errorf("postprocessing step '%s' is configured twice", s)
+			}
+			steps = append(steps, s)
+			needs[s] = previous
+			group = append(group, s)
+		}
+		previous = group
+	}
+
+	for name, sc := range c.StepConfig {
+		s := events.Postprocessingstep(name)
+		if _, ok := needs[s]; !ok {
+			return nil, nil, fmt.Errorf("configured postprocessing step '%s' is not part of the postprocessing steps", name)
+		}
+		if sc.Needs == nil {
+			continue
+		}
+
+		n := make([]events.Postprocessingstep, 0, len(sc.Needs))
+		for _, dep := range sc.Needs {
+			d := events.Postprocessingstep(dep)
+			if _, ok := needs[d]; !ok {
+				return nil, nil, fmt.Errorf("postprocessing step '%s' needs unknown step '%s'", name, dep)
+			}
+			n = append(n, d)
+		}
+		needs[s] = n
+	}
+
+	if err := checkCycles(steps, needs); err != nil {
+		return nil, nil, err
+	}
+
+	return steps, needs, nil
+}
+
+func checkCycles(steps []events.Postprocessingstep, needs map[events.Postprocessingstep][]events.Postprocessingstep) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[events.Postprocessingstep]int, len(steps))
+
+	var visit func(s events.Postprocessingstep) error
+	visit = func(s events.Postprocessingstep) error {
+		switch state[s] {
+		case visiting:
+			return fmt.Errorf("postprocessing step '%s' depends on itself", s)
+		case visited:
+			return nil
+		}
+		state[s] = visiting
+		for _, dep := range needs[s] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[s] = visited
+		return nil
+	}
+
+	for _, s := range steps {
+		if err := visit(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matches checks if the upload matches the conditions of the step
+func (pp *Postprocessing) matches(step events.Postprocessingstep) bool {
+	c := pp.config.StepConfig[string(step)].Conditions
+
+	if c.MinSize > 0 && pp.Filesize < c.MinSize {
+		return false
+	}
+	if c.MaxSize > 0 && pp.Filesize > c.MaxSize {
+		return false
+	}
+
+	if len(c.SpaceTypes) > 0 && !matchAny(c.SpaceTypes, pp.SpaceType) {
+		return false
+	}
+
+	if len(c.MimeTypes) > 0 && !matchAny(c.MimeTypes, mime.Detect(false, pp.Filename)) {
+		return false
+	}
+
+	return true
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package postprocessing
+
+import (
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+)
+
+// DeadLetter records a postprocessing step which did not report back before its timeout
+type DeadLetter struct {
+	UploadID  string
+	Filename  string
+	Step      events.Postprocessingstep
+	Started   time.Time
+	Timeout   time.Duration
+	Outcome   events.PostprocessingOutcome
+	Timestamp time.Time
+}
+
+// Timeout returns the timeout of the step and the outcome used when it is exceeded. A timeout of 0 means no timeout.
+func (pp *Postprocessing) Timeout(step events.Postprocessingstep) (time.Duration, events.PostprocessingOutcome) {
+	timeout, outcome := pp.config.StepTimeout, pp.config.StepTimeoutOutcome
+	if sc, ok := pp.config.StepConfig[string(step)]; ok {
+		if sc.Timeout > 0 {
+			timeout = sc.Timeout
+		}
+		if sc.TimeoutOutcome != "" {
+			outcome = sc.TimeoutOutcome
+		}
+	}
+	if outcome == "" {
+		outcome = string(events.PPOutcomeAbort)
+	}
+	return timeout, events.PostprocessingOutcome(outcome)
+}
+
+// Age returns how long the step has been running. Steps stored by older versions have no start time and an age of 0.
+func (pp *Postprocessing) Age(step events.Postprocessingstep, now time.Time) time.Duration {
+	started := pp.Status.Steps[step].Started
+	if started.IsZero() || started.After(now) {
+		return 0
+	}
+	return now.Sub(started)
+}
+
+// TimedOut returns the dead letters of all running steps which exceeded their timeout
+func (pp *Postprocessing) TimedOut(now time.Time) []DeadLetter {
+	if pp.Finished || pp.Status.CurrentStep == events.PPStepFinished {
+		return nil
+	}
+
+	var timedOut []DeadLetter
+	for _, s := range pp.RunningSteps() {
+		timeout, outcome := pp.Timeout(s)
+		if timeout <= 0 || pp.Age(s, now) <= timeout {
+			continue
+		}
+
+		timedOut = append(timedOut, DeadLetter{
+			UploadID:  pp.ID,
+			Filename:  pp.Filename,
+			Step:      s,
+			Started:   pp.Status.Steps[s].Started,
+			Timeout:   timeout,
+			Outcome:   outcome,
+			Timestamp: now,
+		})
+	}
+	return timedOut
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/owncloud/ocis/v2/ocis-pkg/account"
+	"github.com/owncloud/ocis/v2/ocis-pkg/middleware"
+	"github.com/owncloud/ocis/v2/ocis-pkg/service/http"
+	"github.com/owncloud/ocis/v2/ocis-pkg/tracing"
+	"github.com/owncloud/ocis/v2/ocis-pkg/version"
+	svc "github.com/owncloud/ocis/v2/services/postprocessing/pkg/service"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/webhook"
+	"github.com/riandyrn/otelchi"
+	"go-micro.dev/v4"
+)
+
+// Server initializes the http service and server.
+func Server(opts ...Option) (http.Service, error) {
+	options := newOptions(opts...)
+
+	service, err := http.NewService(
+		http.TLSConfig(options.Config.HTTP.TLS),
+		http.Logger(options.Logger),
+		http.Namespace(options.Config.HTTP.Namespace),
+		http.Name(options.Config.Service.Name),
+		http.Version(version.GetString()),
+		http.Address(options.Config.HTTP.Addr),
+		http.Context(options.Context),
+		http.TraceProvider(options.TracerProvider),
+	)
+	if err != nil {
+		options.Logger.Error().
+			Err(err).
+			Msg("Error initializing http service")
+		return http.Service{}, fmt.Errorf("could not initialize http service: %w", err)
+	}
+
+	mux := chi.NewMux()
+	mux.Use(
+		chimiddleware.RequestID,
+		middleware.Version(
+			options.Config.Service.Name,
+			version.GetString(),
+		),
+		middleware.Logger(
+			options.Logger,
+		),
+	)
+
+	mux.Use(
+		otelchi.Middleware(
+			options.Config.Service.Name,
+			otelchi.WithChiRoutes(mux),
+			otelchi.WithTracerProvider(options.TracerProvider),
+			otelchi.WithPropagators(tracing.GetPropagator()),
+		),
+	)
+
+	mux.Route(options.Config.HTTP.Root, func(r chi.Router) {
+		// webhooks report their results asynchronously to this endpoint, they are authorized by the token of the callback url
+		r.Post(webhook.CallbackPath, webhook.CallbackHandler(options.Config.Postprocessing, options.Publisher, options.Logger))
+
+		if !options.Config.HTTP.EnableProgressAPI {
+			return
+		}
+
+		// clients query the postprocessing state of their uploads
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.ExtractAccountUUID(
+				account.Logger(options.Logger),
+				account.JWTSecret(options.Config.TokenManager.JWTSecret),
+			))
+			r.Get("/uploads", svc.UploadsHandler(options.Store, options.Config.Postprocessing, options.Logger))
+			r.Get("/uploads/{uploadID}", svc.UploadHandler(options.Store, options.Config.Postprocessing, options.Logger))
+		})
+	})
+
+	if err := micro.RegisterHandler(service.Server(), mux); err != nil {
+		return http.Service{}, err
+	}
+
+	return service, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/lease"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	ocissync "github.com/owncloud/ocis/v2/ocis-pkg/sync"
+)
+
+func TestLockUploadWaitsForOtherReplicas(t *testing.T) {
+	sto := store.NewMemoryStore()
+	pps := &PostprocessingService{
+		id:    "replica-1",
+		ctx:   context.Background(),
+		log:   log.NopLogger(),
+		store: sto,
+		locks: ocissync.NewNamedRWMutex(),
+	}
+
+	// another replica is updating the upload
+	require.True(t, lease.Acquire(sto, leasePrefix+"upload-1", "replica-2", time.Now(), time.Minute))
+
+	locked := make(chan func())
+	go func() {
+		locked <- pps.lockUpload("upload-1")
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the upload was locked while another replica holds its lease")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, lease.Release(sto, leasePrefix+"upload-1", "replica-2"))
+	select {
+	case unlock := <-locked:
+		unlock()
+	case <-time.After(time.Second):
+		t.Fatal("the upload was not locked after the lease was released")
+	}
+
+	// unlocking releases the lease for other replicas
+	assert.True(t, lease.Acquire(sto, leasePrefix+"upload-1", "replica-2", time.Now(), time.Minute))
+}
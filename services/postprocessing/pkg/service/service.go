@@ -5,27 +5,37 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	ctxpkg "github.com/cs3org/reva/v2/pkg/ctx"
 	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/google/uuid"
+	"github.com/owncloud/ocis/v2/ocis-pkg/lease"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	ocissync "github.com/owncloud/ocis/v2/ocis-pkg/sync"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
 	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/webhook"
 	"go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
 )
 
 // PostprocessingService is an instance of the service handling postprocessing of files
 type PostprocessingService struct {
+	id     string
 	ctx    context.Context
 	log    log.Logger
 	events <-chan events.Event
 	pub    events.Publisher
 	steps  []events.Postprocessingstep
+	needs  map[events.Postprocessingstep][]events.Postprocessingstep
 	store  store.Store
+	locks  ocissync.NamedRWMutex
+	hooks  map[events.Postprocessingstep]webhook.Webhook
 	c      config.Postprocessing
 	tp     trace.TracerProvider
 }
@@ -52,13 +62,29 @@ func NewPostprocessingService(ctx context.Context, stream events.Stream, logger
 		return nil, err
 	}
 
+	steps, needs, err := postprocessing.ParseSteps(c)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make(map[events.Postprocessingstep]webhook.Webhook)
+	for name, s := range c.StepConfig {
+		if s.Webhook.URL != "" {
+			hooks[events.Postprocessingstep(name)] = webhook.New(s.Webhook, c.WebhookCallbackURL)
+		}
+	}
+
 	return &PostprocessingService{
+		id:     uuid.New().String(),
 		ctx:    ctx,
 		log:    logger,
 		events: evs,
 		pub:    stream,
-		steps:  getSteps(c),
+		steps:  steps,
+		needs:  needs,
 		store:  sto,
+		locks:  ocissync.NewNamedRWMutex(),
+		hooks:  hooks,
 		c:      c,
 		tp:     tp,
 	}, nil
@@ -68,6 +94,10 @@ func NewPostprocessingService(ctx context.Context, stream events.Stream, logger
 func (pps *PostprocessingService) Run() error {
 	wg := sync.WaitGroup{}
 
+	if interval := timeoutCheckInterval(pps.c); interval > 0 {
+		go pps.checkTimeouts(interval)
+	}
+
 	for i := 0; i < pps.c.Workers; i++ {
 		wg.Add(1)
 		go func() {
@@ -93,7 +123,7 @@ func (pps *PostprocessingService) Run() error {
 
 func (pps *PostprocessingService) processEvent(e events.Event) error {
 	var (
-		next interface{}
+		next []interface{}
 		pp   *postprocessing.Postprocessing
 		err  error
 	)
@@ -104,6 +134,9 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 
 	switch ev := e.Event.(type) {
 	case events.BytesReceived:
+		unlock := pps.lockUpload(ev.UploadID)
+		defer unlock()
+
 		pp = &postprocessing.Postprocessing{
 			ID:                ev.UploadID,
 			URL:               ev.URL,
@@ -112,6 +145,7 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 			Filesize:          ev.Filesize,
 			ResourceID:        ev.ResourceID,
 			Steps:             pps.steps,
+			Needs:             pps.needs,
 			InitiatorID:       e.InitiatorID,
 			ImpersonatingUser: ev.ImpersonatingUser,
 		}
@@ -121,6 +155,10 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 			// no current upload - this was an on demand scan
 			return nil
 		}
+		// steps running in parallel must not overwrite the outcome of each other, even if they finish on different replicas
+		unlock := pps.lockUpload(ev.UploadID)
+		defer unlock()
+
 		pp, err = pps.getPP(pps.store, ev.UploadID)
 		if err != nil {
 			pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get upload")
@@ -128,10 +166,13 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 		}
 		next = pp.NextStep(ev)
 
-		switch pp.Status.Outcome {
-		case events.PPOutcomeRetry:
+		for _, n := range next {
+			if _, ok := n.(events.PostprocessingRetry); !ok {
+				continue
+			}
+
 			// schedule retry
-			backoff := pp.BackoffDuration()
+			backoff := pp.BackoffDuration(ev.FinishedStep)
 			go func() {
 				time.Sleep(backoff)
 				retryEvent := events.StartPostprocessingStep{
@@ -141,7 +182,7 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 					Filename:          pp.Filename,
 					Filesize:          pp.Filesize,
 					ResourceID:        pp.ResourceID,
-					StepToStart:       pp.Status.CurrentStep,
+					StepToStart:       ev.FinishedStep,
 					ImpersonatingUser: pp.ImpersonatingUser,
 				}
 				err := events.Publish(ctx, pps.pub, retryEvent)
@@ -151,6 +192,10 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 			}()
 		}
 	case events.StartPostprocessingStep:
+		if hook, ok := pps.hooks[ev.StepToStart]; ok {
+			go pps.callWebhook(ctx, hook, ev)
+			return nil
+		}
 		if ev.StepToStart != events.PPStepDelay {
 			return nil
 		}
@@ -159,21 +204,30 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 			pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get upload")
 			return fmt.Errorf("%w: cannot get upload", ErrEvent)
 		}
-		pp.Delay(func(next interface{}) {
-			if err := events.Publish(ctx, pps.pub, next); err != nil {
+		pp.Delay(func(finished events.PostprocessingStepFinished) {
+			if err := events.Publish(ctx, pps.pub, finished); err != nil {
 				pps.log.Error().Err(err).Msg("cannot publish event")
 			}
 		})
+		// the delay step reports back like any other step, there is nothing to store
+		return nil
 	case events.UploadReady:
 		if ev.Failed {
 			// the upload failed - let's keep it around for a while - but mark it as finished
+			unlock := pps.lockUpload(ev.UploadID)
+			defer unlock()
+
 			pp, err = pps.getPP(pps.store, ev.UploadID)
 			if err != nil {
 				pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get upload")
 				return fmt.Errorf("%w: cannot get upload", ErrEvent)
 			}
 			pp.Finished = true
-			return storePP(pps.store, pp)
+			if err := storePP(pps.store, pp); err != nil {
+				return err
+			}
+			pps.sendProgress(ctx, pp)
+			return nil
 		}
 
 		// the storage provider thinks the upload is done - so no need to keep it any more
@@ -192,10 +246,11 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 			pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot store upload")
 			return fmt.Errorf("%w: cannot store upload", ErrEvent)
 		}
+		pps.sendProgress(ctx, pp)
 	}
 
-	if next != nil {
-		if err := events.Publish(ctx, pps.pub, next); err != nil {
+	for _, n := range next {
+		if err := events.Publish(ctx, pps.pub, n); err != nil {
 			pps.log.Error().Err(err).Msg("unable to publish event")
 			return fmt.Errorf("%w: unable to publish event", ErrFatal) // we can't publish -> we are screwed
 		}
@@ -203,7 +258,60 @@ func (pps *PostprocessingService) processEvent(e events.Event) error {
 	return nil
 }
 
+// callWebhook lets the webhook handle the step. Webhooks reporting their result asynchronously use the callback endpoint.
+func (pps *PostprocessingService) callWebhook(ctx context.Context, hook webhook.Webhook, ev events.StartPostprocessingStep) {
+	l := pps.log.With().Str("uploadID", ev.UploadID).Str("step", string(ev.StepToStart)).Logger()
+
+	res, async, err := hook.Call(ctx, ev)
+	switch {
+	case err != nil:
+		l.Error().Err(err).Msg("webhook failed")
+		res = webhook.Result{Outcome: events.PPOutcomeAbort}
+	case async:
+		l.Debug().Msg("webhook accepted the upload, waiting for callback")
+		return
+	}
+
+	l.Debug().Str("outcome", string(res.Outcome)).Str("reason", res.Reason).Msg("webhook reported result")
+	if err := events.Publish(ctx, pps.pub, events.PostprocessingStepFinished{
+		UploadID:      ev.UploadID,
+		ExecutingUser: ev.ExecutingUser,
+		Filename:      ev.Filename,
+		FinishedStep:  ev.StepToStart,
+		Outcome:       res.Outcome,
+		Result:        res.Reason,
+		Timestamp:     utils.TSNow(),
+	}); err != nil {
+		l.Error().Err(err).Msg("cannot publish event")
+	}
+}
+
+// lockUpload serializes the changes of an upload. All replicas share the store, so the upload is leased in the
+// store as well. Otherwise replicas could overwrite the changes of each other, e.g. when parallel steps finish on
+// different replicas at the same time. The returned function releases the upload.
+func (pps *PostprocessingService) lockUpload(uploadID string) func() {
+	pps.locks.Lock(uploadID)
+
+	key := leasePrefix + uploadID
+	// the lease expires if its holder is gone, waiting for it always ends
+	for !lease.Acquire(pps.store, key, pps.id, time.Now(), uploadLeaseDuration) && pps.ctx.Err() == nil {
+		time.Sleep(uploadLeaseRetryInterval)
+	}
+
+	return func() {
+		if err := lease.Release(pps.store, key, pps.id); err != nil {
+			pps.log.Error().Str("uploadID", uploadID).Err(err).Msg("cannot release upload")
+		}
+		pps.locks.Unlock(uploadID)
+	}
+}
+
 func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*postprocessing.Postprocessing, error) {
+	return GetUpload(sto, pps.c, uploadID)
+}
+
+// GetUpload returns the postprocessing of the upload from the store
+func GetUpload(sto store.Store, c config.Postprocessing, uploadID string) (*postprocessing.Postprocessing, error) {
 	recs, err := sto.Read(uploadID)
 	if err != nil {
 		if err == store.ErrNotFound {
@@ -220,7 +328,7 @@ func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*post
 		return nil, fmt.Errorf("expected only one result for '%s', got %d", uploadID, len(recs))
 	}
 
-	pp := postprocessing.New(pps.c)
+	pp := postprocessing.New(c)
 	err = json.Unmarshal(recs[0].Value, pp)
 	if err != nil {
 		return nil, err
@@ -229,18 +337,6 @@ func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*post
 	return pp, nil
 }
 
-func getSteps(c config.Postprocessing) []events.Postprocessingstep {
-	// NOTE: improved version only allows configuring order of postprocessing steps
-	// But we aim for a system where postprocessing steps can be configured per space, ideally by the spaceadmin itself
-	// We need to iterate over configuring PP service when we see fit
-	steps := make([]events.Postprocessingstep, 0, len(c.Steps))
-	for _, s := range c.Steps {
-		steps = append(steps, events.Postprocessingstep(s))
-	}
-
-	return steps
-}
-
 func storePP(sto store.Store, pp *postprocessing.Postprocessing) error {
 	b, err := json.Marshal(pp)
 	if err != nil {
@@ -268,6 +364,9 @@ func (pps *PostprocessingService) handleResumePPEvent(ctx context.Context, ev ev
 }
 
 func (pps *PostprocessingService) resumePP(ctx context.Context, uploadID string) error {
+	unlock := pps.lockUpload(uploadID)
+	defer unlock()
+
 	pp, err := pps.getPP(pps.store, uploadID)
 	if err != nil {
 		if err == ErrNotFound {
@@ -287,40 +386,65 @@ func (pps *PostprocessingService) resumePP(ctx context.Context, uploadID string)
 		return nil
 	}
 
-	return events.Publish(ctx, pps.pub, pp.CurrentStep())
+	// steps which already reported their outcome are not started again
+	next := pp.CurrentSteps()
+	if err := storePP(pps.store, pp); err != nil {
+		return fmt.Errorf("cannot store upload: %w", err)
+	}
+	pps.sendProgress(ctx, pp)
+	for _, ev := range next {
+		if err := events.Publish(ctx, pps.pub, ev); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (pps *PostprocessingService) findUploadsByStep(step events.Postprocessingstep) []string {
 	var ids []string
+	for _, pp := range FindUploads(pps.store, pps.c, pps.log, step) {
+		ids = append(ids, pp.ID)
+	}
+	return ids
+}
+
+// FindUploads returns the postprocessing of all uploads in the store which are currently in the given step.
+// All uploads are returned if the step is empty.
+func FindUploads(sto store.Store, c config.Postprocessing, logger log.Logger, step events.Postprocessingstep) []*postprocessing.Postprocessing {
+	var pps []*postprocessing.Postprocessing
 
-	keys, err := pps.store.List()
+	keys, err := sto.List()
 	if err != nil {
-		pps.log.Error().Err(err).Msg("cannot list uploads")
+		logger.Error().Err(err).Msg("cannot list uploads")
 	}
 
 	for _, k := range keys {
-		rec, err := pps.store.Read(k)
+		if strings.HasPrefix(k, deadLetterPrefix) || strings.HasPrefix(k, leasePrefix) {
+			continue
+		}
+
+		rec, err := sto.Read(k)
 		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot read upload")
+			logger.Error().Err(err).Msg("cannot read upload")
 			continue
 		}
 
 		if len(rec) != 1 {
-			pps.log.Error().Err(err).Msg("expected only one result")
+			logger.Error().Err(err).Msg("expected only one result")
 			continue
 		}
 
-		pp := &postprocessing.Postprocessing{}
+		pp := postprocessing.New(c)
 		err = json.Unmarshal(rec[0].Value, pp)
 		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot unmarshal upload")
+			logger.Error().Err(err).Msg("cannot unmarshal upload")
 			continue
 		}
 
-		if pp.Status.CurrentStep == step {
-			ids = append(ids, pp.ID)
+		if step == "" || pp.Status.CurrentStep == step || slices.Contains(pp.RunningSteps(), step) {
+			pps = append(pps, pp)
 		}
 	}
 
-	return ids
+	return pps
 }
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/go-chi/chi/v5"
+	"go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+)
+
+// ProgressEventType is the type of the sse events informing the uploading user about the postprocessing progress
+const ProgressEventType = "postprocessing-progress"
+
+// sendProgress informs the uploading user about the new state of the postprocessing via the sse service
+func (pps *PostprocessingService) sendProgress(ctx context.Context, pp *postprocessing.Postprocessing) {
+	uid := pp.User.GetId().GetOpaqueId()
+	if uid == "" {
+		return
+	}
+
+	b, err := json.Marshal(pp.Progress(time.Now()))
+	if err != nil {
+		pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot marshal progress")
+		return
+	}
+
+	if err := events.Publish(ctx, pps.pub, events.SendSSE{
+		UserIDs: []string{uid},
+		Type:    ProgressEventType,
+		Message: b,
+	}); err != nil {
+		pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot publish progress")
+	}
+}
+
+// uploadsCacheTTL is how long the listed uploads are reused by the status API, listing them reads the whole store
+const uploadsCacheTTL = 5 * time.Second
+
+// uploadsByUser caches the uploads in postprocessing indexed by the id of the uploading user
+type uploadsByUser struct {
+	sync.Mutex
+	listed  time.Time
+	uploads map[string][]*postprocessing.Postprocessing
+}
+
+// get returns the uploads of the user, the store is listed again once the cache expired
+func (u *uploadsByUser) get(sto store.Store, c config.Postprocessing, logger log.Logger, uid string, now time.Time) []*postprocessing.Postprocessing {
+	u.Lock()
+	defer u.Unlock()
+
+	if u.uploads == nil || now.Sub(u.listed) > uploadsCacheTTL {
+		u.uploads = make(map[string][]*postprocessing.Postprocessing)
+		for _, pp := range FindUploads(sto, c, logger, "") {
+			owner := pp.User.GetId().GetOpaqueId()
+			u.uploads[owner] = append(u.uploads[owner], pp)
+		}
+		u.listed = now
+	}
+
+	return u.uploads[uid]
+}
+
+// UploadsHandler lists the postprocessing state of all uploads of the current user
+func UploadsHandler(sto store.Store, c config.Postprocessing, logger log.Logger) http.HandlerFunc {
+	cache := &uploadsByUser{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, ok := revactx.ContextGetUser(r.Context())
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		now := time.Now()
+		progress := make([]postprocessing.Progress, 0)
+		for _, pp := range cache.get(sto, c, logger, u.GetId().GetOpaqueId(), now) {
+			progress = append(progress, pp.Progress(now))
+		}
+		sort.Slice(progress, func(i, j int) bool {
+			return progress[i].UploadID < progress[j].UploadID
+		})
+
+		writeJSON(w, progress, logger)
+	}
+}
+
+// UploadHandler returns the postprocessing state of a single upload of the current user
+func UploadHandler(sto store.Store, c config.Postprocessing, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		u, ok := revactx.ContextGetUser(r.Context())
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		uploadID := chi.URLParam(r, "uploadID")
+		pp, err := GetUpload(sto, c, uploadID)
+		switch {
+		case err == ErrNotFound:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case err != nil:
+			logger.Error().Err(err).Str("uploadID", uploadID).Msg("cannot get upload")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case pp.User.GetId().GetOpaqueId() != u.GetId().GetOpaqueId():
+			// don't reveal uploads of other users
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		writeJSON(w, pp.Progress(time.Now()), logger)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}, logger log.Logger) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot marshal response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(b); err != nil {
+		logger.Error().Err(err).Msg("cannot write response")
+	}
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/service"
+)
+
+func TestUploadHandlers(t *testing.T) {
+	sto := store.NewMemoryStore()
+	for id, owner := range map[string]string{"upload-1": "alice", "upload-2": "bob"} {
+		b, err := json.Marshal(postprocessing.Postprocessing{
+			ID:       id,
+			Filename: id + ".txt",
+			User:     &user.User{Id: &user.UserId{OpaqueId: owner}},
+			Steps:    []events.Postprocessingstep{"virusscan"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, sto.Write(&store.Record{Key: id, Value: b}))
+	}
+
+	c := config.Postprocessing{}
+	mux := chi.NewMux()
+	mux.Get("/uploads", service.UploadsHandler(sto, c, log.NopLogger()))
+	mux.Get("/uploads/{uploadID}", service.UploadHandler(sto, c, log.NopLogger()))
+
+	get := func(path, uid string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if uid != "" {
+			r = r.WithContext(revactx.ContextSetUser(r.Context(), &user.User{Id: &user.UserId{OpaqueId: uid}}))
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get("/uploads/upload-1", "").Code)
+	assert.Equal(t, http.StatusNotFound, get("/uploads/upload-2", "alice").Code)
+	assert.Equal(t, http.StatusNotFound, get("/uploads/upload-3", "alice").Code)
+
+	w := get("/uploads/upload-1", "alice")
+	require.Equal(t, http.StatusOK, w.Code)
+	var p postprocessing.Progress
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &p))
+	assert.Equal(t, "upload-1", p.UploadID)
+	assert.Equal(t, postprocessing.ProgressProcessing, p.Status)
+
+	w = get("/uploads", "bob")
+	require.Equal(t, http.StatusOK, w.Code)
+	var list []postprocessing.Progress
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list, 1)
+	assert.Equal(t, "upload-2", list[0].UploadID)
+}
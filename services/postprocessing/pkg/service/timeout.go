@@ -0,0 +1,173 @@
+package service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/owncloud/ocis/v2/ocis-pkg/lease"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+	"go-micro.dev/v4/store"
+)
+
+const (
+	// deadLetterPrefix is the prefix of the store keys of dead letters, the keys of uploads are their ids
+	deadLetterPrefix = "deadletter-"
+
+	// leasePrefix is the prefix of the store keys of leases
+	leasePrefix = "lease-"
+
+	// timeoutLeaseKey is the store key of the lease of the replica which checks the uploads for timeouts
+	timeoutLeaseKey = leasePrefix + "timeoutcheck"
+
+	// uploadLeaseDuration is how long a replica may change an upload before other replicas take over
+	uploadLeaseDuration = 30 * time.Second
+
+	// uploadLeaseRetryInterval is how often replicas check if the lease of an upload was released
+	uploadLeaseRetryInterval = 20 * time.Millisecond
+)
+
+// timeoutCheckInterval returns how often steps are checked for timeouts. It is 0 if no timeout is configured.
+func timeoutCheckInterval(c config.Postprocessing) time.Duration {
+	interval := c.StepTimeout
+	for _, s := range c.StepConfig {
+		if s.Timeout > 0 && (interval <= 0 || s.Timeout < interval) {
+			interval = s.Timeout
+		}
+	}
+	if interval > time.Minute {
+		return time.Minute
+	}
+	return interval
+}
+
+func (pps *PostprocessingService) checkTimeouts(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pps.ctx.Done():
+			return
+		case now := <-ticker.C:
+			// all replicas share the store, one of them is enough to check it. The lease outlives
+			// a missed tick, another replica takes over if the holder is gone.
+			if !lease.Acquire(pps.store, timeoutLeaseKey, pps.id, now, 2*interval) {
+				continue
+			}
+			for _, pp := range FindUploads(pps.store, pps.c, pps.log, "") {
+				if len(pp.TimedOut(now)) > 0 {
+					pps.handleTimeouts(pp.ID, now)
+				}
+			}
+			if pps.c.DeadLetterTTL > 0 {
+				purgeDeadLetters(pps.store, now.Add(-pps.c.DeadLetterTTL), pps.log)
+			}
+		}
+	}
+}
+
+// handleTimeouts finishes all steps of the upload which exceeded their timeout with the configured outcome
+func (pps *PostprocessingService) handleTimeouts(uploadID string, now time.Time) {
+	unlock := pps.lockUpload(uploadID)
+	defer unlock()
+
+	// the upload might have changed since it was listed
+	pp, err := pps.getPP(pps.store, uploadID)
+	if err != nil {
+		pps.log.Error().Str("uploadID", uploadID).Err(err).Msg("cannot get upload")
+		return
+	}
+
+	timedOut := pp.TimedOut(now)
+	for _, dl := range timedOut {
+		// don't report the step again before its outcome was processed
+		st := pp.Status.Steps[dl.Step]
+		st.Started = now
+		pp.Status.Steps[dl.Step] = st
+	}
+	if err := storePP(pps.store, pp); err != nil {
+		pps.log.Error().Str("uploadID", uploadID).Err(err).Msg("cannot store upload")
+		return
+	}
+
+	for _, dl := range timedOut {
+		pps.log.Warn().Str("uploadID", uploadID).Str("step", string(dl.Step)).Dur("timeout", dl.Timeout).
+			Str("outcome", string(dl.Outcome)).Msg("postprocessing step timed out")
+
+		if err := storeDeadLetter(pps.store, dl, pps.c.DeadLetterTTL); err != nil {
+			pps.log.Error().Str("uploadID", uploadID).Err(err).Msg("cannot store dead letter")
+		}
+
+		if err := events.Publish(pps.ctx, pps.pub, events.PostprocessingStepFinished{
+			UploadID:      pp.ID,
+			ExecutingUser: pp.User,
+			Filename:      pp.Filename,
+			FinishedStep:  dl.Step,
+			Outcome:       dl.Outcome,
+			Result:        "timed out after " + dl.Timeout.String(),
+			Timestamp:     utils.TSNow(),
+		}); err != nil {
+			pps.log.Error().Str("uploadID", uploadID).Err(err).Msg("cannot publish event")
+		}
+	}
+}
+
+func storeDeadLetter(sto store.Store, dl postprocessing.DeadLetter, ttl time.Duration) error {
+	b, err := json.Marshal(dl)
+	if err != nil {
+		return err
+	}
+
+	return sto.Write(&store.Record{
+		Key:    deadLetterKey(dl),
+		Value:  b,
+		Expiry: ttl,
+	})
+}
+
+func deadLetterKey(dl postprocessing.DeadLetter) string {
+	return deadLetterPrefix + dl.UploadID + "-" + string(dl.Step)
+}
+
+// purgeDeadLetters deletes the dead letters recorded before the given time. Not all stores support
+// the expiry of single records, e.g. nats-js-kv only expires whole buckets.
+func purgeDeadLetters(sto store.Store, before time.Time, logger log.Logger) {
+	for _, dl := range DeadLetters(sto, logger) {
+		if !dl.Timestamp.Before(before) {
+			continue
+		}
+		if err := sto.Delete(deadLetterKey(dl)); err != nil {
+			logger.Error().Err(err).Str("uploadID", dl.UploadID).Msg("cannot delete dead letter")
+		}
+	}
+}
+
+// DeadLetters returns all dead letters in the store
+func DeadLetters(sto store.Store, logger log.Logger) []postprocessing.DeadLetter {
+	var dls []postprocessing.DeadLetter
+
+	keys, err := sto.List(store.ListPrefix(deadLetterPrefix))
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot list dead letters")
+	}
+
+	for _, k := range keys {
+		rec, err := sto.Read(k)
+		if err != nil || len(rec) != 1 {
+			logger.Error().Err(err).Str("key", k).Msg("cannot read dead letter")
+			continue
+		}
+
+		var dl postprocessing.DeadLetter
+		if err := json.Unmarshal(rec[0].Value, &dl); err != nil {
+			logger.Error().Err(err).Str("key", k).Msg("cannot unmarshal dead letter")
+			continue
+		}
+		dls = append(dls, dl)
+	}
+
+	return dls
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/postprocessing"
+)
+
+func TestPurgeDeadLetters(t *testing.T) {
+	sto := store.NewMemoryStore()
+	now := time.Now()
+	require.NoError(t, storeDeadLetter(sto, postprocessing.DeadLetter{UploadID: "upload-1", Step: "virusscan", Timestamp: now.Add(-48 * time.Hour)}, 0))
+	require.NoError(t, storeDeadLetter(sto, postprocessing.DeadLetter{UploadID: "upload-2", Step: "virusscan", Timestamp: now.Add(-time.Hour)}, 0))
+
+	purgeDeadLetters(sto, now.Add(-24*time.Hour), log.NopLogger())
+
+	dls := DeadLetters(sto, log.NopLogger())
+	require.Len(t, dls, 1)
+	assert.Equal(t, "upload-2", dls[0].UploadID)
+}
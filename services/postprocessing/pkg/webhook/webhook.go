@@ -0,0 +1,233 @@
+// Package webhook implements postprocessing steps which are handled by an external http service.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/mime"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+)
+
+const (
+	// SignatureHeader is the header containing the hex encoded HMAC-SHA256 of the request body, prefixed with 'sha256='
+	SignatureHeader = "X-OCIS-Signature"
+
+	// CallbackPath is the path of the callback endpoint of the postprocessing service
+	CallbackPath = "/webhook/{uploadID}/{step}"
+
+	_defaultTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidToken is returned when the token of a callback does not match
+	ErrInvalidToken = errors.New("invalid callback token")
+)
+
+// Payload is the json description of the upload posted to the webhook
+type Payload struct {
+	UploadID    string    `json:"uploadId"`
+	Step        string    `json:"step"`
+	ResourceID  string    `json:"resourceId,omitempty"`
+	SpaceID     string    `json:"spaceId,omitempty"`
+	Name        string    `json:"name"`
+	Size        uint64    `json:"size"`
+	MimeType    string    `json:"mimeType"`
+	Executant   string    `json:"executant,omitempty"`
+	DownloadURL string    `json:"downloadUrl"`
+	CallbackURL string    `json:"callbackUrl"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// Result is the json the webhook responds with or sends to the callback url
+type Result struct {
+	Outcome events.PostprocessingOutcome `json:"outcome"`
+	Reason  string                       `json:"reason,omitempty"`
+}
+
+// Webhook calls the webhook of a postprocessing step
+type Webhook struct {
+	cfg         config.Webhook
+	callbackURL string
+	client      *http.Client
+}
+
+// New returns a new Webhook. The callback url is the base url of the postprocessing service reachable by the webhook.
+func New(cfg config.Webhook, callbackURL string) Webhook {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	return Webhook{
+		cfg:         cfg,
+		callbackURL: callbackURL,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.Insecure}, //nolint:gosec
+			},
+		},
+	}
+}
+
+// Call posts the signed payload of the upload to the webhook. It returns the result of the webhook and
+// true if the webhook accepted the upload and reports the result to the callback url later.
+func (w Webhook) Call(ctx context.Context, ev events.StartPostprocessingStep) (Result, bool, error) {
+	p := Payload{
+		UploadID:    ev.UploadID,
+		Step:        string(ev.StepToStart),
+		Name:        ev.Filename,
+		Size:        ev.Filesize,
+		MimeType:    mime.Detect(false, ev.Filename),
+		Executant:   ev.ExecutingUser.GetId().GetOpaqueId(),
+		DownloadURL: ev.URL,
+		CallbackURL: w.callbackURL + "/webhook/" + url.PathEscape(ev.UploadID) + "/" + url.PathEscape(string(ev.StepToStart)) +
+			"?token=" + CallbackToken(w.cfg.Secret, ev.UploadID, string(ev.StepToStart)),
+		Timestamp: time.Now(),
+	}
+	if rid := ev.ResourceID; rid != nil {
+		p.ResourceID = storagespace.FormatResourceID(rid)
+		p.SpaceID = storagespace.FormatStorageID(rid.GetStorageId(), rid.GetSpaceId())
+	}
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		return Result{}, false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(w.cfg.Secret, body))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		// the webhook is not reachable, this is most likely temporary
+		return Result{Outcome: events.PPOutcomeRetry, Reason: err.Error()}, false, nil
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusAccepted:
+		return Result{}, true, nil
+	case res.StatusCode >= http.StatusInternalServerError, res.StatusCode == http.StatusTooManyRequests:
+		return Result{Outcome: events.PPOutcomeRetry, Reason: res.Status}, false, nil
+	case res.StatusCode >= http.StatusBadRequest:
+		return Result{Outcome: events.PPOutcomeAbort, Reason: res.Status}, false, nil
+	}
+
+	r, err := ReadResult(res.Body)
+	if err != nil {
+		return Result{}, false, fmt.Errorf("invalid response of webhook: %w", err)
+	}
+	return r, false, nil
+}
+
+// ReadResult reads the result of a webhook. An empty result means the postprocessing continues.
+func ReadResult(r io.Reader) (Result, error) {
+	b, err := io.ReadAll(io.LimitReader(r, 1<<20))
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Outcome: events.PPOutcomeContinue}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return res, nil
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return Result{}, err
+	}
+
+	switch res.Outcome {
+	case "":
+		res.Outcome = events.PPOutcomeContinue
+	case events.PPOutcomeContinue, events.PPOutcomeAbort, events.PPOutcomeDelete, events.PPOutcomeRetry:
+	default:
+		return Result{}, fmt.Errorf("unknown outcome '%s'", res.Outcome)
+	}
+	return res, nil
+}
+
+// Sign returns the value of the signature header for the body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CallbackToken returns the token which authorizes the webhook to report the result of the step for the upload
+func CallbackToken(secret, uploadID, step string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(uploadID + "/" + step))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCallbackToken checks the token of a callback
+func VerifyCallbackToken(secret, uploadID, step, token string) error {
+	if !hmac.Equal([]byte(CallbackToken(secret, uploadID, step)), []byte(token)) {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// CallbackHandler handles the results webhooks send to their callback url
+func CallbackHandler(c config.Postprocessing, pub events.Publisher, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		uploadID, step := chi.URLParam(r, "uploadID"), chi.URLParam(r, "step")
+		l := logger.With().Str("uploadID", uploadID).Str("step", step).Logger()
+
+		sc, ok := c.StepConfig[step]
+		if !ok || sc.Webhook.URL == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := VerifyCallbackToken(sc.Webhook.Secret, uploadID, step, r.URL.Query().Get("token")); err != nil {
+			l.Warn().Err(err).Msg("rejected webhook callback")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		res, err := ReadResult(r.Body)
+		if err != nil {
+			l.Debug().Err(err).Msg("invalid webhook callback")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := events.Publish(r.Context(), pub, events.PostprocessingStepFinished{
+			UploadID:     uploadID,
+			FinishedStep: events.Postprocessingstep(step),
+			Outcome:      res.Outcome,
+			Result:       res.Reason,
+			Timestamp:    utils.TSNow(),
+		}); err != nil {
+			l.Error().Err(err).Msg("cannot publish event")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Str("outcome", string(res.Outcome)).Str("reason", res.Reason).Msg("webhook reported result")
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
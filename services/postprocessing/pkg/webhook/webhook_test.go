@@ -0,0 +1,121 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	microevents "go-micro.dev/v4/events"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/config"
+	"github.com/owncloud/ocis/v2/services/postprocessing/pkg/webhook"
+)
+
+type publisher struct {
+	events []interface{}
+}
+
+func (p *publisher) Publish(_ string, ev interface{}, _ ...microevents.PublishOption) error {
+	p.events = append(p.events, ev)
+	return nil
+}
+
+func TestCall(t *testing.T) {
+	ev := events.StartPostprocessingStep{
+		UploadID:    "upload",
+		URL:         "https://localhost:9200/data/token",
+		Filename:    "report.pdf",
+		Filesize:    42,
+		StepToStart: "classifier",
+	}
+
+	var payload webhook.Payload
+	status, response := http.StatusOK, `{"outcome": "delete", "reason": "confidential"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, webhook.Sign("secret", body), r.Header.Get(webhook.SignatureHeader))
+		require.NoError(t, json.Unmarshal(body, &payload))
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	hook := webhook.New(config.Webhook{URL: srv.URL, Secret: "secret"}, "http://localhost:9256")
+
+	res, async, err := hook.Call(context.Background(), ev)
+	require.NoError(t, err)
+	assert.False(t, async)
+	assert.Equal(t, webhook.Result{Outcome: events.PPOutcomeDelete, Reason: "confidential"}, res)
+
+	assert.Equal(t, "upload", payload.UploadID)
+	assert.Equal(t, "report.pdf", payload.Name)
+	assert.Equal(t, uint64(42), payload.Size)
+	assert.Equal(t, "application/pdf", payload.MimeType)
+	assert.Equal(t, ev.URL, payload.DownloadURL)
+	assert.Equal(t, "http://localhost:9256/webhook/upload/classifier?token="+webhook.CallbackToken("secret", "upload", "classifier"), payload.CallbackURL)
+
+	status, response = http.StatusOK, ""
+	res, _, err = hook.Call(context.Background(), ev)
+	require.NoError(t, err)
+	assert.Equal(t, events.PPOutcomeContinue, res.Outcome)
+
+	status = http.StatusAccepted
+	_, async, err = hook.Call(context.Background(), ev)
+	require.NoError(t, err)
+	assert.True(t, async)
+
+	status = http.StatusServiceUnavailable
+	res, _, err = hook.Call(context.Background(), ev)
+	require.NoError(t, err)
+	assert.Equal(t, events.PPOutcomeRetry, res.Outcome)
+
+	status = http.StatusForbidden
+	res, _, err = hook.Call(context.Background(), ev)
+	require.NoError(t, err)
+	assert.Equal(t, events.PPOutcomeAbort, res.Outcome)
+
+	status, response = http.StatusOK, `{"outcome": "maybe"}`
+	_, _, err = hook.Call(context.Background(), ev)
+	assert.Error(t, err)
+}
+
+func TestCallbackHandler(t *testing.T) {
+	c := config.Postprocessing{
+		StepConfig: map[string]config.Step{
+			"classifier": {Webhook: config.Webhook{URL: "http://localhost", Secret: "secret"}},
+		},
+	}
+	pub := &publisher{}
+
+	mux := chi.NewMux()
+	mux.Post(webhook.CallbackPath, webhook.CallbackHandler(c, pub, log.NopLogger()))
+
+	callback := func(step, token, body string) int {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/webhook/upload/"+step+"?token="+token, strings.NewReader(body)))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusNotFound, callback("virusscan", webhook.CallbackToken("secret", "upload", "virusscan"), ""))
+	assert.Equal(t, http.StatusUnauthorized, callback("classifier", webhook.CallbackToken("other", "upload", "classifier"), ""))
+	assert.Equal(t, http.StatusBadRequest, callback("classifier", webhook.CallbackToken("secret", "upload", "classifier"), "{"))
+	assert.Empty(t, pub.events)
+
+	assert.Equal(t, http.StatusNoContent, callback("classifier", webhook.CallbackToken("secret", "upload", "classifier"), `{"outcome": "abort"}`))
+	require.Len(t, pub.events, 1)
+	ev := pub.events[0].(events.PostprocessingStepFinished)
+	assert.Equal(t, "upload", ev.UploadID)
+	assert.Equal(t, events.Postprocessingstep("classifier"), ev.FinishedStep)
+	assert.Equal(t, events.PPOutcomeAbort, ev.Outcome)
+}
@@ -0,0 +1,368 @@
+// Package bruteforce protects passwords against brute-force attacks by delaying and locking out failed authentication attempts.
+package bruteforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	microstore "go-micro.dev/v4/store"
+)
+
+const (
+	// KindUser counts the failed attempts per username
+	KindUser = "user"
+	// KindIP counts the failed attempts per client IP
+	KindIP = "ip"
+	// KindLink counts the failed attempts per public link token
+	KindLink = "link"
+)
+
+var (
+	// ErrLockedOut is returned when an attempt is rejected because one of its subjects is locked out
+	ErrLockedOut = errors.New("locked out")
+	// ErrNotLockedOut is returned when clearing a subject which is not locked out
+	ErrNotLockedOut = errors.New("not locked out")
+)
+
+// Subject identifies whose failed attempts are counted together.
+type Subject struct {
+	Kind  string
+	Value string
+}
+
+// User returns the subject of a username
+func User(username string) Subject {
+	return Subject{Kind: KindUser, Value: username}
+}
+
+// IP returns the subject of a client IP
+func IP(ip string) Subject {
+	return Subject{Kind: KindIP, Value: ip}
+}
+
+// Link returns the subject of a public link token
+func Link(token string) Subject {
+	return Subject{Kind: KindLink, Value: token}
+}
+
+// ParseSubject parses the string representation of a subject
+func ParseSubject(s string) (Subject, error) {
+	kind, value, ok := strings.Cut(s, ":")
+	if !ok || value == "" {
+		return Subject{}, fmt.Errorf("invalid subject %q", s)
+	}
+	switch kind {
+	case KindUser, KindIP, KindLink:
+		return Subject{Kind: kind, Value: value}, nil
+	default:
+		return Subject{}, fmt.Errorf("invalid subject kind %q", kind)
+	}
+}
+
+// String returns the string representation of the subject, e.g. 'user:alice'
+func (s Subject) String() string {
+	return s.Kind + ":" + s.Value
+}
+
+// Lockout is the state of the failed attempts of a subject as it is kept in the store.
+type Lockout struct {
+	Subject     string    `json:"subject"`
+	Kind        string    `json:"kind"`
+	Value       string    `json:"value"`
+	Failures    int       `json:"failures"`
+	LastFailure time.Time `json:"last_failure"`
+	LockedUntil time.Time `json:"locked_until,omitempty"`
+}
+
+// Guard keeps the failed attempts and lockouts in a store. When using a shared store the lockouts apply across all
+// proxy instances. Like the rate limits, updates are only serialized within an instance.
+type Guard struct {
+	store     microstore.Store
+	cfg       config.BruteForceProtection
+	publisher events.Publisher
+	logger    log.Logger
+	locks     [256]sync.Mutex
+	now       func() time.Time
+	sleep     func(context.Context, time.Duration) error
+}
+
+// NewGuard creates a new Guard using the store. The publisher is optional.
+func NewGuard(store microstore.Store, cfg config.BruteForceProtection, publisher events.Publisher, logger log.Logger) *Guard {
+	return &Guard{
+		store:     store,
+		cfg:       cfg,
+		publisher: publisher,
+		logger:    logger,
+		now:       time.Now,
+		sleep:     sleep,
+	}
+}
+
+// Attempt is an authentication attempt of one or more subjects.
+type Attempt struct {
+	guard      *Guard
+	ctx        context.Context
+	clientIP   string
+	subjects   []Subject
+	failed     map[Subject]bool
+	ipFailures int
+}
+
+// Start starts an authentication attempt. The attempt is delayed according to the failed attempts of its usernames
+// and public links, ErrLockedOut is returned when one of the subjects is locked out. The failed attempts of a client IP
+// only delay the response to another failed attempt, clients sharing the IP with an attacker can still authenticate.
+// A nil Guard doesn't protect anything and returns a nil Attempt, which can be used nonetheless.
+func (g *Guard) Start(ctx context.Context, clientIP string, subjects ...Subject) (*Attempt, error) {
+	if g == nil {
+		return nil, nil
+	}
+
+	a := &Attempt{
+		guard:    g,
+		ctx:      ctx,
+		clientIP: clientIP,
+		subjects: subjects,
+		failed:   make(map[Subject]bool, len(subjects)),
+	}
+	now := g.now()
+	failures := 0
+	for _, s := range subjects {
+		l, ok, err := g.read(s, now)
+		if err != nil {
+			// don't block the authentication when the store is not available
+			g.logger.Error().Err(err).Str("subject", s.String()).Msg("could not read the failed attempts")
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if l.LockedUntil.After(now) {
+			return nil, ErrLockedOut
+		}
+		a.failed[s] = true
+		if s.Kind == KindIP {
+			a.ipFailures = max(a.ipFailures, l.Failures)
+			continue
+		}
+		failures = max(failures, l.Failures)
+	}
+
+	if err := g.sleep(ctx, g.delay(failures)); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Failed records the failed attempt for all subjects and locks them out when they failed too often.
+// It returns after the delay of the previous failed attempts of the client IP.
+func (a *Attempt) Failed() {
+	if a == nil {
+		return
+	}
+	for _, s := range a.subjects {
+		if err := a.guard.fail(a.ctx, a.clientIP, s); err != nil {
+			a.guard.logger.Error().Err(err).Str("subject", s.String()).Msg("could not record the failed attempt")
+		}
+	}
+	_ = a.guard.sleep(a.ctx, a.guard.delay(a.ipFailures))
+}
+
+// Succeeded forgets the failed attempts of the subjects. The failed attempts of the client IP are kept,
+// otherwise attackers could reset them by authenticating with their own credentials.
+func (a *Attempt) Succeeded() {
+	if a == nil {
+		return
+	}
+	for _, s := range a.subjects {
+		if s.Kind == KindIP || !a.failed[s] {
+			continue
+		}
+		if err := a.guard.store.Delete(s.String()); err != nil && !errors.Is(err, microstore.ErrNotFound) {
+			a.guard.logger.Error().Err(err).Str("subject", s.String()).Msg("could not reset the failed attempts")
+		}
+	}
+}
+
+// Lockouts returns the subjects which are currently locked out
+func (g *Guard) Lockouts() ([]Lockout, error) {
+	keys, err := g.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	now := g.now()
+	lockouts := make([]Lockout, 0, len(keys))
+	for _, k := range keys {
+		s, err := ParseSubject(k)
+		if err != nil {
+			continue
+		}
+		l, ok, err := g.read(s, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok && l.LockedUntil.After(now) {
+			lockouts = append(lockouts, l)
+		}
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		return lockouts[i].Subject < lockouts[j].Subject
+	})
+	return lockouts, nil
+}
+
+// Clear lifts the lockout of the subject and forgets its failed attempts
+func (g *Guard) Clear(ctx context.Context, s Subject, executant *user.UserId) error {
+	lock := g.lock(s)
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := g.now()
+	l, ok, err := g.read(s, now)
+	if err != nil {
+		return err
+	}
+	if !ok || !l.LockedUntil.After(now) {
+		return ErrNotLockedOut
+	}
+	if err := g.store.Delete(s.String()); err != nil {
+		return err
+	}
+
+	g.publish(ctx, event.AuthenticationLockoutCleared{
+		Executant: executant,
+		Kind:      s.Kind,
+		Value:     s.Value,
+		Timestamp: utils.TimeToTS(now),
+	})
+	return nil
+}
+
+func (g *Guard) fail(ctx context.Context, clientIP string, s Subject) error {
+	lock := g.lock(s)
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := g.now()
+	l, ok, err := g.read(s, now)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		l = Lockout{Subject: s.String(), Kind: s.Kind, Value: s.Value}
+	}
+	if l.LockedUntil.After(now) {
+		// concurrent attempts don't prolong the lockout
+		return nil
+	}
+
+	l.Failures++
+	l.LastFailure = now
+	lockedOut := l.Failures >= g.cfg.MaxAttempts
+	if lockedOut {
+		l.LockedUntil = now.Add(g.cfg.LockoutDuration)
+	}
+
+	value, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+	if err := g.store.Write(&microstore.Record{
+		Key:   s.String(),
+		Value: value,
+		// the failed attempts are forgotten and the lockout is lifted after this time
+		Expiry: g.cfg.LockoutDuration,
+	}); err != nil {
+		return err
+	}
+
+	if lockedOut {
+		g.logger.Warn().Str("subject", s.String()).Str("client_ip", clientIP).Int("failures", l.Failures).Msg("locked out after too many failed authentication attempts")
+		g.publish(ctx, event.AuthenticationLockedOut{
+			Kind:        s.Kind,
+			Value:       s.Value,
+			ClientIP:    clientIP,
+			Failures:    l.Failures,
+			LockedUntil: utils.TimeToTS(l.LockedUntil),
+			Timestamp:   utils.TimeToTS(now),
+		})
+	}
+	return nil
+}
+
+// read returns the state of the subject. States older than the lockout duration are ignored,
+// not every store honours the expiry of the records.
+func (g *Guard) read(s Subject, now time.Time) (Lockout, bool, error) {
+	records, err := g.store.Read(s.String())
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return Lockout{}, false, nil
+	case err != nil:
+		return Lockout{}, false, err
+	case len(records) == 0:
+		return Lockout{}, false, nil
+	}
+
+	var l Lockout
+	if err := json.Unmarshal(records[0].Value, &l); err != nil {
+		return Lockout{}, false, err
+	}
+	if !l.LockedUntil.After(now) && now.Sub(l.LastFailure) >= g.cfg.LockoutDuration {
+		return Lockout{}, false, nil
+	}
+	return l, true, nil
+}
+
+// delay returns the delay of an attempt after the given number of failed attempts. It doubles with every failed attempt.
+func (g *Guard) delay(failures int) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+	d := g.cfg.Delay
+	for i := 1; i < failures && d < g.cfg.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, g.cfg.MaxDelay)
+}
+
+func (g *Guard) publish(ctx context.Context, ev interface{}) {
+	if g.publisher == nil {
+		return
+	}
+	if err := events.Publish(ctx, g.publisher, ev); err != nil {
+		g.logger.Error().Err(err).Interface("event", ev).Msg("could not publish the event")
+	}
+}
+
+func (g *Guard) lock(s Subject) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s.String()))
+	return &g.locks[h.Sum32()%uint32(len(g.locks))]
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
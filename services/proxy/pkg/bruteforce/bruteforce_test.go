@@ -0,0 +1,172 @@
+package bruteforce
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	microevents "go-micro.dev/v4/events"
+	microstore "go-micro.dev/v4/store"
+)
+
+type publisher struct {
+	events []interface{}
+}
+
+func (p *publisher) Publish(_ string, ev interface{}, _ ...microevents.PublishOption) error {
+	p.events = append(p.events, ev)
+	return nil
+}
+
+func newGuard() (*Guard, *publisher, *time.Time, *[]time.Duration) {
+	pub := &publisher{}
+	now := time.Now()
+	var delays []time.Duration
+	g := NewGuard(microstore.NewMemoryStore(), config.BruteForceProtection{
+		MaxAttempts:     3,
+		Delay:           time.Second,
+		MaxDelay:        3 * time.Second,
+		LockoutDuration: time.Minute,
+	}, pub, log.NopLogger())
+	g.now = func() time.Time { return now }
+	g.sleep = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	return g, pub, &now, &delays
+}
+
+func TestParseSubject(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Subject
+		wantErr bool
+	}{
+		{in: "user:alice", want: User("alice")},
+		{in: "ip:2001:db8::1", want: IP("2001:db8::1")},
+		{in: "link:token", want: Link("token")},
+		{in: "group:admins", wantErr: true},
+		{in: "user:", wantErr: true},
+		{in: "alice", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSubject(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSubject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuard_LockOut(t *testing.T) {
+	g, pub, now, delays := newGuard()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		a, err := g.Start(ctx, "10.0.0.1", User("alice"), IP("10.0.0.1"))
+		if err != nil {
+			t.Fatalf("attempt %d was rejected: %v", i, err)
+		}
+		a.Failed()
+	}
+	// the attempts are delayed by the failures of the user, the failed attempts by the ones of the IP
+	want := []time.Duration{0, 0, time.Second, time.Second, 2 * time.Second, 2 * time.Second}
+	for i := range want {
+		if (*delays)[i] != want[i] {
+			t.Errorf("delay %d is %s, want %s", i, (*delays)[i], want[i])
+		}
+	}
+
+	if _, err := g.Start(ctx, "10.0.0.2", User("alice")); !errors.Is(err, ErrLockedOut) {
+		t.Fatalf("attempt of a locked out user was not rejected: %v", err)
+	}
+	if _, err := g.Start(ctx, "10.0.0.1", User("bob"), IP("10.0.0.1")); !errors.Is(err, ErrLockedOut) {
+		t.Fatalf("attempt from a locked out IP was not rejected: %v", err)
+	}
+
+	if len(pub.events) != 2 {
+		t.Fatalf("got %d events, want 2", len(pub.events))
+	}
+	ev, ok := pub.events[0].(event.AuthenticationLockedOut)
+	if !ok || ev.Kind != KindUser || ev.Value != "alice" || ev.ClientIP != "10.0.0.1" || ev.Failures != 3 {
+		t.Errorf("unexpected event %+v", pub.events[0])
+	}
+
+	lockouts, err := g.Lockouts()
+	if err != nil || len(lockouts) != 2 || lockouts[0].Subject != "ip:10.0.0.1" || lockouts[1].Subject != "user:alice" {
+		t.Fatalf("unexpected lockouts %+v: %v", lockouts, err)
+	}
+
+	*now = now.Add(time.Minute)
+	if _, err := g.Start(ctx, "10.0.0.1", User("alice"), IP("10.0.0.1")); err != nil {
+		t.Errorf("attempt after the lockout was rejected: %v", err)
+	}
+	if lockouts, _ := g.Lockouts(); len(lockouts) != 0 {
+		t.Errorf("the lockouts were not lifted: %+v", lockouts)
+	}
+}
+
+func TestGuard_Succeeded(t *testing.T) {
+	g, _, _, delays := newGuard()
+	ctx := context.Background()
+
+	a, _ := g.Start(ctx, "10.0.0.1", User("alice"), IP("10.0.0.1"))
+	a.Failed()
+	a, _ = g.Start(ctx, "10.0.0.1", User("alice"), IP("10.0.0.1"))
+	a.Succeeded()
+
+	// the failed attempts of the user are forgotten, the ones of the IP are kept
+	if _, err := g.Start(ctx, "10.0.0.2", User("alice")); err != nil || (*delays)[3] != 0 {
+		t.Errorf("the failed attempts of the user were not reset: %v", err)
+	}
+	a, err := g.Start(ctx, "10.0.0.1", User("bob"), IP("10.0.0.1"))
+	if err != nil || (*delays)[4] != 0 {
+		t.Errorf("the attempt was delayed by the failed attempts of the IP: %v", err)
+	}
+	a.Failed()
+	if (*delays)[5] != time.Second {
+		t.Errorf("the failed attempts of the IP were reset")
+	}
+}
+
+func TestGuard_Clear(t *testing.T) {
+	g, pub, _, _ := newGuard()
+	ctx := context.Background()
+
+	if err := g.Clear(ctx, Link("token"), nil); !errors.Is(err, ErrNotLockedOut) {
+		t.Fatalf("clearing a subject which is not locked out returned %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		a, _ := g.Start(ctx, "10.0.0.1", Link("token"))
+		a.Failed()
+	}
+	if err := g.Clear(ctx, Link("token"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Start(ctx, "10.0.0.1", Link("token")); err != nil {
+		t.Errorf("attempt after clearing the lockout was rejected: %v", err)
+	}
+	if _, ok := pub.events[len(pub.events)-1].(event.AuthenticationLockoutCleared); !ok {
+		t.Errorf("no cleared event was published")
+	}
+}
+
+func TestGuard_Nil(t *testing.T) {
+	var g *Guard
+	a, err := g.Start(context.Background(), "10.0.0.1", User("alice"))
+	if a != nil || err != nil {
+		t.Fatalf("nil guard returned %v, %v", a, err)
+	}
+	a.Failed()
+	a.Succeeded()
+}
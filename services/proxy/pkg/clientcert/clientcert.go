@@ -0,0 +1,153 @@
+// Package clientcert maps X.509 client certificates to claims and checks them against a deny list.
+package clientcert
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+// attributes are the certificate attributes which can be mapped to claims
+var attributes = map[string]func(*x509.Certificate) []string{
+	"subject.dn":           func(c *x509.Certificate) []string { return []string{c.Subject.String()} },
+	"subject.cn":           func(c *x509.Certificate) []string { return []string{c.Subject.CommonName} },
+	"subject.serialnumber": func(c *x509.Certificate) []string { return []string{c.Subject.SerialNumber} },
+	"subject.o":            func(c *x509.Certificate) []string { return c.Subject.Organization },
+	"subject.ou":           func(c *x509.Certificate) []string { return c.Subject.OrganizationalUnit },
+	"san.email":            func(c *x509.Certificate) []string { return c.EmailAddresses },
+	"san.dns":              func(c *x509.Certificate) []string { return c.DNSNames },
+	"san.uri": func(c *x509.Certificate) []string {
+		uris := make([]string, 0, len(c.URIs))
+		for _, u := range c.URIs {
+			uris = append(uris, u.String())
+		}
+		return uris
+	},
+}
+
+// Verifier maps client certificates to claims and rejects revoked certificates.
+type Verifier struct {
+	claims       map[string]func(*x509.Certificate) []string
+	fingerprints map[string]struct{}
+	// serials are only unique per CA, they are keyed by the fingerprint of the issuing CA certificate and the serial
+	serials map[string]struct{}
+}
+
+// NewVerifier parses the claim mapping and the deny list of the configuration
+func NewVerifier(cfg config.ClientCertificateAuth) (*Verifier, error) {
+	v := &Verifier{
+		claims:       make(map[string]func(*x509.Certificate) []string, len(cfg.ClaimMapping)),
+		fingerprints: make(map[string]struct{}),
+		serials:      make(map[string]struct{}),
+	}
+
+	for _, m := range cfg.ClaimMapping {
+		claim, attribute, ok := strings.Cut(m, "=")
+		claim, attribute = strings.TrimSpace(claim), strings.TrimSpace(attribute)
+		if !ok || claim == "" {
+			return nil, fmt.Errorf("invalid claim mapping %q, expected 'claim=attribute'", m)
+		}
+		f, ok := attributes[strings.ToLower(attribute)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported certificate attribute %q in claim mapping %q", attribute, m)
+		}
+		v.claims[claim] = f
+	}
+	if len(v.claims) == 0 {
+		return nil, errors.New("the claim mapping must not be empty")
+	}
+
+	for _, entry := range cfg.DenyList {
+		issuer, serial, scoped := strings.Cut(normalize(entry), "/")
+		if !isFingerprint(issuer) {
+			return nil, fmt.Errorf("invalid fingerprint %q in deny list", entry)
+		}
+		if !scoped {
+			v.fingerprints[issuer] = struct{}{}
+			continue
+		}
+		n, ok := new(big.Int).SetString(serial, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number %q in deny list, expected 'fingerprint of the CA/serial number'", entry)
+		}
+		v.serials[issuer+"/"+n.Text(16)] = struct{}{}
+	}
+	return v, nil
+}
+
+// Maps returns true if the claim is mapped to an attribute of the certificates
+func (v *Verifier) Maps(claim string) bool {
+	_, ok := v.claims[claim]
+	return ok
+}
+
+// Revoked returns true if any certificate of the verified chain is on the deny list.
+// Every certificate of the chain is issued by the next one, the last one is self-signed.
+func (v *Verifier) Revoked(chain []*x509.Certificate) bool {
+	for i, c := range chain {
+		if _, ok := v.fingerprints[Fingerprint(c)]; ok {
+			return true
+		}
+		issuer := c
+		if i+1 < len(chain) {
+			issuer = chain[i+1]
+		}
+		if _, ok := v.serials[Fingerprint(issuer)+"/"+c.SerialNumber.Text(16)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Claims maps the attributes of the certificate to claims. Claims of attributes which are not set
+// are omitted, the first value is used for attributes having several values.
+func (v *Verifier) Claims(c *x509.Certificate) map[string]interface{} {
+	claims := make(map[string]interface{}, len(v.claims))
+	for claim, attribute := range v.claims {
+		for _, value := range attribute(c) {
+			if value != "" {
+				claims[claim] = value
+				break
+			}
+		}
+	}
+	return claims
+}
+
+// Fingerprint returns the hex encoded SHA-256 fingerprint of the certificate
+func Fingerprint(c *x509.Certificate) string {
+	sum := sha256.Sum256(c.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// LoadCertPool reads the PEM encoded CA certificates used to verify the client certificates
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the client CA certificates: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// isFingerprint returns true if the normalized string is a hex encoded SHA-256 fingerprint
+func isFingerprint(s string) bool {
+	_, err := hex.DecodeString(s)
+	return err == nil && len(s) == 2*sha256.Size
+}
+
+// normalize strips the separators commonly used when printing fingerprints and serial numbers
+func normalize(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(":", "", " ", "").Replace(s)
+}
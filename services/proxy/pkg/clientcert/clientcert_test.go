@@ -0,0 +1,164 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+func newCertificate(t *testing.T, serial int64) *x509.Certificate {
+	t.Helper()
+	cert, _ := issueCertificate(t, serial, nil, nil)
+	return cert
+}
+
+// issueCertificate creates a certificate issued by the parent, it is self-signed without a parent
+func issueCertificate(t *testing.T, serial int64, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject: pkix.Name{
+			CommonName:         "kiosk-1",
+			Organization:       []string{"Example"},
+			OrganizationalUnit: []string{"", "Kiosks"},
+		},
+		EmailAddresses: []string{"kiosk-1@example.com"},
+		URIs:           []*url.URL{{Scheme: "urn", Opaque: "device:kiosk-1"}},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestNewVerifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.ClientCertificateAuth
+		wantErr bool
+	}{
+		{name: "valid", cfg: config.ClientCertificateAuth{
+			ClaimMapping: []string{"preferred_username=subject.cn", " email = SAN.Email "},
+			DenyList:     []string{strings.Repeat("AB:", 31) + "AB/0A:1B", strings.Repeat("ab", 32)},
+		}},
+		{name: "empty mapping", cfg: config.ClientCertificateAuth{}, wantErr: true},
+		{name: "missing attribute", cfg: config.ClientCertificateAuth{ClaimMapping: []string{"preferred_username"}}, wantErr: true},
+		{name: "missing claim", cfg: config.ClientCertificateAuth{ClaimMapping: []string{"=subject.cn"}}, wantErr: true},
+		{name: "unsupported attribute", cfg: config.ClientCertificateAuth{ClaimMapping: []string{"preferred_username=subject.street"}}, wantErr: true},
+		{name: "invalid serial", cfg: config.ClientCertificateAuth{
+			ClaimMapping: []string{"preferred_username=subject.cn"},
+			DenyList:     []string{strings.Repeat("ab", 32) + "/xyz"},
+		}, wantErr: true},
+		{name: "serial without issuer", cfg: config.ClientCertificateAuth{
+			ClaimMapping: []string{"preferred_username=subject.cn"},
+			DenyList:     []string{"0A:1B"},
+		}, wantErr: true},
+		{name: "invalid fingerprint", cfg: config.ClientCertificateAuth{
+			ClaimMapping: []string{"preferred_username=subject.cn"},
+			DenyList:     []string{strings.Repeat("zz", 32)},
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewVerifier(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("NewVerifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifier_Claims(t *testing.T) {
+	v, err := NewVerifier(config.ClientCertificateAuth{
+		ClaimMapping: []string{
+			"preferred_username=subject.cn",
+			"email=san.email",
+			"roles=subject.ou",
+			"device=san.uri",
+			"host=san.dns",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := v.Claims(newCertificate(t, 1))
+	want := map[string]string{
+		"preferred_username": "kiosk-1",
+		"email":              "kiosk-1@example.com",
+		"roles":              "Kiosks",
+		"device":             "urn:device:kiosk-1",
+	}
+	if len(claims) != len(want) {
+		t.Fatalf("got claims %v, want %v", claims, want)
+	}
+	for claim, value := range want {
+		if claims[claim] != value {
+			t.Errorf("claim %s is %v, want %s", claim, claims[claim], value)
+		}
+	}
+	if !v.Maps("preferred_username") || v.Maps("sub") {
+		t.Error("Maps() does not match the claim mapping")
+	}
+}
+
+func TestVerifier_Revoked(t *testing.T) {
+	ca, caKey := issueCertificate(t, 1, nil, nil)
+	otherCA, otherCAKey := issueCertificate(t, 2, nil, nil)
+	revokedCA := newCertificate(t, 3)
+	revoked, _ := issueCertificate(t, 0x0a1b, ca, caKey)
+	sameSerial, _ := issueCertificate(t, 0x0a1b, otherCA, otherCAKey)
+	byFingerprint, _ := issueCertificate(t, 4, ca, caKey)
+	valid, _ := issueCertificate(t, 5, ca, caKey)
+
+	v, err := NewVerifier(config.ClientCertificateAuth{
+		ClaimMapping: []string{"preferred_username=subject.cn"},
+		DenyList: []string{
+			strings.ToUpper(Fingerprint(ca)) + "/0A:1B",
+			strings.ToUpper(Fingerprint(byFingerprint)),
+			Fingerprint(revokedCA),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !v.Revoked([]*x509.Certificate{revoked, ca}) {
+		t.Error("certificate on the deny list by serial number was not revoked")
+	}
+	if v.Revoked([]*x509.Certificate{sameSerial, otherCA}) {
+		t.Error("certificate with the serial number of a revoked certificate of another CA was revoked")
+	}
+	if !v.Revoked([]*x509.Certificate{byFingerprint, ca}) {
+		t.Error("certificate on the deny list by fingerprint was not revoked")
+	}
+	if !v.Revoked([]*x509.Certificate{valid, revokedCA}) {
+		t.Error("certificate issued by a revoked CA was not revoked")
+	}
+	if v.Revoked([]*x509.Certificate{valid, ca}) {
+		t.Error("valid certificate was revoked")
+	}
+}
@@ -20,17 +20,21 @@ import (
 	pkgmiddleware "github.com/owncloud/ocis/v2/ocis-pkg/middleware"
 	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
 	"github.com/owncloud/ocis/v2/ocis-pkg/registry"
+	"github.com/owncloud/ocis/v2/ocis-pkg/roles"
 	"github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
 	"github.com/owncloud/ocis/v2/ocis-pkg/tracing"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
 	policiessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/policies/v0"
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/clientcert"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config/parser"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/logging"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/metrics"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/middleware"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/proxy"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/server/debug"
 	proxyHTTP "github.com/owncloud/ocis/v2/services/proxy/pkg/server/http"
@@ -73,6 +77,16 @@ func Server(cfg *config.Config) *cli.Command {
 				store.Authentication(cfg.PreSignedURL.SigningKeys.AuthUsername, cfg.PreSignedURL.SigningKeys.AuthPassword),
 			)
 
+			rateLimitStore := store.Create(
+				store.Store(cfg.RateLimits.Store),
+				store.TTL(rateLimitTTL(cfg.Policies)),
+				microstore.Nodes(cfg.RateLimits.Nodes...),
+				microstore.Database("proxy"),
+				microstore.Table("rate-limits"),
+				store.DisablePersistence(true),
+				store.Authentication(cfg.RateLimits.AuthUsername, cfg.RateLimits.AuthPassword),
+			)
+
 			logger := logging.Configure(cfg.Service.Name, cfg.Log)
 			traceProvider, err := tracing.GetServiceTraceProvider(cfg.Tracing, cfg.Service.Name)
 			if err != nil {
@@ -165,6 +179,25 @@ func Server(cfg *config.Config) *cli.Command {
 				}
 			}
 
+			var bruteForceGuard *bruteforce.Guard
+			if cfg.BruteForceProtection.Enabled {
+				bruteForceStore := store.Create(
+					store.Store(cfg.BruteForceProtection.Store),
+					store.TTL(cfg.BruteForceProtection.LockoutDuration),
+					microstore.Nodes(cfg.BruteForceProtection.Nodes...),
+					microstore.Database("proxy"),
+					microstore.Table("lockouts"),
+					store.DisablePersistence(true),
+					store.Authentication(cfg.BruteForceProtection.AuthUsername, cfg.BruteForceProtection.AuthPassword),
+				)
+				bruteForceGuard = bruteforce.NewGuard(bruteForceStore, cfg.BruteForceProtection, publisher, logger)
+			}
+
+			roleManager := roles.NewManager(
+				roles.Logger(logger),
+				roles.RoleService(settingssvc.NewRoleService("com.owncloud.api.settings", cfg.GrpcClient)),
+			)
+
 			lh := staticroutes.StaticRouteHandler{
 				Prefix:          cfg.HTTP.Root,
 				UserInfoCache:   userInfoCache,
@@ -175,13 +208,15 @@ func Server(cfg *config.Config) *cli.Command {
 				Proxy:           rp,
 				EventsPublisher: publisher,
 				UserProvider:    userProvider,
+				BruteForceGuard: bruteForceGuard,
+				RoleManager:     &roleManager,
 			}
 			if err != nil {
 				return fmt.Errorf("failed to initialize reverse proxy: %w", err)
 			}
 
 			{
-				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, traceProvider, *m, userProvider, publisher, gatewaySelector, serviceSelector)
+				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, rateLimitStore, traceProvider, *m, userProvider, publisher, bruteForceGuard, gatewaySelector, serviceSelector)
 
 				server, err := proxyHTTP.Server(
 					proxyHTTP.Handler(lh.Handler()),
@@ -242,9 +277,9 @@ func Server(cfg *config.Config) *cli.Command {
 }
 
 func loadMiddlewares(logger log.Logger, cfg *config.Config,
-	userInfoCache, signingKeyStore microstore.Store,
+	userInfoCache, signingKeyStore, rateLimitStore microstore.Store,
 	traceProvider trace.TracerProvider, metrics metrics.Metrics,
-	userProvider backend.UserBackend, publisher events.Publisher,
+	userProvider backend.UserBackend, publisher events.Publisher, bruteForceGuard *bruteforce.Guard,
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient], serviceSelector selector.Selector) alice.Chain {
 
 	rolesClient := settingssvc.NewRoleService("com.owncloud.api.settings", cfg.GrpcClient)
@@ -285,8 +320,9 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 	if cfg.EnableBasicAuth {
 		logger.Warn().Msg("basic auth enabled, use only for testing or development")
 		authenticators = append(authenticators, middleware.BasicAuthenticator{
-			Logger:       logger,
-			UserProvider: userProvider,
+			Logger:          logger,
+			UserProvider:    userProvider,
+			BruteForceGuard: bruteForceGuard,
 		})
 	}
 
@@ -311,9 +347,21 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		)),
 		middleware.SkipUserInfo(cfg.OIDC.SkipUserInfo),
 	))
+	if cfg.ClientCertificateAuth.Enabled {
+		verifier, err := clientcert.NewVerifier(cfg.ClientCertificateAuth)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("Failed to parse the client certificate authentication configuration.")
+		}
+		authenticators = append(authenticators, middleware.ClientCertificateAuthenticator{
+			Logger:        logger,
+			Verifier:      verifier,
+			UserOIDCClaim: cfg.UserOIDCClaim,
+		})
+	}
 	authenticators = append(authenticators, middleware.PublicShareAuthenticator{
 		Logger:              logger,
 		RevaGatewaySelector: gatewaySelector,
+		BruteForceGuard:     bruteForceGuard,
 	})
 	authenticators = append(authenticators, middleware.SignedURLAuthenticator{
 		Logger:             logger,
@@ -340,7 +388,7 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		middleware.Tracer(traceProvider),
 		pkgmiddleware.TraceContext,
 		middleware.Instrumenter(metrics),
-		chimiddleware.RealIP,
+		middleware.RealIP(cfg.TrustedProxies),
 		chimiddleware.RequestID,
 		middleware.AccessLog(logger),
 		middleware.HTTPSRedirect,
@@ -364,6 +412,10 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 			middleware.AutoprovisionAccounts(cfg.AutoprovisionAccounts),
 			middleware.EventsPublisher(publisher),
 		),
+		middleware.RateLimit(
+			middleware.Logger(logger),
+			middleware.RateLimiter(ratelimit.NewLimiter(rateLimitStore)),
+		),
 		middleware.SelectorCookie(
 			middleware.Logger(logger),
 			middleware.PolicySelectorConfig(*cfg.PolicySelector),
@@ -382,3 +434,19 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		),
 	)
 }
+
+// rateLimitTTL returns how long the state of the rate limits needs to be kept, which is the time to refill the largest bucket
+func rateLimitTTL(policies []config.Policy) time.Duration {
+	ttl := time.Minute
+	for _, policy := range policies {
+		for _, route := range policy.Routes {
+			if route.RateLimit == nil {
+				continue
+			}
+			if limit, err := ratelimit.NewLimit(route.Endpoint, *route.RateLimit); err == nil && limit.RefillTime() > ttl {
+				ttl = limit.RefillTime()
+			}
+		}
+	}
+	return ttl
+}
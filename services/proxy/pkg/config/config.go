@@ -24,27 +24,31 @@ type Config struct {
 	GRPCClientTLS *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
 	GrpcClient    client.Client         `yaml:"-"`
 
-	RoleQuotas            map[string]uint64   `yaml:"role_quotas"`
-	Policies              []Policy            `yaml:"policies"`
-	AdditionalPolicies    []Policy            `yaml:"additional_policies"`
-	OIDC                  OIDC                `yaml:"oidc"`
-	ServiceAccount        ServiceAccount      `yaml:"service_account"`
-	RoleAssignment        RoleAssignment      `yaml:"role_assignment"`
-	PolicySelector        *PolicySelector     `yaml:"policy_selector"`
-	PreSignedURL          PreSignedURL        `yaml:"pre_signed_url"`
-	AccountBackend        string              `yaml:"account_backend" env:"PROXY_ACCOUNT_BACKEND_TYPE" desc:"Account backend the PROXY service should use. Currently only 'cs3' is possible here." introductionVersion:"pre5.0"`
-	UserOIDCClaim         string              `yaml:"user_oidc_claim" env:"PROXY_USER_OIDC_CLAIM" desc:"The name of an OpenID Connect claim that is used for resolving users with the account backend. The value of the claim must hold a per user unique, stable and non re-assignable identifier. The availability of claims depends on your Identity Provider. There are common claims available for most Identity providers like 'email' or 'preferred_username' but you can also add your own claim." introductionVersion:"pre5.0"`
-	UserCS3Claim          string              `yaml:"user_cs3_claim" env:"PROXY_USER_CS3_CLAIM" desc:"The name of a CS3 user attribute (claim) that should be mapped to the 'user_oidc_claim'. Supported values are 'username', 'mail' and 'userid'." introductionVersion:"pre5.0"`
-	MachineAuthAPIKey     string              `yaml:"machine_auth_api_key" env:"OCIS_MACHINE_AUTH_API_KEY;PROXY_MACHINE_AUTH_API_KEY" desc:"Machine auth API key used to validate internal requests necessary to access resources from other services." introductionVersion:"pre5.0" mask:"password"`
-	AutoprovisionAccounts bool                `yaml:"auto_provision_accounts" env:"PROXY_AUTOPROVISION_ACCOUNTS" desc:"Set this to 'true' to automatically provision users that do not yet exist in the users service on-demand upon first sign-in. To use this a write-enabled libregraph user backend needs to be setup an running." introductionVersion:"pre5.0"`
-	AutoProvisionClaims   AutoProvisionClaims `yaml:"auto_provision_claims"`
-	EnableBasicAuth       bool                `yaml:"enable_basic_auth" env:"PROXY_ENABLE_BASIC_AUTH" desc:"Set this to true to enable 'basic authentication' (username/password)." introductionVersion:"pre5.0"`
-	InsecureBackends      bool                `yaml:"insecure_backends" env:"PROXY_INSECURE_BACKENDS" desc:"Disable TLS certificate validation for all HTTP backend connections." introductionVersion:"pre5.0"`
-	BackendHTTPSCACert    string              `yaml:"backend_https_cacert" env:"PROXY_HTTPS_CACERT" desc:"Path/File for the root CA certificate used to validate the server’s TLS certificate for https enabled backend services." introductionVersion:"pre5.0"`
-	AuthMiddleware        AuthMiddleware      `yaml:"auth_middleware"`
-	PoliciesMiddleware    PoliciesMiddleware  `yaml:"policies_middleware"`
-	CSPConfigFileLocation string              `yaml:"csp_config_file_location" env:"PROXY_CSP_CONFIG_FILE_LOCATION" desc:"The location of the CSP configuration file." introductionVersion:"6.0.0"`
-	Events                Events              `yaml:"events"`
+	RoleQuotas            map[string]uint64     `yaml:"role_quotas"`
+	Policies              []Policy              `yaml:"policies"`
+	AdditionalPolicies    []Policy              `yaml:"additional_policies"`
+	OIDC                  OIDC                  `yaml:"oidc"`
+	ServiceAccount        ServiceAccount        `yaml:"service_account"`
+	RoleAssignment        RoleAssignment        `yaml:"role_assignment"`
+	PolicySelector        *PolicySelector       `yaml:"policy_selector"`
+	PreSignedURL          PreSignedURL          `yaml:"pre_signed_url"`
+	AccountBackend        string                `yaml:"account_backend" env:"PROXY_ACCOUNT_BACKEND_TYPE" desc:"Account backend the PROXY service should use. Currently only 'cs3' is possible here." introductionVersion:"pre5.0"`
+	UserOIDCClaim         string                `yaml:"user_oidc_claim" env:"PROXY_USER_OIDC_CLAIM" desc:"The name of an OpenID Connect claim that is used for resolving users with the account backend. The value of the claim must hold a per user unique, stable and non re-assignable identifier. The availability of claims depends on your Identity Provider. There are common claims available for most Identity providers like 'email' or 'preferred_username' but you can also add your own claim." introductionVersion:"pre5.0"`
+	UserCS3Claim          string                `yaml:"user_cs3_claim" env:"PROXY_USER_CS3_CLAIM" desc:"The name of a CS3 user attribute (claim) that should be mapped to the 'user_oidc_claim'. Supported values are 'username', 'mail' and 'userid'." introductionVersion:"pre5.0"`
+	MachineAuthAPIKey     string                `yaml:"machine_auth_api_key" env:"OCIS_MACHINE_AUTH_API_KEY;PROXY_MACHINE_AUTH_API_KEY" desc:"Machine auth API key used to validate internal requests necessary to access resources from other services." introductionVersion:"pre5.0" mask:"password"`
+	AutoprovisionAccounts bool                  `yaml:"auto_provision_accounts" env:"PROXY_AUTOPROVISION_ACCOUNTS" desc:"Set this to 'true' to automatically provision users that do not yet exist in the users service on-demand upon first sign-in. To use this a write-enabled libregraph user backend needs to be setup an running." introductionVersion:"pre5.0"`
+	AutoProvisionClaims   AutoProvisionClaims   `yaml:"auto_provision_claims"`
+	EnableBasicAuth       bool                  `yaml:"enable_basic_auth" env:"PROXY_ENABLE_BASIC_AUTH" desc:"Set this to true to enable 'basic authentication' (username/password)." introductionVersion:"pre5.0"`
+	InsecureBackends      bool                  `yaml:"insecure_backends" env:"PROXY_INSECURE_BACKENDS" desc:"Disable TLS certificate validation for all HTTP backend connections." introductionVersion:"pre5.0"`
+	BackendHTTPSCACert    string                `yaml:"backend_https_cacert" env:"PROXY_HTTPS_CACERT" desc:"Path/File for the root CA certificate used to validate the server’s TLS certificate for https enabled backend services." introductionVersion:"pre5.0"`
+	AuthMiddleware        AuthMiddleware        `yaml:"auth_middleware"`
+	PoliciesMiddleware    PoliciesMiddleware    `yaml:"policies_middleware"`
+	CSPConfigFileLocation string                `yaml:"csp_config_file_location" env:"PROXY_CSP_CONFIG_FILE_LOCATION" desc:"The location of the CSP configuration file." introductionVersion:"6.0.0"`
+	TrustedProxies        []string              `yaml:"trusted_proxies" env:"PROXY_TRUSTED_PROXIES" desc:"A list of IP addresses or CIDR networks of the reverse proxies in front of the proxy. The client IP is only taken from the 'X-Forwarded-For', 'X-Real-IP' or 'True-Client-IP' headers of requests sent by them, otherwise the address of the peer is used. The client IP is logged and used for rate limits and the brute force protection. Defaults to the loopback and private networks. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	Events                Events                `yaml:"events"`
+	RateLimits            RateLimits            `yaml:"rate_limits"`
+	BruteForceProtection  BruteForceProtection  `yaml:"brute_force_protection"`
+	ClientCertificateAuth ClientCertificateAuth `yaml:"client_certificate_auth"`
 
 	Context context.Context `json:"-" yaml:"-"`
 }
@@ -67,6 +71,22 @@ type Route struct {
 	Service     string `yaml:"service,omitempty"`
 	ApacheVHost bool   `yaml:"apache_vhost,omitempty"`
 	Unprotected bool   `yaml:"unprotected,omitempty"`
+	// RateLimit optionally limits the rate of requests to this route
+	RateLimit *RateLimit `yaml:"rate_limit,omitempty"`
+}
+
+// RateLimit defines a token bucket limiting the requests to a route.
+// Every user or client IP gets its own bucket holding up to Burst tokens, which is refilled with Requests tokens per Period.
+type RateLimit struct {
+	// Requests is the number of requests allowed per period
+	Requests int `yaml:"requests"`
+	// Period is the duration in which the requests are allowed, for example '1m'
+	Period string `yaml:"period"`
+	// Burst is the number of requests allowed at once, defaults to Requests
+	Burst int `yaml:"burst,omitempty"`
+	// Key defines whose requests are limited together, either 'user' or 'ip'. Requests of
+	// unauthenticated users are limited by their IP. Defaults to 'user'.
+	Key string `yaml:"key,omitempty"`
 }
 
 // RouteType defines the type of route
@@ -192,6 +212,35 @@ type SigningKeys struct {
 	AuthPassword       string        `yaml:"password" env:"OCIS_CACHE_AUTH_PASSWORD;PROXY_PRESIGNEDURL_SIGNING_KEYS_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"5.0"`
 }
 
+// RateLimits configures the store keeping the state of the rate limits defined for the routes.
+type RateLimits struct {
+	Store        string   `yaml:"store" env:"PROXY_RATE_LIMITS_STORE" desc:"The type of the store keeping the state of the rate limits. Supported values are: 'memory', 'redis-sentinel' and 'nats-js-kv'. With 'memory', every proxy instance limits the requests on its own. Use a shared store to apply the limits across all proxy instances. See the text description for details." introductionVersion:"7.0.0"`
+	Nodes        []string `yaml:"addresses" env:"OCIS_CACHE_STORE_NODES;PROXY_RATE_LIMITS_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	AuthUsername string   `yaml:"username" env:"OCIS_CACHE_AUTH_USERNAME;PROXY_RATE_LIMITS_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+	AuthPassword string   `yaml:"password" env:"OCIS_CACHE_AUTH_PASSWORD;PROXY_RATE_LIMITS_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+}
+
+// BruteForceProtection configures the protection of basic auth and public link passwords against brute-force attacks.
+type BruteForceProtection struct {
+	Enabled         bool          `yaml:"enabled" env:"PROXY_BRUTE_FORCE_PROTECTION_ENABLED" desc:"Delay and temporarily lock out failed basic auth and public link password attempts per username, client IP and public link. Disabled by default. See the text description for details." introductionVersion:"7.0.0"`
+	MaxAttempts     int           `yaml:"max_attempts" env:"PROXY_BRUTE_FORCE_PROTECTION_MAX_ATTEMPTS" desc:"The number of failed attempts after which a username, client IP or public link is locked out." introductionVersion:"7.0.0"`
+	Delay           time.Duration `yaml:"delay" env:"PROXY_BRUTE_FORCE_PROTECTION_DELAY" desc:"The delay of an attempt after the first failed attempt. The delay is doubled with every further failed attempt. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	MaxDelay        time.Duration `yaml:"max_delay" env:"PROXY_BRUTE_FORCE_PROTECTION_MAX_DELAY" desc:"The maximum delay of an attempt. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	LockoutDuration time.Duration `yaml:"lockout_duration" env:"PROXY_BRUTE_FORCE_PROTECTION_LOCKOUT_DURATION" desc:"The duration of a lockout. Failed attempts are also forgotten after this duration. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	Store           string        `yaml:"store" env:"PROXY_BRUTE_FORCE_PROTECTION_STORE" desc:"The type of the store keeping the failed attempts and lockouts. Supported values are: 'memory', 'redis-sentinel' and 'nats-js-kv'. With 'memory', every proxy instance counts the failed attempts on its own." introductionVersion:"7.0.0"`
+	Nodes           []string      `yaml:"addresses" env:"OCIS_CACHE_STORE_NODES;PROXY_BRUTE_FORCE_PROTECTION_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	AuthUsername    string        `yaml:"username" env:"OCIS_CACHE_AUTH_USERNAME;PROXY_BRUTE_FORCE_PROTECTION_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+	AuthPassword    string        `yaml:"password" env:"OCIS_CACHE_AUTH_PASSWORD;PROXY_BRUTE_FORCE_PROTECTION_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"7.0.0"`
+}
+
+// ClientCertificateAuth configures the authentication of requests with X.509 client certificates.
+type ClientCertificateAuth struct {
+	Enabled      bool     `yaml:"enabled" env:"PROXY_CLIENT_CERTIFICATE_AUTH_ENABLED" desc:"Authenticate requests with X.509 client certificates. Requires PROXY_TLS to be enabled. See the text description for details." introductionVersion:"7.0.0"`
+	CACert       string   `yaml:"ca_cert" env:"PROXY_CLIENT_CERTIFICATE_AUTH_CA_CERT" desc:"Path/File name of the CA certificates (in PEM format) used to verify the client certificates." introductionVersion:"7.0.0"`
+	ClaimMapping []string `yaml:"claim_mapping" env:"PROXY_CLIENT_CERTIFICATE_AUTH_CLAIM_MAPPING" desc:"A list of 'claim=attribute' pairs mapping attributes of the client certificate to claims. Supported attributes are 'subject.dn', 'subject.cn', 'subject.serialnumber', 'subject.o', 'subject.ou', 'san.email', 'san.dns' and 'san.uri'. The claim configured in PROXY_USER_OIDC_CLAIM must be mapped, it is used to resolve the user. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+	DenyList     []string `yaml:"deny_list" env:"PROXY_CLIENT_CERTIFICATE_AUTH_DENY_LIST" desc:"A list of revoked client or CA certificates identified by their hex encoded SHA-256 fingerprint or by the fingerprint of the issuing CA certificate and their serial number, separated by a slash. See the text description for details and the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+}
+
 // ClaimsSelectorConf is the config for the claims-selector
 type ClaimsSelectorConf struct {
 	DefaultPolicy         string `yaml:"default_policy"`
@@ -82,6 +82,24 @@ func DefaultConfig() *config.Config {
 				DisablePersistence: true,
 			},
 		},
+		// reverse proxies are usually deployed on the same host or in a private network
+		TrustedProxies: []string{"127.0.0.0/8", "::1/128", "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"},
+		RateLimits: config.RateLimits{
+			Store: "memory",
+			Nodes: []string{"127.0.0.1:9233"},
+		},
+		BruteForceProtection: config.BruteForceProtection{
+			MaxAttempts:     10,
+			Delay:           200 * time.Millisecond,
+			MaxDelay:        25 * time.Second,
+			LockoutDuration: 15 * time.Minute,
+			Store:           "nats-js-kv", // the lockouts are listed and cleared via any proxy instance
+			Nodes:           []string{"127.0.0.1:9233"},
+		},
+		ClientCertificateAuth: config.ClientCertificateAuth{
+			Enabled:      false,
+			ClaimMapping: []string{"preferred_username=subject.cn"},
+		},
 		AccountBackend:        "cs3",
 		UserOIDCClaim:         "preferred_username",
 		UserCS3Claim:          "username",
@@ -253,6 +271,21 @@ func DefaultPolicies() []config.Policy {
 					Endpoint: "/graph/v1beta1/extensions/org.libregraph/activities",
 					Service:  "com.owncloud.web.activitylog",
 				},
+				{
+					// webhooks report their results with a signed callback url, they have no user
+					Endpoint:    "/postprocessing/webhook/",
+					Service:     "com.owncloud.web.postprocessing",
+					Unprotected: true,
+				},
+				{
+					Endpoint: "/postprocessing/",
+					Service:  "com.owncloud.web.postprocessing",
+				},
+				{
+					// handled by the proxy itself
+					Endpoint: "/proxy/",
+					Service:  "com.owncloud.web.proxy",
+				},
 				{
 					Endpoint: "/graph/v1.0/invitations",
 					Service:  "com.owncloud.web.invitations",
@@ -6,8 +6,11 @@ import (
 
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/clientcert"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/middleware"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/envdecode"
 )
@@ -60,5 +63,39 @@ func Validate(cfg *config.Config) error {
 		return shared.MissingServiceAccountSecret(cfg.Service.Name)
 	}
 
+	for _, proxy := range cfg.TrustedProxies {
+		if _, err := middleware.ParseNetwork(proxy); err != nil {
+			return fmt.Errorf("invalid trusted proxy '%s' in service %s: %w", proxy, cfg.Service.Name, err)
+		}
+	}
+
+	if bfp := cfg.BruteForceProtection; bfp.Enabled && (bfp.MaxAttempts < 1 || bfp.Delay < 0 || bfp.MaxDelay < bfp.Delay || bfp.LockoutDuration <= 0) {
+		return fmt.Errorf("invalid brute force protection in service %s: max_attempts must be positive, max_delay must not be less than delay and lockout_duration must be positive", cfg.Service.Name)
+	}
+
+	if cca := cfg.ClientCertificateAuth; cca.Enabled {
+		if !cfg.HTTP.TLS || cca.CACert == "" {
+			return fmt.Errorf("client certificate authentication in service %s requires PROXY_TLS to be enabled and the CA certificates to be configured", cfg.Service.Name)
+		}
+		v, err := clientcert.NewVerifier(cca)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate authentication in service %s: %w", cfg.Service.Name, err)
+		}
+		if !v.Maps(cfg.UserOIDCClaim) {
+			return fmt.Errorf("invalid client certificate authentication in service %s: the user claim '%s' is not mapped", cfg.Service.Name, cfg.UserOIDCClaim)
+		}
+	}
+
+	for _, policy := range cfg.Policies {
+		for _, route := range policy.Routes {
+			if route.RateLimit == nil {
+				continue
+			}
+			if _, err := ratelimit.NewLimit(route.Endpoint, *route.RateLimit); err != nil {
+				return fmt.Errorf("invalid rate limit for the route '%s' of the policy '%s' in service %s: %w", route.Endpoint, policy.Name, cfg.Service.Name, err)
+			}
+		}
+	}
+
 	return nil
 }
@@ -117,9 +117,12 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 		user, token, err = m.userProvider.GetUserByClaims(req.Context(), m.userCS3Claim, value)
 
+		// users authenticated by a client certificate are neither provisioned nor updated from the claims
+		provision := m.autoProvisionAccounts && !authenticatedByClientCertificate(ctx)
+
 		if errors.Is(err, backend.ErrAccountNotFound) {
 			m.logger.Debug().Str("claim", m.userOIDCClaim).Str("value", value).Msg("User by claim not found")
-			if !m.autoProvisionAccounts {
+			if !provision {
 				m.logger.Debug().Interface("claims", claims).Msg("Autoprovisioning disabled")
 				w.WriteHeader(http.StatusUnauthorized)
 				return
@@ -151,7 +154,7 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 
-		if m.autoProvisionAccounts {
+		if provision {
 			if err = m.userProvider.UpdateUserIfNeeded(req.Context(), user, claims); err != nil {
 				m.logger.Error().Err(err).Str("userid", user.GetId().GetOpaqueId()).Interface("claims", claims).Msg("Failed to update autoprovisioned user")
 				w.WriteHeader(http.StatusInternalServerError)
@@ -168,8 +171,12 @@ func (m accountResolver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			}
 		}
 
-		// resolve the user's roles
-		user, err = m.userRoleAssigner.UpdateUserRoleAssignment(ctx, user, claims)
+		// resolve the user's roles, the claims of a client certificate don't carry roles to assign
+		if authenticatedByClientCertificate(ctx) {
+			user, err = m.userRoleAssigner.ApplyUserRole(ctx, user)
+		} else {
+			user, err = m.userRoleAssigner.UpdateUserRoleAssignment(ctx, user, claims)
+		}
 		if err != nil {
 			m.logger.Error().Err(err).Msg("Could not get user roles")
 			w.WriteHeader(http.StatusInternalServerError)
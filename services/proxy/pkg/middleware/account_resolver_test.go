@@ -157,6 +157,57 @@ func TestUnauthorizedOnUserDisabled(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, rw.Code)
 }
 
+func TestNoAutoprovisioningOnClientCertificate(t *testing.T) {
+	ub := mocks.UserBackend{}
+	ub.On("GetUserByClaims", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, "", backend.ErrAccountNotFound)
+	sut := AccountResolver(
+		Logger(log.NewLogger()),
+		UserProvider(&ub),
+		UserOIDCClaim(oidc.PreferredUsername),
+		UserCS3Claim("username"),
+		AutoprovisionAccounts(true),
+	)(mockHandler{})
+
+	req, rw := mockRequest(map[string]interface{}{
+		oidc.PreferredUsername: "kiosk",
+	})
+	req = req.WithContext(context.WithValue(req.Context(), clientCertificateKey{}, true))
+
+	sut.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	ub.AssertNotCalled(t, "CreateUserFromClaims", mock.Anything, mock.Anything)
+}
+
+func TestNoRoleAssignmentUpdateOnClientCertificate(t *testing.T) {
+	u := &userv1beta1.User{
+		Id:       &userv1beta1.UserId{Idp: "https://idx.example.com", OpaqueId: "123"},
+		Username: "kiosk",
+	}
+	ub := mocks.UserBackend{}
+	ub.On("GetUserByClaims", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(u, "", nil)
+	ra := userRoleMocks.UserRoleAssigner{}
+	ra.On("ApplyUserRole", mock.Anything, mock.Anything).Return(u, nil)
+	sut := AccountResolver(
+		Logger(log.NewLogger()),
+		UserProvider(&ub),
+		UserRoleAssigner(&ra),
+		UserOIDCClaim(oidc.PreferredUsername),
+		UserCS3Claim("username"),
+	)(mockHandler{})
+
+	req, rw := mockRequest(map[string]interface{}{
+		oidc.PreferredUsername: "kiosk",
+	})
+	req = req.WithContext(context.WithValue(req.Context(), clientCertificateKey{}, true))
+
+	sut.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusOK, rw.Code)
+	ra.AssertCalled(t, "ApplyUserRole", mock.Anything, mock.Anything)
+	ra.AssertNotCalled(t, "UpdateUserRoleAssignment", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestInternalServerErrorOnMissingMailAndUsername(t *testing.T) {
 	sut := newMockAccountResolver(nil, backend.ErrAccountNotFound, oidc.Email, "mail")
 	req, rw := mockRequest(map[string]interface{}{
@@ -1,10 +1,12 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
 )
 
@@ -14,6 +16,8 @@ type BasicAuthenticator struct {
 	UserProvider  backend.UserBackend
 	UserCS3Claim  string
 	UserOIDCClaim string
+	// BruteForceGuard optionally delays and locks out failed attempts per username and client IP
+	BruteForceGuard *bruteforce.Guard
 }
 
 // Authenticate implements the authenticator interface to authenticate requests via basic auth.
@@ -30,8 +34,21 @@ func (m BasicAuthenticator) Authenticate(r *http.Request) (*http.Request, bool)
 		return nil, false
 	}
 
+	attempt, err := m.BruteForceGuard.Start(r.Context(), clientIP(r), bruteforce.User(login), bruteforce.IP(clientIP(r)))
+	if err != nil {
+		m.Logger.Warn().
+			Err(err).
+			Str("authenticator", "basic").
+			Str("path", r.URL.Path).
+			Msg("rejected authentication attempt")
+		return nil, false
+	}
+
 	user, _, err := m.UserProvider.Authenticate(r.Context(), login, password)
 	if err != nil {
+		if errors.Is(err, backend.ErrInvalidCredentials) || errors.Is(err, backend.ErrAccountNotFound) {
+			attempt.Failed()
+		}
 		m.Logger.Error().
 			Err(err).
 			Str("authenticator", "basic").
@@ -39,6 +56,7 @@ func (m BasicAuthenticator) Authenticate(r *http.Request) (*http.Request, bool)
 			Msg("failed to authenticate request")
 		return nil, false
 	}
+	attempt.Succeeded()
 
 	// fake oidc claims
 	claims := map[string]interface{}{
@@ -3,6 +3,7 @@ package middleware
 import (
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
@@ -11,8 +12,11 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend/mocks"
+	"go-micro.dev/v4/store"
 )
 
 var _ = Describe("Authenticating requests", Label("BasicAuthenticator"), func() {
@@ -30,6 +34,7 @@ var _ = Describe("Authenticating requests", Label("BasicAuthenticator"), func()
 		"",
 		nil,
 	)
+	ub.On("Authenticate", mock.Anything, mock.Anything, "wrongpassword").Return(nil, "", backend.ErrInvalidCredentials)
 	ub.On("Authenticate", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", backend.ErrAccountNotFound)
 
 	BeforeEach(func() {
@@ -64,4 +69,47 @@ var _ = Describe("Authenticating requests", Label("BasicAuthenticator"), func()
 			Expect(claims[oidc.OwncloudUUID]).To(Equal("OpaqueId"))
 		})
 	})
+
+	When("the brute force protection is enabled", func() {
+		BeforeEach(func() {
+			authenticator = BasicAuthenticator{
+				Logger:       log.NewLogger(),
+				UserProvider: &ub,
+				BruteForceGuard: bruteforce.NewGuard(store.NewMemoryStore(), config.BruteForceProtection{
+					MaxAttempts:     2,
+					LockoutDuration: time.Minute,
+				}, nil, log.NopLogger()),
+			}
+		})
+
+		It("locks out the user after too many failed attempts", func() {
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
+				req.SetBasicAuth("testuser", "wrongpassword")
+				_, valid := authenticator.Authenticate(req)
+				Expect(valid).To(Equal(false))
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
+			req.RemoteAddr = "192.0.2.2:1234"
+			req.SetBasicAuth("testuser", "testpassword")
+			_, valid := authenticator.Authenticate(req)
+			Expect(valid).To(Equal(false))
+		})
+
+		It("forgets the failed attempts of the user after a successful attempt", func() {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
+			req.SetBasicAuth("testuser", "wrongpassword")
+			_, valid := authenticator.Authenticate(req)
+			Expect(valid).To(Equal(false))
+
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
+				req.RemoteAddr = "192.0.2.2:1234"
+				req.SetBasicAuth("testuser", "testpassword")
+				_, valid := authenticator.Authenticate(req)
+				Expect(valid).To(Equal(true))
+			}
+		})
+	})
 })
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/clientcert"
+)
+
+type clientCertificateKey struct{}
+
+// ClientCertificateAuthenticator is the authenticator responsible for authenticating requests with
+// X.509 client certificates. The certificates are verified against the configured CAs during the
+// TLS handshake, the user is resolved from the mapped claims by the account resolver.
+type ClientCertificateAuthenticator struct {
+	Logger        log.Logger
+	Verifier      *clientcert.Verifier
+	UserOIDCClaim string
+}
+
+// Authenticate implements the authenticator interface to authenticate requests via client certificates.
+func (m ClientCertificateAuthenticator) Authenticate(r *http.Request) (*http.Request, bool) {
+	if isPublicPath(r.URL.Path) && isPublicWithShareToken(r) {
+		// The authentication of public path requests is handled by another authenticator.
+		// Since we can't guarantee the order of execution of the authenticators, we better
+		// implement an early return here for paths we can't authenticate in this authenticator.
+		return nil, false
+	}
+
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		// no client certificate or the certificate could not be verified
+		return nil, false
+	}
+	chain := r.TLS.VerifiedChains[0]
+	cert := chain[0]
+
+	if m.Verifier.Revoked(chain) {
+		m.Logger.Warn().
+			Str("authenticator", "client_certificate").
+			Str("path", r.URL.Path).
+			Str("subject", cert.Subject.String()).
+			Str("fingerprint", clientcert.Fingerprint(cert)).
+			Msg("rejected revoked client certificate")
+		return nil, false
+	}
+
+	claims := m.Verifier.Claims(cert)
+	if _, ok := claims[m.UserOIDCClaim]; !ok {
+		m.Logger.Error().
+			Str("authenticator", "client_certificate").
+			Str("path", r.URL.Path).
+			Str("subject", cert.Subject.String()).
+			Str("claim", m.UserOIDCClaim).
+			Msg("client certificate does not provide the user claim")
+		return nil, false
+	}
+
+	m.Logger.Debug().
+		Str("authenticator", "client_certificate").
+		Str("path", r.URL.Path).
+		Str("subject", cert.Subject.String()).
+		Msg("successfully authenticated request")
+	ctx := context.WithValue(oidc.NewContext(r.Context(), claims), clientCertificateKey{}, true)
+	return r.WithContext(ctx), true
+}
+
+// authenticatedByClientCertificate returns true if the claims of the request were mapped from a client certificate
+func authenticatedByClientCertificate(ctx context.Context) bool {
+	ok, _ := ctx.Value(clientCertificateKey{}).(bool)
+	return ok
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/clientcert"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+)
+
+var _ = Describe("Authenticating requests", Label("ClientCertificateAuthenticator"), func() {
+	var (
+		authenticator Authenticator
+		cert          *x509.Certificate
+	)
+
+	newCertificate := func(cn string) *x509.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber:   big.NewInt(time.Now().UnixNano()),
+			Subject:        pkix.Name{CommonName: cn},
+			EmailAddresses: []string{"kiosk@example.com"},
+			NotBefore:      time.Now(),
+			NotAfter:       time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+		c, err := x509.ParseCertificate(der)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	newRequest := func(c *x509.Certificate) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/example/path", http.NoBody)
+		if c != nil {
+			req.TLS.VerifiedChains = [][]*x509.Certificate{{c}}
+		}
+		return req
+	}
+
+	BeforeEach(func() {
+		cert = newCertificate("kiosk")
+		verifier, err := clientcert.NewVerifier(config.ClientCertificateAuth{
+			ClaimMapping: []string{"preferred_username=subject.cn", "email=san.email"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		authenticator = ClientCertificateAuthenticator{
+			Logger:        log.NewLogger(),
+			Verifier:      verifier,
+			UserOIDCClaim: oidc.PreferredUsername,
+		}
+	})
+
+	When("the request contains a verified client certificate", func() {
+		It("should successfully authenticate", func() {
+			req, valid := authenticator.Authenticate(newRequest(cert))
+
+			Expect(valid).To(BeTrue())
+			Expect(req).ToNot(BeNil())
+		})
+		It("adds the mapped claims to the request context", func() {
+			req, _ := authenticator.Authenticate(newRequest(cert))
+
+			claims := oidc.FromContext(req.Context())
+			Expect(claims).To(Equal(map[string]interface{}{
+				oidc.PreferredUsername: "kiosk",
+				oidc.Email:             "kiosk@example.com",
+			}))
+			Expect(authenticatedByClientCertificate(req.Context())).To(BeTrue())
+		})
+	})
+
+	When("the request contains no verified client certificate", func() {
+		It("should not authenticate plain http requests", func() {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
+			req2, valid := authenticator.Authenticate(req)
+
+			Expect(valid).To(BeFalse())
+			Expect(req2).To(BeNil())
+		})
+		It("should not authenticate requests without certificate", func() {
+			req, valid := authenticator.Authenticate(newRequest(nil))
+
+			Expect(valid).To(BeFalse())
+			Expect(req).To(BeNil())
+		})
+		It("should not authenticate unverified certificates", func() {
+			req := newRequest(nil)
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+			req2, valid := authenticator.Authenticate(req)
+
+			Expect(valid).To(BeFalse())
+			Expect(req2).To(BeNil())
+		})
+	})
+
+	When("the client certificate is on the deny list", func() {
+		It("should not authenticate", func() {
+			authenticator := authenticator.(ClientCertificateAuthenticator)
+			revoked := newCertificate("revoked")
+			verifier, err := clientcert.NewVerifier(config.ClientCertificateAuth{
+				ClaimMapping: []string{"preferred_username=subject.cn"},
+				DenyList:     []string{clientcert.Fingerprint(revoked)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			authenticator.Verifier = verifier
+
+			req, valid := authenticator.Authenticate(newRequest(revoked))
+
+			Expect(valid).To(BeFalse())
+			Expect(req).To(BeNil())
+		})
+	})
+
+	When("the client certificate does not provide the user claim", func() {
+		It("should not authenticate", func() {
+			req, valid := authenticator.Authenticate(newRequest(newCertificate("")))
+
+			Expect(valid).To(BeFalse())
+			Expect(req).To(BeNil())
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	policiessvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/policies/v0"
 	settingssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/settings/v0"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/userroles"
 	"go-micro.dev/v4/store"
@@ -72,6 +73,8 @@ type Options struct {
 	// SkipUserInfo prevents the oidc middleware from querying the userinfo endpoint and read any claims directly from the access token instead
 	SkipUserInfo    bool
 	EventsPublisher events.Publisher
+	// RateLimiter keeps the state of the rate limits of the routes
+	RateLimiter *ratelimit.Limiter
 }
 
 // newOptions initializes the available default options.
@@ -245,3 +248,10 @@ func EventsPublisher(ep events.Publisher) Option {
 		o.EventsPublisher = ep
 	}
 }
+
+// RateLimiter sets the rate limiter.
+func RateLimiter(l *ratelimit.Limiter) Option {
+	return func(o *Options) {
+		o.RateLimiter = l
+	}
+}
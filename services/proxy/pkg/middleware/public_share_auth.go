@@ -5,8 +5,10 @@ import (
 	"strings"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
 )
 
 const (
@@ -24,6 +26,8 @@ const (
 type PublicShareAuthenticator struct {
 	Logger              log.Logger
 	RevaGatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	// BruteForceGuard optionally delays and locks out failed attempts per public link and client IP
+	BruteForceGuard *bruteforce.Guard
 }
 
 // The archiver is able to create archives from public shares in which case it needs to use the
@@ -74,6 +78,7 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 	}
 
 	var sharePassword string
+	var hasCredentials bool
 	if signature := query.Get(_paramSignature); signature != "" {
 		expiration := query.Get(_paramExpiration)
 		if expiration == "" {
@@ -81,6 +86,7 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 			return nil, false
 		}
 		sharePassword = strings.Join([]string{"signature", signature, expiration}, "|")
+		hasCredentials = true
 	} else {
 		// We can ignore the username since it is always set to "public" in public shares.
 		_, password, ok := r.BasicAuth()
@@ -89,6 +95,18 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 		if ok {
 			sharePassword += password
 		}
+		hasCredentials = ok
+	}
+
+	attempt, err := a.BruteForceGuard.Start(r.Context(), clientIP(r), bruteforce.Link(shareToken), bruteforce.IP(clientIP(r)))
+	if err != nil {
+		a.Logger.Warn().
+			Err(err).
+			Str("authenticator", "public_share").
+			Str("public_share_token", shareToken).
+			Str("path", r.URL.Path).
+			Msg("rejected authentication attempt")
+		return nil, false
 	}
 
 	client, err := a.RevaGatewaySelector.Next()
@@ -118,6 +136,19 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 		return nil, false
 	}
 
+	switch authResp.GetStatus().GetCode() {
+	case rpc.Code_CODE_OK:
+		attempt.Succeeded()
+	case rpc.Code_CODE_NOT_FOUND:
+		// guessed link tokens
+		attempt.Failed()
+	case rpc.Code_CODE_UNAUTHENTICATED, rpc.Code_CODE_PERMISSION_DENIED:
+		// opening a password protected link without the password is part of the normal flow
+		if hasCredentials {
+			attempt.Failed()
+		}
+	}
+
 	r.Header.Add(_headerRevaAccessToken, authResp.Token)
 
 	a.Logger.Debug().
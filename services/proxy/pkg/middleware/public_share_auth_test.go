@@ -2,8 +2,10 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	gatewayv1beta1 "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
@@ -12,6 +14,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"go-micro.dev/v4/store"
 	"google.golang.org/grpc"
 )
 
@@ -98,6 +103,29 @@ var _ = Describe("Authenticating requests", Label("PublicShareAuthenticator"), f
 			})
 		})
 	})
+	When("the brute force protection is enabled", func() {
+		It("locks out the public link after too many failed attempts", func() {
+			a := authenticator.(PublicShareAuthenticator)
+			a.BruteForceGuard = bruteforce.NewGuard(store.NewMemoryStore(), config.BruteForceProtection{
+				MaxAttempts:     2,
+				LockoutDuration: time.Minute,
+			}, nil, log.NopLogger())
+
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(http.MethodGet, "http://example.com/dav/public-files/?public-token=sharetoken", http.NoBody)
+				req.RemoteAddr = fmt.Sprintf("192.0.2.%d:1234", i)
+				req.SetBasicAuth("public", "wrongpassword")
+				_, _ = a.Authenticate(req)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/dav/public-files/?public-token=sharetoken", http.NoBody)
+			req.SetBasicAuth("public", "examples3cr3t")
+			req2, valid := a.Authenticate(req)
+
+			Expect(valid).To(Equal(false))
+			Expect(req2).To(BeNil())
+		})
+	})
 })
 
 type mockGatewayClient struct {
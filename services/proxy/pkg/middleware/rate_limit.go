@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+)
+
+// RateLimit provides a middleware which limits the rate of requests to the routes having a rate limit.
+// Requests exceeding the limit are rejected with '429 Too Many Requests'.
+func RateLimit(optionSetters ...Option) func(next http.Handler) http.Handler {
+	options := newOptions(optionSetters...)
+
+	return func(next http.Handler) http.Handler {
+		return &rateLimit{
+			next:    next,
+			logger:  options.Logger,
+			limiter: options.RateLimiter,
+		}
+	}
+}
+
+type rateLimit struct {
+	next    http.Handler
+	logger  log.Logger
+	limiter *ratelimit.Limiter
+}
+
+func (m rateLimit) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	limit := router.ContextRoutingInfo(req.Context()).RateLimit()
+	if limit == nil || m.limiter == nil {
+		m.next.ServeHTTP(w, req)
+		return
+	}
+
+	allowed, retryAfter, err := m.limiter.Allow(*limit, subject(req, limit.Key))
+	if err != nil {
+		// don't block the requests when the store is not available
+		m.logger.Error().Err(err).Str("route", limit.Name).Msg("could not evaluate the rate limit")
+		m.next.ServeHTTP(w, req)
+		return
+	}
+	if !allowed {
+		m.logger.Debug().Str("route", limit.Name).Str("path", req.URL.Path).Msg("rate limit exceeded")
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+	m.next.ServeHTTP(w, req)
+}
+
+// subject returns the identifier of the bucket the request is counted in
+func subject(req *http.Request, key string) string {
+	if key == ratelimit.KeyUser {
+		if u, ok := revactx.ContextGetUser(req.Context()); ok && u.GetId().GetOpaqueId() != "" {
+			return "user:" + u.GetId().GetOpaqueId()
+		}
+	}
+	return "ip:" + clientIP(req)
+}
+
+// clientIP returns the IP of the client sending the request
+func clientIP(req *http.Request) string {
+	// the RealIP middleware replaces the remote address with the client IP forwarded by a trusted proxy
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	return ip
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/router"
+	"go-micro.dev/v4/store"
+)
+
+var _ = Describe("Rate limiting requests", Label("RateLimit"), func() {
+	var (
+		rt      router.Router
+		handler http.Handler
+	)
+
+	BeforeEach(func() {
+		rt = router.New(nil, &config.PolicySelector{Static: &config.StaticSelectorConf{Policy: "ocis"}}, []config.Policy{{
+			Name: "ocis",
+			Routes: []config.Route{
+				{Endpoint: "/", Backend: "http://localhost"},
+				{Endpoint: "/user/", Backend: "http://localhost", RateLimit: &config.RateLimit{Requests: 1, Period: "1m"}},
+				{Endpoint: "/ip/", Backend: "http://localhost", RateLimit: &config.RateLimit{Requests: 2, Period: "1m", Key: ratelimit.KeyIP}},
+			},
+		}}, log.NopLogger())
+
+		handler = RateLimit(
+			Logger(log.NopLogger()),
+			RateLimiter(ratelimit.NewLimiter(store.NewMemoryStore())),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	serve := func(path, userID, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		ri, ok := rt.Route(req)
+		Expect(ok).To(BeTrue())
+		ctx := router.SetRoutingInfo(req.Context(), ri)
+		if userID != "" {
+			ctx = revactx.ContextSetUser(ctx, &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: userID}})
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req.WithContext(ctx))
+		return rec
+	}
+
+	It("does not limit routes without a rate limit", func() {
+		for i := 0; i < 5; i++ {
+			Expect(serve("/other", "alice", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		}
+	})
+
+	It("limits the requests per user", func() {
+		Expect(serve("/user/files", "alice", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+
+		rec := serve("/user/files", "alice", "10.0.0.2:1234")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rec.Header().Get("Retry-After")).To(Equal("60"))
+
+		Expect(serve("/user/files", "bob", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+	})
+
+	It("limits unauthenticated requests per IP", func() {
+		Expect(serve("/user/files", "", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		Expect(serve("/user/files", "", "10.0.0.1:5678").Code).To(Equal(http.StatusTooManyRequests))
+		Expect(serve("/user/files", "", "10.0.0.2:1234").Code).To(Equal(http.StatusOK))
+	})
+
+	It("limits the requests per IP", func() {
+		Expect(serve("/ip/files", "alice", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		Expect(serve("/ip/files", "bob", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		Expect(serve("/ip/files", "carol", "10.0.0.1:1234").Code).To(Equal(http.StatusTooManyRequests))
+	})
+})
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RealIP replaces the remote address of requests sent by trusted proxies with the client IP from their forwarding
+// headers. The forwarding headers of requests from other peers are ignored, clients could set them to any IP.
+// The trusted proxies are IP addresses or CIDR networks, invalid entries are skipped.
+func RealIP(trustedProxies []string) func(http.Handler) http.Handler {
+	trusted := ParseNetworks(trustedProxies)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if ip := forwardedIP(r, trusted); ip != "" {
+				r.RemoteAddr = ip
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParseNetworks parses a list of IP addresses and CIDR networks, invalid entries are skipped
+func ParseNetworks(entries []string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		if n, err := ParseNetwork(entry); err == nil {
+			networks = append(networks, n)
+		}
+	}
+	return networks
+}
+
+// ParseNetwork parses an IP address or a CIDR network, an IP address is a network of its own
+func ParseNetwork(entry string) (*net.IPNet, error) {
+	entry = strings.TrimSpace(entry)
+	if !strings.Contains(entry, "/") {
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, &net.ParseError{Type: "IP address", Text: entry}
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	_, n, err := net.ParseCIDR(entry)
+	return n, err
+}
+
+// forwardedIP returns the client IP of a request sent by a trusted proxy or an empty string
+func forwardedIP(r *http.Request, trusted []*net.IPNet) string {
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+	if !contains(trusted, net.ParseIP(peer)) {
+		return ""
+	}
+
+	// every proxy appends the address of its peer, the client is the last hop which isn't a trusted proxy
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return ""
+			}
+			if i == 0 || !contains(trusted, ip) {
+				return ip.String()
+			}
+		}
+	}
+
+	for _, h := range []string{"X-Real-IP", "True-Client-IP"} {
+		if ip := net.ParseIP(strings.TrimSpace(r.Header.Get(h))); ip != nil {
+			return ip.String()
+		}
+	}
+	return ""
+}
+
+func contains(networks []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range networks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+// Package ratelimit limits the rate of requests with token buckets.
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	microstore "go-micro.dev/v4/store"
+)
+
+const (
+	// KeyUser limits the requests per user
+	KeyUser = "user"
+	// KeyIP limits the requests per client IP
+	KeyIP = "ip"
+)
+
+// Limit is a parsed rate limit of a route.
+type Limit struct {
+	// Name identifies the buckets of the limit
+	Name string
+	// Rate is the number of tokens refilled per second
+	Rate float64
+	// Burst is the capacity of a bucket
+	Burst float64
+	// Key is either KeyUser or KeyIP
+	Key string
+}
+
+// NewLimit parses the rate limit configuration
+func NewLimit(name string, cfg config.RateLimit) (Limit, error) {
+	period, err := time.ParseDuration(cfg.Period)
+	if err != nil {
+		return Limit{}, fmt.Errorf("invalid rate limit period %q: %w", cfg.Period, err)
+	}
+	if cfg.Requests <= 0 || period <= 0 {
+		return Limit{}, errors.New("the requests and the period of a rate limit must be positive")
+	}
+	if cfg.Burst < 0 {
+		return Limit{}, errors.New("the burst of a rate limit must not be negative")
+	}
+
+	l := Limit{
+		Name:  name,
+		Rate:  float64(cfg.Requests) / period.Seconds(),
+		Burst: float64(cfg.Burst),
+		Key:   cfg.Key,
+	}
+	if l.Burst == 0 {
+		l.Burst = float64(cfg.Requests)
+	}
+	switch l.Key {
+	case "":
+		l.Key = KeyUser
+	case KeyUser, KeyIP:
+	default:
+		return Limit{}, fmt.Errorf("invalid rate limit key %q, supported values are '%s' and '%s'", cfg.Key, KeyUser, KeyIP)
+	}
+	return l, nil
+}
+
+// RefillTime returns the time it takes to refill an empty bucket
+func (l Limit) RefillTime() time.Duration {
+	return time.Duration(l.Burst / l.Rate * float64(time.Second))
+}
+
+// bucket is the state of a token bucket as it is kept in the store
+type bucket struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// Limiter keeps the token buckets in a store. When using a shared store the limits apply across all proxy instances.
+// Updates of the same bucket are only serialized within an instance, concurrent requests to different instances
+// may let a few more requests pass than configured.
+type Limiter struct {
+	store microstore.Store
+	locks [256]sync.Mutex
+	now   func() time.Time
+}
+
+// NewLimiter creates a new Limiter using the store
+func NewLimiter(store microstore.Store) *Limiter {
+	return &Limiter{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Allow takes a token from the bucket of the subject. If the bucket is empty the request is not allowed
+// and the time until the next token is available is returned.
+func (l *Limiter) Allow(limit Limit, subject string) (bool, time.Duration, error) {
+	key := limit.Name + "/" + subject
+
+	lock := l.lock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := l.now()
+	b := bucket{Tokens: limit.Burst, Updated: now}
+	records, err := l.store.Read(key)
+	switch {
+	case err == nil && len(records) > 0:
+		if err := json.Unmarshal(records[0].Value, &b); err != nil {
+			return false, 0, err
+		}
+		b.Tokens = math.Min(limit.Burst, b.Tokens+now.Sub(b.Updated).Seconds()*limit.Rate)
+		b.Updated = now
+	case err != nil && !errors.Is(err, microstore.ErrNotFound):
+		return false, 0, err
+	}
+
+	if b.Tokens < 1 {
+		return false, time.Duration((1 - b.Tokens) / limit.Rate * float64(time.Second)), nil
+	}
+
+	b.Tokens--
+	value, err := json.Marshal(b)
+	if err != nil {
+		return false, 0, err
+	}
+	return true, 0, l.store.Write(&microstore.Record{
+		Key:   key,
+		Value: value,
+		// the bucket is full again after this time, so it doesn't need to be kept any longer
+		Expiry: time.Duration((limit.Burst - b.Tokens) / limit.Rate * float64(time.Second)),
+	})
+}
+
+func (l *Limiter) lock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &l.locks[h.Sum32()%uint32(len(l.locks))]
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
+	microstore "go-micro.dev/v4/store"
+)
+
+func TestNewLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.RateLimit
+		want    Limit
+		wantErr bool
+	}{
+		{name: "defaults", cfg: config.RateLimit{Requests: 60, Period: "1m"}, want: Limit{Name: "route", Rate: 1, Burst: 60, Key: KeyUser}},
+		{name: "burst and key", cfg: config.RateLimit{Requests: 10, Period: "1s", Burst: 5, Key: KeyIP}, want: Limit{Name: "route", Rate: 10, Burst: 5, Key: KeyIP}},
+		{name: "invalid period", cfg: config.RateLimit{Requests: 10, Period: "often"}, wantErr: true},
+		{name: "no requests", cfg: config.RateLimit{Period: "1m"}, wantErr: true},
+		{name: "negative burst", cfg: config.RateLimit{Requests: 10, Period: "1m", Burst: -1}, wantErr: true},
+		{name: "invalid key", cfg: config.RateLimit{Requests: 10, Period: "1m", Key: "header"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLimit("route", tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(microstore.NewMemoryStore())
+	l.now = func() time.Time { return now }
+
+	limit, err := NewLimit("route", config.RateLimit{Requests: 1, Period: "10s", Burst: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if allowed, _, err := l.Allow(limit, "alice"); !allowed || err != nil {
+			t.Fatalf("request %d was not allowed: %v", i, err)
+		}
+	}
+
+	allowed, retryAfter, err := l.Allow(limit, "alice")
+	if allowed || err != nil {
+		t.Fatalf("request exceeding the burst was allowed: %v", err)
+	}
+	if retryAfter != 10*time.Second {
+		t.Errorf("retry after is %s, want 10s", retryAfter)
+	}
+
+	// other subjects have their own bucket
+	if allowed, _, _ := l.Allow(limit, "bob"); !allowed {
+		t.Error("request of another subject was not allowed")
+	}
+
+	now = now.Add(5 * time.Second)
+	allowed, retryAfter, _ = l.Allow(limit, "alice")
+	if allowed || retryAfter != 5*time.Second {
+		t.Errorf("request before the refill was allowed or retry after %s is not 5s", retryAfter)
+	}
+
+	now = now.Add(5 * time.Second)
+	if allowed, _, _ := l.Allow(limit, "alice"); !allowed {
+		t.Error("request after the refill was not allowed")
+	}
+	if allowed, _, _ := l.Allow(limit, "alice"); allowed {
+		t.Error("only one token should have been refilled")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/proxy/policy"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/ratelimit"
 	"go-micro.dev/v4/selector"
 )
 
@@ -89,6 +90,7 @@ type RoutingInfo struct {
 	rewrite     func(*httputil.ProxyRequest)
 	endpoint    string
 	unprotected bool
+	rateLimit   *ratelimit.Limit
 }
 
 // Rewrite returns the proxy rewrite hook.
@@ -101,6 +103,11 @@ func (r RoutingInfo) IsRouteUnprotected() bool {
 	return r.unprotected
 }
 
+// RateLimit returns the rate limit of the route or nil if the route is not limited.
+func (r RoutingInfo) RateLimit() *ratelimit.Limit {
+	return r.rateLimit
+}
+
 // Router handles the routing of HTTP requests according to the given policies.
 type Router struct {
 	logger          log.Logger
@@ -125,9 +132,25 @@ func (rt Router) addHost(policy string, target *url.URL, route config.Route) {
 		rt.rewriters[policy][routeType][route.Method] = make([]RoutingInfo, 0)
 	}
 
+	var rateLimit *ratelimit.Limit
+	if route.RateLimit != nil {
+		// the buckets are separated by policy and route
+		limit, err := ratelimit.NewLimit(strings.Join([]string{policy, string(routeType), route.Method, route.Endpoint}, " "), *route.RateLimit)
+		if err != nil {
+			rt.logger.
+				Fatal(). // fail early on misconfiguration
+				Err(err).
+				Str("policy", policy).
+				Str("endpoint", route.Endpoint).
+				Msg("invalid rate limit")
+		}
+		rateLimit = &limit
+	}
+
 	rt.rewriters[policy][routeType][route.Method] = append(rt.rewriters[policy][routeType][route.Method], RoutingInfo{
 		endpoint:    route.Endpoint,
 		unprotected: route.Unprotected,
+		rateLimit:   rateLimit,
 		rewrite: func(req *httputil.ProxyRequest) {
 			if route.Service != "" {
 				// select next node
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"crypto/x509"
 	"fmt"
 	"os"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/service/http"
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/clientcert"
 	"go-micro.dev/v4"
 )
 
@@ -32,6 +34,15 @@ func Server(opts ...Option) (http.Service, error) {
 	}
 	chain := options.Middlewares.Then(options.Handler)
 
+	var clientCAs *x509.CertPool
+	if options.Config.ClientCertificateAuth.Enabled {
+		var err error
+		clientCAs, err = clientcert.LoadCertPool(options.Config.ClientCertificateAuth.CACert)
+		if err != nil {
+			return http.Service{}, err
+		}
+	}
+
 	service, err := http.NewService(
 		http.Name(options.Config.Service.Name),
 		http.Version(version.GetString()),
@@ -40,6 +51,7 @@ func Server(opts ...Option) (http.Service, error) {
 			Cert:    options.Config.HTTP.TLSCert,
 			Key:     options.Config.HTTP.TLSKey,
 		}),
+		http.ClientCAs(clientCAs),
 		http.Logger(options.Logger),
 		http.Address(options.Config.HTTP.Addr),
 		http.Namespace(options.Config.HTTP.Namespace),
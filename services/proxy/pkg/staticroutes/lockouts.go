@@ -0,0 +1,101 @@
+package staticroutes
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/owncloud/ocis/v2/ocis-pkg/roles"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
+	settings "github.com/owncloud/ocis/v2/services/settings/pkg/service/v0"
+)
+
+// listLockouts lists the usernames, client IPs and public links which are currently locked out
+func (s *StaticRouteHandler) listLockouts(w http.ResponseWriter, r *http.Request) {
+	logger := s.Logger.SubloggerWithRequestID(r.Context())
+	lockouts, err := s.BruteForceGuard.Lockouts()
+	if err != nil {
+		logger.Error().Err(err).Msg("could not list the lockouts")
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, jse{Error: "server_error", ErrorDescription: "could not list the lockouts"})
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, lockouts)
+}
+
+// clearLockout lifts the lockout of a username, client IP or public link
+func (s *StaticRouteHandler) clearLockout(w http.ResponseWriter, r *http.Request) {
+	logger := s.Logger.SubloggerWithRequestID(r.Context())
+	param, err := url.PathUnescape(chi.URLParam(r, "subject"))
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, jse{Error: "invalid_request", ErrorDescription: err.Error()})
+		return
+	}
+	subject, err := bruteforce.ParseSubject(param)
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, jse{Error: "invalid_request", ErrorDescription: err.Error()})
+		return
+	}
+
+	u, _ := revactx.ContextGetUser(r.Context())
+	switch err := s.BruteForceGuard.Clear(r.Context(), subject, u.GetId()); {
+	case errors.Is(err, bruteforce.ErrNotLockedOut):
+		render.Status(r, http.StatusNotFound)
+		render.JSON(w, r, jse{Error: "not_found", ErrorDescription: err.Error()})
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("subject", subject.String()).Msg("could not clear the lockout")
+		render.Status(r, http.StatusInternalServerError)
+		render.JSON(w, r, jse{Error: "server_error", ErrorDescription: "could not clear the lockout"})
+		return
+	}
+
+	logger.Info().Str("subject", subject.String()).Str("executant", u.GetId().GetOpaqueId()).Msg("cleared lockout")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requireAdmin only allows requests of users having the account management permission
+func (s *StaticRouteHandler) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.Logger.SubloggerWithRequestID(r.Context())
+		if s.BruteForceGuard == nil {
+			// the brute force protection is disabled
+			render.Status(r, http.StatusNotFound)
+			render.JSON(w, r, jse{Error: "not_found", ErrorDescription: "brute force protection is disabled"})
+			return
+		}
+
+		u, ok := revactx.ContextGetUser(r.Context())
+		if !ok || u.GetId().GetOpaqueId() == "" {
+			render.Status(r, http.StatusUnauthorized)
+			render.JSON(w, r, jse{Error: "access_denied", ErrorDescription: "unauthorized"})
+			return
+		}
+
+		roleIDs, ok := roles.ReadRoleIDsFromContext(r.Context())
+		if !ok {
+			var err error
+			roleIDs, err = s.RoleManager.FindRoleIDsForUser(r.Context(), u.GetId().GetOpaqueId())
+			if err != nil {
+				logger.Error().Err(err).Str("userid", u.GetId().GetOpaqueId()).Msg("failed to get roles for user")
+				render.Status(r, http.StatusInternalServerError)
+				render.JSON(w, r, jse{Error: "server_error", ErrorDescription: "could not get the roles of the user"})
+				return
+			}
+		}
+
+		if s.RoleManager.FindPermissionByID(r.Context(), roleIDs, settings.AccountManagementPermissionID) == nil {
+			render.Status(r, http.StatusForbidden)
+			render.JSON(w, r, jse{Error: "access_denied", ErrorDescription: "forbidden"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/oidc"
+	"github.com/owncloud/ocis/v2/ocis-pkg/roles"
+	"github.com/owncloud/ocis/v2/services/proxy/pkg/bruteforce"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/config"
 	"github.com/owncloud/ocis/v2/services/proxy/pkg/user/backend"
 	microstore "go-micro.dev/v4/store"
@@ -23,6 +25,8 @@ type StaticRouteHandler struct {
 	OidcHttpClient  *http.Client
 	EventsPublisher events.Publisher
 	UserProvider    backend.UserBackend
+	BruteForceGuard *bruteforce.Guard
+	RoleManager     *roles.Manager
 }
 
 type jse struct {
@@ -37,6 +41,17 @@ func (s *StaticRouteHandler) Handler() http.Handler {
 		// Wrapper for backchannel logout
 		r.Post("/backchannel_logout", s.backchannelLogout)
 
+		// administration of the brute force protection lockouts
+		r.Route("/proxy", func(r chi.Router) {
+			// the proxy route points to the proxy itself, never forward these requests
+			r.NotFound(http.NotFound)
+			r.MethodNotAllowed(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			})
+			r.With(s.requireAdmin).Get("/lockouts", s.listLockouts)
+			r.With(s.requireAdmin).Delete("/lockouts/{subject}", s.clearLockout)
+		})
+
 		// openid .well-known
 		if s.Config.OIDC.RewriteWellKnown {
 			r.Get("/.well-known/openid-configuration", s.oIDCWellKnownRewrite(s.Config.OIDC.Issuer))
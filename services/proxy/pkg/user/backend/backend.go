@@ -14,6 +14,8 @@ var (
 	ErrAccountDisabled = errors.New("account disabled")
 	// ErrNotSupported operation not supported by user-backend
 	ErrNotSupported = errors.New("operation not supported")
+	// ErrInvalidCredentials username or password wrong
+	ErrInvalidCredentials = errors.New("invalid credentials")
 )
 
 // UserBackend allows the proxy to retrieve users from different user-backends (accounts-service, CS3)
@@ -150,6 +150,8 @@ func (c *cs3backend) Authenticate(ctx context.Context, username string, password
 	switch {
 	case err != nil:
 		return nil, "", fmt.Errorf("could not authenticate with username and password user: %s, %w", username, err)
+	case res.Status.Code == rpcv1beta1.Code_CODE_UNAUTHENTICATED, res.Status.Code == rpcv1beta1.Code_CODE_PERMISSION_DENIED, res.Status.Code == rpcv1beta1.Code_CODE_NOT_FOUND:
+		return nil, "", fmt.Errorf("could not authenticate with username and password user: %s, got code: %d: %w", username, res.GetStatus().GetCode(), ErrInvalidCredentials)
 	case res.Status.Code != rpcv1beta1.Code_CODE_OK:
 		return nil, "", fmt.Errorf("could not authenticate with username and password user: %s, got code: %d", username, res.GetStatus().GetCode())
 	}
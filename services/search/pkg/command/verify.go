@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	tw "github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"go-micro.dev/v4/client"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
+	"github.com/owncloud/ocis/v2/ocis-pkg/tracing"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config/parser"
+)
+
+// Verify is the entrypoint for the verify command.
+func Verify(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:     "verify",
+		Usage:    "compare the index with the files of one or more spaces",
+		Category: "index management",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "space",
+				Aliases: []string{"s"},
+				Usage:   "the id of the space to verify the index of. This or --all-spaces is required.",
+			},
+			&cli.BoolFlag{
+				Name:  "all-spaces",
+				Usage: "verify all spaces instead. This or --space is required.",
+			},
+			&cli.BoolFlag{
+				Name:  "fix",
+				Usage: "reindex the missing and stale files and remove the orphaned ones from the index.",
+			},
+		},
+		Before: func(_ *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(ctx *cli.Context) error {
+			if ctx.String("space") == "" && !ctx.Bool("all-spaces") {
+				return errors.New("either --space or --all-spaces is required")
+			}
+
+			traceProvider, err := tracing.GetServiceTraceProvider(cfg.Tracing, cfg.Service.Name)
+			if err != nil {
+				return err
+			}
+			grpcClient, err := grpc.NewClient(
+				append(grpc.GetClientOptions(cfg.GRPCClientTLS),
+					grpc.WithTraceProvider(traceProvider),
+				)...,
+			)
+			if err != nil {
+				return err
+			}
+
+			c := searchsvc.NewSearchProviderService("com.owncloud.api.search", grpcClient)
+			res, err := c.VerifySpace(context.Background(), &searchsvc.VerifySpaceRequest{
+				SpaceId: ctx.String("space"),
+				Fix:     ctx.Bool("fix"),
+			}, func(opts *client.CallOptions) { opts.RequestTimeout = 10 * time.Minute })
+			if err != nil {
+				fmt.Println("failed to verify space: " + err.Error())
+				return err
+			}
+
+			if len(res.GetDiscrepancies()) > 0 {
+				table := tw.NewWriter(os.Stdout)
+				table.SetHeader([]string{"Kind", "Space", "Resource Id", "Path", "Reason", "Fixed"})
+				table.SetAutoFormatHeaders(false)
+				for _, d := range res.GetDiscrepancies() {
+					table.Append([]string{d.GetKind(), d.GetSpaceId(), d.GetResourceId(), d.GetPath(), d.GetReason(), strconv.FormatBool(d.GetFixed())})
+				}
+				table.Render()
+			}
+
+			fmt.Printf("checked %d resources, found %d discrepancies\n", res.GetChecked(), len(res.GetDiscrepancies()))
+			return nil
+		},
+	}
+}
@@ -27,7 +27,10 @@ type Config struct {
 	Events                     Events                `yaml:"events"`
 	Engine                     Engine                `yaml:"engine"`
 	Extractor                  Extractor             `yaml:"extractor"`
+	Embedding                  Embedding             `yaml:"embedding"`
+	Store                      Store                 `yaml:"store"`
 	ContentExtractionSizeLimit uint64                `yaml:"content_extraction_size_limit" env:"SEARCH_CONTENT_EXTRACTION_SIZE_LIMIT" desc:"Maximum file size in bytes that is allowed for content extraction." introductionVersion:"pre5.0"`
+	CustomProperties           []CustomProperty      `yaml:"custom_properties"`
 
 	ServiceAccount ServiceAccount `yaml:"service_account"`
 
@@ -38,6 +38,9 @@ func DefaultConfig() *config.Config {
 			Bleve: config.EngineBleve{
 				Datapath: filepath.Join(defaults.BaseDataPath(), "search"),
 			},
+			OpenSearch: config.EngineOpenSearch{
+				Index: "ocis-resources",
+			},
 		},
 		Extractor: config.Extractor{
 			Type:             "basic",
@@ -46,6 +49,23 @@ func DefaultConfig() *config.Config {
 				TikaURL:        "http://127.0.0.1:9998",
 				CleanStopWords: true,
 			},
+			Native: config.ExtractorNative{
+				CleanStopWords: true,
+			},
+		},
+		Embedding: config.Embedding{
+			Type:     "none",
+			Datapath: filepath.Join(defaults.BaseDataPath(), "search"),
+			Weight:   0.5,
+			Local: config.EmbeddingLocal{
+				Dimensions: 256,
+			},
+		},
+		Store: config.Store{
+			Store:    "nats-js-kv",
+			Nodes:    []string{"127.0.0.1:9233"},
+			Database: "search",
+			Table:    "",
 		},
 		Events: config.Events{
 			Endpoint:         "127.0.0.1:9233",
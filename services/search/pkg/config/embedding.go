@@ -0,0 +1,14 @@
+package config
+
+// Embedding defines which embedding provider to use for the semantic search
+type Embedding struct {
+	Type     string         `yaml:"type" env:"SEARCH_EMBEDDING_TYPE" desc:"Defines the provider which computes the vectors of the resources for the semantic search. Defaults to 'none' which disables the semantic search. Supported values are: 'none' and 'local'." introductionVersion:"7.0.0"`
+	Datapath string         `yaml:"data_path" env:"SEARCH_EMBEDDING_DATA_PATH" desc:"The directory where the vectors of the resources are stored. If not defined, the root directory derives from $OCIS_BASE_DATA_PATH/search." introductionVersion:"7.0.0"`
+	Weight   float64        `yaml:"weight" env:"SEARCH_EMBEDDING_WEIGHT" desc:"The weight of the semantic similarity when it is merged with the keyword score of a free text semantic query. Must be between 0 and 1." introductionVersion:"7.0.0"`
+	Local    EmbeddingLocal `yaml:"local"`
+}
+
+// EmbeddingLocal configures the local embedding provider
+type EmbeddingLocal struct {
+	Dimensions int `yaml:"dimensions" env:"SEARCH_EMBEDDING_LOCAL_DIMENSIONS" desc:"The number of dimensions of the vectors. Changing it requires a reindex of all spaces." introductionVersion:"7.0.0"`
+}
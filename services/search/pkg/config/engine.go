@@ -2,11 +2,21 @@ package config
 
 // Engine defines which search engine to use
 type Engine struct {
-	Type  string      `yaml:"type" env:"SEARCH_ENGINE_TYPE" desc:"Defines which search engine to use. Defaults to 'bleve'. Supported values are: 'bleve'." introductionVersion:"pre5.0"`
-	Bleve EngineBleve `yaml:"bleve"`
+	Type       string           `yaml:"type" env:"SEARCH_ENGINE_TYPE" desc:"Defines which search engine to use. Defaults to 'bleve'. Supported values are: 'bleve' and 'opensearch'." introductionVersion:"pre5.0"`
+	Bleve      EngineBleve      `yaml:"bleve"`
+	OpenSearch EngineOpenSearch `yaml:"open_search"`
 }
 
 // EngineBleve configures the bleve engine
 type EngineBleve struct {
 	Datapath string `yaml:"data_path" env:"SEARCH_ENGINE_BLEVE_DATA_PATH" desc:"The directory where the filesystem will store search data. If not defined, the root directory derives from $OCIS_BASE_DATA_PATH/search." introductionVersion:"pre5.0"`
 }
+
+// EngineOpenSearch configures the OpenSearch engine
+type EngineOpenSearch struct {
+	Address  string `yaml:"address" env:"SEARCH_ENGINE_OPEN_SEARCH_ADDRESS" desc:"The URL of the OpenSearch or Elasticsearch compatible server like 'https://opensearch:9200'. Required when SEARCH_ENGINE_TYPE is set to 'opensearch'." introductionVersion:"7.0.0"`
+	Index    string `yaml:"index" env:"SEARCH_ENGINE_OPEN_SEARCH_INDEX" desc:"The name of the index. The index is created with the required mapping if it does not exist." introductionVersion:"7.0.0"`
+	Username string `yaml:"username" env:"SEARCH_ENGINE_OPEN_SEARCH_USERNAME" desc:"The username for the basic authentication with the server." introductionVersion:"7.0.0"`
+	Password string `yaml:"password" env:"SEARCH_ENGINE_OPEN_SEARCH_PASSWORD" desc:"The password for the basic authentication with the server." introductionVersion:"7.0.0"`
+	Insecure bool   `yaml:"insecure" env:"OCIS_INSECURE;SEARCH_ENGINE_OPEN_SEARCH_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the server." introductionVersion:"7.0.0"`
+}
@@ -2,6 +2,9 @@ package parser
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
@@ -11,6 +14,14 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/envdecode"
 )
 
+// propertyNameRegex matches the names which can be used as KQL property restriction
+var propertyNameRegex = regexp.MustCompile(`^[A-Za-z]+$`)
+
+// reservedPropertyNames are the KQL keys of the built-in fields and can't be used for custom properties
+var reservedPropertyNames = []string{
+	"rootid", "path", "id", "name", "size", "mtime", "mediatype", "type", "tag", "tags", "content", "hidden", "scope", "similar",
+}
+
 // ParseConfig loads configuration from known paths.
 func ParseConfig(cfg *config.Config) error {
 	err := ociscfg.BindSourcesToStructs(cfg.Service.Name, cfg)
@@ -45,5 +56,41 @@ func Validate(cfg *config.Config) error {
 		return shared.MissingServiceAccountSecret(cfg.Service.Name)
 	}
 
+	if cfg.Engine.Type == "opensearch" && cfg.Engine.OpenSearch.Address == "" {
+		return errors.New("the search engine 'opensearch' needs an address, set SEARCH_ENGINE_OPEN_SEARCH_ADDRESS")
+	}
+
+	if cfg.Embedding.Weight < 0 || cfg.Embedding.Weight > 1 {
+		return errors.New("the embedding weight must be between 0 and 1, check SEARCH_EMBEDDING_WEIGHT")
+	}
+	if cfg.Embedding.Type == "local" && cfg.Embedding.Local.Dimensions <= 0 {
+		return errors.New("the local embedding provider needs a positive number of dimensions, set SEARCH_EMBEDDING_LOCAL_DIMENSIONS")
+	}
+
+	return validateCustomProperties(cfg.CustomProperties)
+}
+
+func validateCustomProperties(properties []config.CustomProperty) error {
+	names := map[string]bool{}
+	for _, name := range reservedPropertyNames {
+		names[name] = true
+	}
+
+	for _, p := range properties {
+		if !propertyNameRegex.MatchString(p.Name) {
+			return fmt.Errorf("invalid custom property name '%s', only letters are allowed", p.Name)
+		}
+		if names[strings.ToLower(p.Name)] {
+			return fmt.Errorf("custom property name '%s' is already in use", p.Name)
+		}
+		names[strings.ToLower(p.Name)] = true
+
+		switch p.Type {
+		case config.PropertyTypeString, config.PropertyTypeKeyword, config.PropertyTypeNumber, config.PropertyTypeDate:
+		default:
+			return fmt.Errorf("unsupported type '%s' of custom property '%s'", p.Type, p.Name)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,32 @@
+package config
+
+// The supported types of custom properties
+const (
+	// PropertyTypeString is a text which is searched word by word like the content
+	PropertyTypeString = "string"
+	// PropertyTypeKeyword is a text which is matched as a whole, ignoring the case
+	PropertyTypeKeyword = "keyword"
+	// PropertyTypeNumber is a number which can be compared like the size
+	PropertyTypeNumber = "number"
+	// PropertyTypeDate is a date or timestamp which can be compared like the mtime
+	PropertyTypeDate = "date"
+)
+
+// CustomProperty defines an additional property which is indexed from the arbitrary metadata of a resource
+// and can be searched with a KQL property restriction like 'name:value'.
+type CustomProperty struct {
+	// Name is the KQL key of the property, it may only contain letters
+	Name string `yaml:"name"`
+	// MetadataKey is the arbitrary metadata key which holds the value, it defaults to the name
+	MetadataKey string `yaml:"metadata_key"`
+	// Type is one of 'string', 'keyword', 'number' or 'date'
+	Type string `yaml:"type"`
+}
+
+// Key returns the arbitrary metadata key of the property
+func (p CustomProperty) Key() string {
+	if p.MetadataKey != "" {
+		return p.MetadataKey
+	}
+	return p.Name
+}
@@ -2,22 +2,30 @@ package content
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"github.com/cs3org/reva/v2/pkg/tags"
 	"github.com/cs3org/reva/v2/pkg/utils"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 )
 
+// propertyDateLayouts are the accepted formats of date properties
+var propertyDateLayouts = []string{time.RFC3339Nano, "2006-01-02"}
+
 // Basic is the simplest Extractor implementation.
 type Basic struct {
-	logger log.Logger
+	logger     log.Logger
+	properties []config.CustomProperty
 }
 
-// NewBasicExtractor creates a new Basic instance.
-func NewBasicExtractor(logger log.Logger) (*Basic, error) {
-	return &Basic{logger: logger}, nil
+// NewBasicExtractor creates a new Basic instance,
+// the given custom properties are read from the arbitrary metadata.
+func NewBasicExtractor(logger log.Logger, properties ...config.CustomProperty) (*Basic, error) {
+	return &Basic{logger: logger, properties: properties}, nil
 }
 
 // Extract literally just rearranges the inputs and processes them into a Document.
@@ -32,6 +40,8 @@ func (b Basic) Extract(_ context.Context, ri *storageProvider.ResourceInfo) (Doc
 		if t, ok := m["tags"]; ok {
 			doc.Tags = tags.New(t).AsSlice()
 		}
+
+		doc.Properties = b.extractProperties(m)
 	}
 
 	if ri.Mtime != nil {
@@ -40,3 +50,53 @@ func (b Basic) Extract(_ context.Context, ri *storageProvider.ResourceInfo) (Doc
 
 	return doc, nil
 }
+
+// extractProperties converts the metadata values of the custom properties to their type,
+// values which can't be converted are skipped
+func (b Basic) extractProperties(m map[string]string) map[string]interface{} {
+	var properties map[string]interface{}
+	for _, p := range b.properties {
+		v, ok := m[p.Key()]
+		if !ok || strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		var value interface{}
+		switch p.Type {
+		case config.PropertyTypeNumber:
+			n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				b.logger.Debug().Err(err).Str("property", p.Name).Msg("invalid number, skipping the property")
+				continue
+			}
+			value = n
+		case config.PropertyTypeDate:
+			t, err := parsePropertyDate(strings.TrimSpace(v))
+			if err != nil {
+				b.logger.Debug().Err(err).Str("property", p.Name).Msg("invalid date, skipping the property")
+				continue
+			}
+			value = t
+		default:
+			value = v
+		}
+
+		if properties == nil {
+			properties = map[string]interface{}{}
+		}
+		properties[p.Name] = value
+	}
+
+	return properties
+}
+
+func parsePropertyDate(v string) (time.Time, error) {
+	var err error
+	for _, layout := range propertyDateLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, v); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, err
+}
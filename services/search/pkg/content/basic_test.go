@@ -2,12 +2,14 @@ package content_test
 
 import (
 	"context"
+	"time"
 
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	cs3Types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
 )
 
@@ -65,6 +67,34 @@ var _ = Describe("Basic", func() {
 			}
 		})
 
+		It("adds custom properties", func() {
+			basic, _ = content.NewBasicExtractor(logger,
+				config.CustomProperty{Name: "project", MetadataKey: "oc:project", Type: config.PropertyTypeKeyword},
+				config.CustomProperty{Name: "retention", Type: config.PropertyTypeNumber},
+				config.CustomProperty{Name: "reviewed", Type: config.PropertyTypeDate},
+				config.CustomProperty{Name: "invalid", Type: config.PropertyTypeNumber},
+				config.CustomProperty{Name: "missing", Type: config.PropertyTypeString},
+			)
+			ri := &storageProvider.ResourceInfo{
+				ArbitraryMetadata: &storageProvider.ArbitraryMetadata{
+					Metadata: map[string]string{
+						"oc:project": "Apollo",
+						"retention":  " 10.5",
+						"reviewed":   "2023-07-20",
+						"invalid":    "ten",
+					},
+				},
+			}
+
+			doc, err := basic.Extract(ctx, ri)
+			Expect(err).To(BeNil())
+			Expect(doc.Properties).To(Equal(map[string]interface{}{
+				"project":   "Apollo",
+				"retention": 10.5,
+				"reviewed":  time.Date(2023, 7, 20, 0, 0, 0, 0, time.UTC),
+			}))
+		})
+
 		It("RFC3339 mtime", func() {
 			for _, data := range []struct {
 				second uint64
@@ -25,6 +25,9 @@ type Document struct {
 	Image    *libregraph.Image          `json:"image,omitempty"`
 	Location *libregraph.GeoCoordinates `json:"location,omitempty"`
 	Photo    *libregraph.Photo          `json:"photo,omitempty"`
+	// Properties holds the values of the configured custom properties,
+	// numbers are float64 and dates time.Time values
+	Properties map[string]interface{} `json:"properties,omitempty"`
 }
 
 func CleanString(content, langCode string) string {
@@ -0,0 +1,265 @@
+package content
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"golang.org/x/net/html"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+)
+
+// nativeParser returns the title and the text of a document
+type nativeParser func(data []byte, limit uint64) (title string, text string, err error)
+
+// nativeParsers maps the supported mime types to their parser
+var nativeParsers = map[string]nativeParser{
+	"text/plain":            parsePlainText,
+	"text/csv":              parsePlainText,
+	"text/markdown":         parseMarkdown,
+	"text/x-markdown":       parseMarkdown,
+	"text/html":             parseHTML,
+	"application/xhtml+xml": parseHTML,
+	"application/pdf":       parsePDF,
+
+	"application/vnd.oasis.opendocument.text":                                   parseODF,
+	"application/vnd.oasis.opendocument.spreadsheet":                            parseODF,
+	"application/vnd.oasis.opendocument.presentation":                           parseODF,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   parseDOCX,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         parseXLSX,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": parsePPTX,
+}
+
+// nativeExtensions is used if the mime type of a resource is unknown
+var nativeExtensions = map[string]string{
+	".txt":  "text/plain",
+	".csv":  "text/csv",
+	".md":   "text/markdown",
+	".htm":  "text/html",
+	".html": "text/html",
+	".pdf":  "application/pdf",
+	".odt":  "application/vnd.oasis.opendocument.text",
+	".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	".odp":  "application/vnd.oasis.opendocument.presentation",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+// Native is used to extract content from a resource without an external service,
+// it supports plain text, markdown, html, odf and ooxml documents and simple pdf files.
+type Native struct {
+	*Basic
+	Retriever
+	ContentExtractionSizeLimit uint64
+	CleanStopWords             bool
+}
+
+// NewNativeExtractor creates a new Native instance.
+func NewNativeExtractor(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], logger log.Logger, cfg *config.Config) (*Native, error) {
+	basic, err := NewBasicExtractor(logger, cfg.CustomProperties...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Native{
+		Basic:                      basic,
+		Retriever:                  newCS3Retriever(gatewaySelector, logger, cfg.Extractor.CS3AllowInsecure),
+		ContentExtractionSizeLimit: cfg.ContentExtractionSizeLimit,
+		CleanStopWords:             cfg.Extractor.Native.CleanStopWords,
+	}, nil
+}
+
+// Extract loads a resource from its underlying storage, parses it by its mime type and processes the result into a Document.
+func (n Native) Extract(ctx context.Context, ri *provider.ResourceInfo) (Document, error) {
+	doc, err := n.Basic.Extract(ctx, ri)
+	if err != nil {
+		return doc, err
+	}
+
+	if ri.Size == 0 {
+		return doc, nil
+	}
+
+	if ri.Size > n.ContentExtractionSizeLimit {
+		n.logger.Info().Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("file exceeds content extraction size limit. skipping.")
+		return doc, nil
+	}
+
+	if ri.Type != provider.ResourceType_RESOURCE_TYPE_FILE {
+		return doc, nil
+	}
+
+	parse := nativeParserFor(ri)
+	if parse == nil {
+		return doc, nil
+	}
+
+	data, err := n.Retrieve(ctx, ri.Id)
+	if err != nil {
+		return doc, err
+	}
+	defer data.Close()
+
+	// the size of the resource might be outdated, never read more than allowed
+	b, err := io.ReadAll(io.LimitReader(data, int64(n.ContentExtractionSizeLimit)))
+	if err != nil {
+		return doc, err
+	}
+
+	title, text, err := parse(b, n.ContentExtractionSizeLimit)
+	if err != nil {
+		n.logger.Debug().Err(err).Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("failed to parse the content. skipping.")
+		return doc, nil
+	}
+
+	doc.Title = normalizeSpace(title)
+	doc.Content = normalizeSpace(text)
+
+	if langCode := detectLanguage(doc.Content); langCode != "" && n.CleanStopWords {
+		doc.Content = CleanString(doc.Content, langCode)
+	}
+
+	return doc, nil
+}
+
+// nativeParserFor returns the parser for the mime type of the resource, or nil if it is not supported
+func nativeParserFor(ri *provider.ResourceInfo) nativeParser {
+	mimeType, _, _ := strings.Cut(ri.MimeType, ";")
+	if parse, ok := nativeParsers[strings.TrimSpace(mimeType)]; ok {
+		return parse
+	}
+
+	return nativeParsers[nativeExtensions[strings.ToLower(path.Ext(ri.Path))]]
+}
+
+var spaces = regexp.MustCompile(`\s+`)
+
+// normalizeSpace collapses all whitespace to single spaces
+func normalizeSpace(s string) string {
+	return strings.TrimSpace(spaces.ReplaceAllString(s, " "))
+}
+
+func parsePlainText(data []byte, _ uint64) (string, string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		return "", strings.ToValidUTF8(string(data), " "), nil
+	}
+
+	return "", string(data), nil
+}
+
+var markdownSyntax = []struct {
+	expr *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile("(?m)^\\s*(```|~~~).*$"), ""},                    // code fences
+	{regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`), "$1"},                // images and links
+	{regexp.MustCompile(`(?m)^\s{0,3}(#{1,6}|>+|[-*+]|\d+[.)])\s+`), ""}, // headings, quotes and lists
+	{regexp.MustCompile(`(?m)^\s*([-*_=]\s*){3,}$`), ""},                 // rules and setext underlines
+	{regexp.MustCompile("[*~`]+"), " "},                                  // emphasis and code spans
+	{regexp.MustCompile(`(^|\s)_+|_+(\s|$)`), " "},                       // emphasis with underscores
+}
+
+func parseMarkdown(data []byte, limit uint64) (string, string, error) {
+	_, text, err := parsePlainText(data, limit)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, s := range markdownSyntax {
+		text = s.expr.ReplaceAllString(text, s.repl)
+	}
+
+	return "", text, nil
+}
+
+// htmlSkipped are the elements whose text is not part of the content
+var htmlSkipped = map[string]bool{"script": true, "style": true, "noscript": true, "template": true, "head": true}
+
+// htmlInline are the elements which do not separate words
+var htmlInline = map[string]bool{
+	"a": true, "abbr": true, "b": true, "bdi": true, "bdo": true, "cite": true, "code": true, "data": true, "dfn": true,
+	"em": true, "i": true, "kbd": true, "mark": true, "q": true, "s": true, "samp": true, "small": true, "span": true,
+	"strong": true, "sub": true, "sup": true, "time": true, "u": true, "var": true,
+}
+
+func parseHTML(data []byte, _ uint64) (string, string, error) {
+	var title, text strings.Builder
+	var inTitle bool
+	skipped := 0
+
+	z := html.NewTokenizer(bytes.NewReader(data))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return "", "", err
+			}
+			return title.String(), text.String(), nil
+		case html.StartTagToken, html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			start := tt == html.StartTagToken
+			switch {
+			case tag == "title":
+				inTitle = start
+			case htmlSkipped[tag] && start:
+				skipped++
+			case htmlSkipped[tag] && skipped > 0:
+				skipped--
+			}
+			if !htmlInline[tag] {
+				text.WriteByte(' ')
+			}
+		case html.SelfClosingTagToken:
+			text.WriteByte(' ')
+		case html.TextToken:
+			switch {
+			case inTitle:
+				title.Write(z.Text())
+			case skipped == 0:
+				text.Write(z.Text())
+			}
+		}
+	}
+}
+
+// stopWordLanguages are the candidates of the language detection, languages without spaces between words are not supported
+var stopWordLanguages = []string{
+	"en", "de", "fr", "es", "it", "nl", "pt", "sv", "da", "no", "fi", "pl", "cs", "sk",
+	"hu", "ro", "tr", "ru", "bg", "el", "lv", "id", "ar", "fa",
+}
+
+// _languageSampleSize is the number of bytes used to detect the language of a text
+const _languageSampleSize = 4096
+
+// detectLanguage guesses the language of the text by the number of its stop words,
+// it returns an empty string if the text contains no stop words of any language
+func detectLanguage(text string) string {
+	sample := text
+	if len(sample) > _languageSampleSize {
+		sample = strings.ToValidUTF8(sample[:_languageSampleSize], "")
+	}
+	words := len(strings.Fields(sample))
+
+	var language string
+	var best int
+	for _, langCode := range stopWordLanguages {
+		if removed := words - len(strings.Fields(CleanString(sample, langCode))); removed > best {
+			language, best = langCode, removed
+		}
+	}
+
+	return language
+}
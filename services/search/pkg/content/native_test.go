@@ -0,0 +1,239 @@
+package content_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	conf "github.com/owncloud/ocis/v2/services/search/pkg/config/defaults"
+	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	contentMocks "github.com/owncloud/ocis/v2/services/search/pkg/content/mocks"
+)
+
+func zipDocument(files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, data := range files {
+		f, err := w.Create(name)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.Write([]byte(data))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(w.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+func pdfDocument(title, content string, deflate bool) []byte {
+	stream := []byte(content)
+	filter := ""
+	if deflate {
+		buf := &bytes.Buffer{}
+		w := zlib.NewWriter(buf)
+		_, err := w.Write(stream)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		stream = buf.Bytes()
+		filter = " /Filter /FlateDecode"
+	}
+
+	return []byte(fmt.Sprintf("%%PDF-1.4\n"+
+		"1 0 obj\n<< /Title (%s) >>\nendobj\n"+
+		"2 0 obj\n<< /Length %d%s >>\nstream\n%s\nendstream\nendobj\n"+
+		"3 0 obj\n<< /Length 4 /Subtype /Image >>\nstream\nBT (image) Tj ET\nendstream\nendobj\n"+
+		"trailer\n<< /Info 1 0 R >>\n%%%%EOF\n", title, len(stream), filter, stream))
+}
+
+var _ = Describe("Native", func() {
+	Describe("extract", func() {
+		var (
+			body      []byte
+			native    *content.Native
+			retriever *contentMocks.Retriever
+			extract   = func(mimeType, path string) (content.Document, error) {
+				return native.Extract(context.TODO(), &provider.ResourceInfo{
+					Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+					Size:     uint64(len(body)),
+					MimeType: mimeType,
+					Path:     path,
+				})
+			}
+		)
+
+		BeforeEach(func() {
+			body = nil
+
+			cfg := conf.DefaultConfig()
+			cfg.Extractor.Native.CleanStopWords = false
+
+			var err error
+			native, err = content.NewNativeExtractor(nil, log.NewLogger(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(native).ToNot(BeNil())
+
+			retriever = &contentMocks.Retriever{}
+			retriever.On("Retrieve", mock.Anything, mock.Anything, mock.Anything).Return(func(context.Context, *provider.ResourceId) io.ReadCloser {
+				return io.NopCloser(bytes.NewReader(body))
+			}, nil)
+
+			native.Retriever = retriever
+		})
+
+		It("skips non file resources", func() {
+			doc, err := native.Extract(context.TODO(), &provider.ResourceInfo{Size: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			retriever.AssertNotCalled(GinkgoT(), "Retrieve", mock.Anything, mock.Anything)
+		})
+
+		It("skips unsupported formats", func() {
+			body = []byte("GIF89a")
+
+			doc, err := extract("image/gif", "./image.gif")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			retriever.AssertNotCalled(GinkgoT(), "Retrieve", mock.Anything, mock.Anything)
+		})
+
+		It("skips files exceeding the size limit", func() {
+			body = []byte("some text")
+			native.ContentExtractionSizeLimit = 4
+
+			doc, err := extract("text/plain", "./file.txt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+			retriever.AssertNotCalled(GinkgoT(), "Retrieve", mock.Anything, mock.Anything)
+		})
+
+		It("adds plain text", func() {
+			body = []byte("some\n\ttext  with\r\nspaces")
+
+			doc, err := extract("text/plain", "./file.txt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("some text with spaces"))
+		})
+
+		It("detects the format by the extension", func() {
+			body = []byte("# Heading")
+
+			doc, err := extract("application/octet-stream", "./README.MD")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("Heading"))
+		})
+
+		It("adds markdown without markup", func() {
+			body = []byte("# The Title\n\nSome **bold** and _italic_ text with a [link](https://example.org) and `code`.\n\n* my_variable\n\n```go\nfmt.Println()\n```\n")
+
+			doc, err := extract("text/markdown", "./file.md")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("The Title Some bold and italic text with a link and code . my_variable fmt.Println()"))
+		})
+
+		It("adds html text and title", func() {
+			body = []byte(`<html><head><title>The &amp; Title</title><style>p {}</style></head>` +
+				`<body><h1>Heading</h1><p>Some <b>bo</b>ld text</p><script>alert()</script><p>more</p></body></html>`)
+
+			doc, err := extract("text/html", "./file.html")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Title).To(Equal("The & Title"))
+			Expect(doc.Content).To(Equal("Heading Some bold text more"))
+		})
+
+		It("adds odf text and title", func() {
+			body = zipDocument(map[string]string{
+				"meta.xml": `<office:document-meta xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+					`<office:meta><dc:title>The Title</dc:title></office:meta></office:document-meta>`,
+				"content.xml": `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">` +
+					`<office:body><office:text><text:h>Heading</text:h><text:p>Some <text:span>sp</text:span>an<text:s/>text</text:p></office:text></office:body></office:document-content>`,
+			})
+
+			doc, err := extract("application/vnd.oasis.opendocument.text", "./file.odt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Title).To(Equal("The Title"))
+			Expect(doc.Content).To(Equal("Heading Some span text"))
+		})
+
+		It("adds docx text and title", func() {
+			body = zipDocument(map[string]string{
+				"docProps/core.xml": `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/">` +
+					`<dc:title>The Title</dc:title></cp:coreProperties>`,
+				"word/document.xml": `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+					`<w:p><w:r><w:t>First</w:t></w:r></w:p><w:p><w:r><w:t>sec</w:t></w:r><w:r><w:t>ond</w:t></w:r>` +
+					`<w:r><w:instrText>PAGE</w:instrText></w:r><w:del><w:r><w:delText>deleted</w:delText></w:r></w:del></w:p></w:body></w:document>`,
+			})
+
+			doc, err := extract("application/vnd.openxmlformats-officedocument.wordprocessingml.document", "./file.docx")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Title).To(Equal("The Title"))
+			Expect(doc.Content).To(Equal("First second"))
+		})
+
+		It("adds xlsx strings", func() {
+			body = zipDocument(map[string]string{
+				"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+					`<si><t>Name</t></si><si><r><t>Am</t></r><r><t>ount</t></r></si></sst>`,
+			})
+
+			doc, err := extract("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "./file.xlsx")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("Name Amount"))
+		})
+
+		It("adds pptx slides in order", func() {
+			slide := func(text string) string {
+				return `<p:sld xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main" xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">` +
+					`<p:txBody><a:p><a:r><a:t>` + text + `</a:t></a:r></a:p></p:txBody></p:sld>`
+			}
+			body = zipDocument(map[string]string{
+				"ppt/slides/slide10.xml": slide("ten"),
+				"ppt/slides/slide2.xml":  slide("two"),
+				"ppt/slides/slide1.xml":  slide("one"),
+			})
+
+			doc, err := extract("application/vnd.openxmlformats-officedocument.presentationml.presentation", "./file.pptx")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("one two ten"))
+		})
+
+		It("limits the uncompressed size of zip documents", func() {
+			body = zipDocument(map[string]string{
+				"content.xml": `<document>` + string(bytes.Repeat([]byte("text "), 1000)) + `</document>`,
+			})
+			native.ContentExtractionSizeLimit = uint64(len(body))
+
+			doc, err := extract("application/vnd.oasis.opendocument.text", "./file.odt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal(""))
+		})
+
+		DescribeTable("adds pdf text and title",
+			func(deflate bool) {
+				body = pdfDocument("The \\(Title\\)", "BT /F1 12 Tf 72 712 Td (Hello) Tj 0 -14 Td [(W) 120 (orld) -300 (again)] TJ T* <2122> Tj ET", deflate)
+
+				doc, err := extract("application/pdf", "./file.pdf")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(doc.Title).To(Equal("The (Title)"))
+				Expect(doc.Content).To(Equal("Hello World again !\""))
+			},
+			Entry("uncompressed", false),
+			Entry("deflated", true),
+		)
+
+		It("cleans stop words", func() {
+			body = []byte("this is the text of the document")
+			native.CleanStopWords = true
+
+			doc, err := extract("text/plain", "./file.txt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(doc.Content).To(Equal("text document"))
+		})
+	})
+})
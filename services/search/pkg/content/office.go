@@ -0,0 +1,156 @@
+package content
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errContentLimit = errors.New("content exceeds the extraction size limit")
+
+// odfBreaks are the odf elements which separate words
+var odfBreaks = map[string]bool{"p": true, "h": true, "s": true, "tab": true, "line-break": true, "table-cell": true, "list-item": true}
+
+// ooxmlBreaks are the ooxml elements which separate words
+var ooxmlBreaks = map[string]bool{"p": true, "tab": true, "br": true, "cr": true, "tc": true, "si": true}
+
+// ooxmlSkipped are the ooxml elements whose text is not part of the content, like field codes or deleted text
+var ooxmlSkipped = map[string]bool{"instrText": true, "delText": true, "rPh": true}
+
+var pptxSlide = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+
+func parseODF(data []byte, limit uint64) (string, string, error) {
+	return parseOfficeDocument(data, limit, "meta.xml", []string{"content.xml"}, odfBreaks, nil)
+}
+
+func parseDOCX(data []byte, limit uint64) (string, string, error) {
+	return parseOfficeDocument(data, limit, "docProps/core.xml", []string{"word/document.xml"}, ooxmlBreaks, ooxmlSkipped)
+}
+
+func parseXLSX(data []byte, limit uint64) (string, string, error) {
+	return parseOfficeDocument(data, limit, "docProps/core.xml", []string{"xl/sharedStrings.xml"}, ooxmlBreaks, ooxmlSkipped)
+}
+
+func parsePPTX(data []byte, limit uint64) (string, string, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	// the slides are numbered, the names don't sort naturally
+	numbers := map[string]int{}
+	var slides []string
+	for _, f := range r.File {
+		if m := pptxSlide.FindStringSubmatch(f.Name); m != nil {
+			numbers[f.Name], _ = strconv.Atoi(m[1])
+			slides = append(slides, f.Name)
+		}
+	}
+	sort.Slice(slides, func(i, j int) bool { return numbers[slides[i]] < numbers[slides[j]] })
+
+	return parseOfficeDocument(data, limit, "docProps/core.xml", slides, ooxmlBreaks, ooxmlSkipped)
+}
+
+// parseOfficeDocument reads the title from the metadata and the text from the content parts of a zip based document,
+// the uncompressed size of all read parts is limited
+func parseOfficeDocument(data []byte, limit uint64, meta string, parts []string, breaks, skipped map[string]bool) (string, string, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	var title string
+	if b, err := readZipFile(r, meta, &limit); err == nil {
+		title, _ = xmlText(b, "title", nil, nil)
+	}
+
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		b, err := readZipFile(r, part, &limit)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			continue
+		case err != nil:
+			return "", "", err
+		}
+
+		text, err := xmlText(b, "", breaks, skipped)
+		if err != nil {
+			return "", "", err
+		}
+		texts = append(texts, text)
+	}
+
+	return title, strings.Join(texts, " "), nil
+}
+
+// readZipFile reads a file of the archive and reduces the remaining limit by its size
+func readZipFile(r *zip.Reader, name string, limit *uint64) ([]byte, error) {
+	f, err := r.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// the declared size can't be trusted, read one byte more than allowed to detect oversized files
+	b, err := io.ReadAll(io.LimitReader(f, int64(*limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(b)) > *limit {
+		return nil, fmt.Errorf("%s: %w", name, errContentLimit)
+	}
+	*limit -= uint64(len(b))
+
+	return b, nil
+}
+
+// xmlText returns the character data of the document, if root is set only the text of the first element with that name.
+// The breaks elements separate words, the text of the skipped elements is ignored.
+func xmlText(data []byte, root string, breaks, skipped map[string]bool) (string, error) {
+	var text strings.Builder
+	var inRoot bool
+	skip := 0
+
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			return text.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch e := t.(type) {
+		case xml.StartElement:
+			switch {
+			case e.Name.Local == root:
+				inRoot = true
+			case skipped[e.Name.Local]:
+				skip++
+			}
+		case xml.EndElement:
+			switch {
+			case e.Name.Local == root && inRoot:
+				return text.String(), nil
+			case skipped[e.Name.Local] && skip > 0:
+				skip--
+			case breaks[e.Name.Local]:
+				text.WriteByte(' ')
+			}
+		case xml.CharData:
+			if skip == 0 && (root == "" || inRoot) {
+				text.Write(e)
+			}
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package content
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// The pdf support is limited to simple documents: the text of uncompressed or deflated content streams
+// is read from the text showing operators, fonts are expected to use a single byte encoding.
+
+var (
+	errNoPDF = errors.New("not a pdf document")
+
+	// pdfSkippedStream matches the dictionaries of streams which never contain page content, like images or fonts
+	pdfSkippedStream = regexp.MustCompile(`/Subtype\b|/Length[123]\b|/Type\s*/(XObject|XRef|ObjStm|Metadata|EmbeddedFile)\b`)
+	pdfFilter        = regexp.MustCompile(`/Filter\s*\[?\s*/(\w+)\s*(/\w+)?`)
+	pdfTitle         = regexp.MustCompile(`/Title\s*([(<])`)
+)
+
+// pdfTJSpace is the offset in thousandths of an em above which a TJ adjustment separates words
+const pdfTJSpace = 250
+
+func parsePDF(data []byte, limit uint64) (string, string, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("%PDF-")) {
+		return "", "", errNoPDF
+	}
+
+	var text strings.Builder
+	for pos := 0; ; {
+		dict, stream, next, ok := nextPDFStream(data, pos)
+		if !ok {
+			break
+		}
+		pos = next
+
+		if pdfSkippedStream.Match(dict) {
+			continue
+		}
+
+		switch m := pdfFilter.FindSubmatch(dict); {
+		case m == nil:
+		case string(m[1]) == "FlateDecode" && len(m[2]) == 0:
+			r, err := zlib.NewReader(bytes.NewReader(stream))
+			if err != nil {
+				continue
+			}
+			// damaged streams are read as far as possible
+			stream, _ = io.ReadAll(io.LimitReader(r, int64(limit)+1))
+		default:
+			continue
+		}
+
+		if uint64(len(stream)) > limit {
+			return "", "", errContentLimit
+		}
+		limit -= uint64(len(stream))
+
+		text.WriteString(pdfContentText(stream))
+		text.WriteByte(' ')
+	}
+
+	var title string
+	if m := pdfTitle.FindSubmatchIndex(data); m != nil {
+		if data[m[2]] == '(' {
+			title, _ = readPDFLiteral(data[m[2]:])
+		} else {
+			title, _ = readPDFHex(data[m[2]:])
+		}
+	}
+
+	return title, text.String(), nil
+}
+
+// nextPDFStream returns the dictionary and the raw data of the next stream starting at pos
+func nextPDFStream(data []byte, pos int) (dict []byte, stream []byte, next int, ok bool) {
+	for {
+		i := bytes.Index(data[pos:], []byte("stream"))
+		if i < 0 {
+			return nil, nil, 0, false
+		}
+		i += pos
+		pos = i + len("stream")
+		if i >= 3 && string(data[i-3:i]) == "end" {
+			continue
+		}
+
+		start := pos
+		if bytes.HasPrefix(data[start:], []byte("\r\n")) {
+			start += 2
+		} else if bytes.HasPrefix(data[start:], []byte("\n")) {
+			start++
+		} else {
+			continue
+		}
+
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			return nil, nil, 0, false
+		}
+		end += start
+
+		dictStart := bytes.LastIndex(data[:i], []byte("obj"))
+		if dictStart < 0 {
+			dictStart = 0
+		}
+
+		return data[dictStart:i], bytes.TrimRight(data[start:end], "\r\n"), end + len("endstream"), true
+	}
+}
+
+// pdfContentText returns the text shown by the operators of a content stream
+func pdfContentText(content []byte) string {
+	var text strings.Builder
+	var operands, array []interface{}
+	var inText, inArray bool
+
+	push := func(v interface{}) {
+		if inArray {
+			array = append(array, v)
+		} else {
+			operands = append(operands, v)
+		}
+	}
+	lastString := func() (string, bool) {
+		if len(operands) == 0 {
+			return "", false
+		}
+		s, ok := operands[len(operands)-1].(string)
+		return s, ok
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case isPDFSpace(c):
+			i++
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			s, n := readPDFLiteral(content[i:])
+			push(s)
+			i += n
+		case c == '<' && i+1 < len(content) && content[i+1] == '<', c == '>' && i+1 < len(content) && content[i+1] == '>':
+			// dictionaries are only used as operands of marked content operators
+			i += 2
+		case c == '<':
+			s, n := readPDFHex(content[i:])
+			push(s)
+			i += n
+		case c == '[':
+			inArray, array = true, nil
+			i++
+		case c == ']':
+			inArray = false
+			operands = append(operands, array)
+			i++
+		case c == '/':
+			i++
+			for i < len(content) && !isPDFSpace(content[i]) && !isPDFDelimiter(content[i]) {
+				i++
+			}
+			push(nil)
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			start := i
+			for i++; i < len(content) && (content[i] == '.' || (content[i] >= '0' && content[i] <= '9')); i++ {
+			}
+			f, _ := strconv.ParseFloat(string(content[start:i]), 64)
+			push(f)
+		default:
+			start := i
+			for i++; i < len(content) && !isPDFSpace(content[i]) && !isPDFDelimiter(content[i]); i++ {
+			}
+			if i == start+1 && isPDFDelimiter(c) {
+				// stray delimiter
+				continue
+			}
+
+			switch op := string(content[start:i]); op {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				text.WriteByte(' ')
+			case "Td", "TD", "T*", "Tm":
+				text.WriteByte(' ')
+			case "Tj", "'", `"`:
+				if s, ok := lastString(); ok && inText {
+					if op != "Tj" {
+						text.WriteByte(' ')
+					}
+					text.WriteString(s)
+				}
+			case "TJ":
+				if len(operands) == 0 || !inText {
+					break
+				}
+				elements, _ := operands[len(operands)-1].([]interface{})
+				for _, e := range elements {
+					switch v := e.(type) {
+					case string:
+						text.WriteString(v)
+					case float64:
+						if v < -pdfTJSpace {
+							text.WriteByte(' ')
+						}
+					}
+				}
+			case "ID":
+				// skip the data of inline images
+				end := bytes.Index(content[i:], []byte("EI"))
+				if end < 0 {
+					return text.String()
+				}
+				i += end + 2
+			}
+			operands = operands[:0]
+		}
+	}
+
+	return text.String()
+}
+
+// readPDFLiteral reads a literal string like (text) and returns it with the number of consumed bytes
+func readPDFLiteral(data []byte) (string, int) {
+	var b []byte
+	depth := 0
+	i := 0
+	for ; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '(':
+			if depth > 0 {
+				b = append(b, c)
+			}
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return decodePDFString(b), i + 1
+			}
+			b = append(b, c)
+		case c == '\\' && i+1 < len(data):
+			i++
+			switch e := data[i]; e {
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case '\r':
+				if i+1 < len(data) && data[i+1] == '\n' {
+					i++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					n := 0
+					for j := 0; j < 3 && i < len(data) && data[i] >= '0' && data[i] <= '7'; j++ {
+						n = n*8 + int(data[i]-'0')
+						i++
+					}
+					i--
+					b = append(b, byte(n))
+				} else {
+					b = append(b, e)
+				}
+			}
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return decodePDFString(b), i
+}
+
+// readPDFHex reads a hexadecimal string like <48656c6c6f> and returns it with the number of consumed bytes
+func readPDFHex(data []byte) (string, int) {
+	end := bytes.IndexByte(data, '>')
+	if end < 0 {
+		return "", len(data)
+	}
+
+	digits := make([]byte, 0, end)
+	for _, c := range data[1:end] {
+		if !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	b, err := hex.DecodeString(string(digits))
+	if err != nil {
+		return "", end + 1
+	}
+
+	// two byte codes of simple fonts have a zero high byte
+	if len(b)%2 == 0 && len(b) > 0 && !bytes.HasPrefix(b, []byte{0xfe, 0xff}) {
+		high := true
+		for i := 0; i < len(b); i += 2 {
+			high = high && b[i] == 0
+		}
+		if high {
+			low := make([]byte, 0, len(b)/2)
+			for i := 1; i < len(b); i += 2 {
+				low = append(low, b[i])
+			}
+			b = low
+		}
+	}
+
+	return decodePDFString(b), end + 1
+}
+
+// decodePDFString decodes utf-16 strings with byte order mark, all others are treated as latin-1
+func decodePDFString(b []byte) string {
+	if bytes.HasPrefix(b, []byte{0xfe, 0xff}) {
+		b = b[2:]
+		u := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			u = append(u, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(u))
+	}
+
+	r := make([]rune, len(b))
+	for i, c := range b {
+		r[i] = rune(c)
+	}
+	return string(r)
+}
+
+func isPDFSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
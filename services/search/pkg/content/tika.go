@@ -30,7 +30,7 @@ type Tika struct {
 
 // NewTikaExtractor creates a new Tika instance.
 func NewTikaExtractor(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], logger log.Logger, cfg *config.Config) (*Tika, error) {
-	basic, err := NewBasicExtractor(logger)
+	basic, err := NewBasicExtractor(logger, cfg.CustomProperties...)
 	if err != nil {
 		return nil, err
 	}
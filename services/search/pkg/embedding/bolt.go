@@ -0,0 +1,249 @@
+package embedding
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	_idsBucket      = []byte("ids")
+	_rootsBucket    = []byte("roots")
+	_parentsBucket  = []byte("parents")
+	_childrenBucket = []byte("children")
+)
+
+// ErrNotFound is returned if no vector is stored for a resource
+var ErrNotFound = errors.New("vector not found")
+
+// BoltStore keeps the vectors in a bolt database next to the search index.
+// The vectors of a space are kept in a bucket of their own, similar resources are found by comparing them all.
+// The parents of the resources are kept as well, to delete the vectors of all descendants of a resource.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens or creates the database in the given directory.
+func NewBoltStore(root string) (*BoltStore, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(filepath.Join(root, "vectors.db"), 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{_idsBucket, _rootsBucket, _parentsBucket, _childrenBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Upsert stores the vector of a resource.
+func (s *BoltStore) Upsert(id, rootID, parentID string, vector []float32) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		// a resource never changes its space, the old vector is removed anyway to keep the buckets consistent
+		if err := deleteVector(tx, id); err != nil {
+			return err
+		}
+
+		root, err := tx.Bucket(_rootsBucket).CreateBucketIfNotExists([]byte(rootID))
+		if err != nil {
+			return err
+		}
+		if err := root.Put([]byte(id), encodeVector(vector)); err != nil {
+			return err
+		}
+		if err := tx.Bucket(_idsBucket).Put([]byte(id), []byte(rootID)); err != nil {
+			return err
+		}
+
+		return setParent(tx, id, parentID)
+	})
+}
+
+// Move updates the parent of a resource.
+func (s *BoltStore) Move(id, parentID string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(_idsBucket).Get([]byte(id)) == nil {
+			return nil
+		}
+		return setParent(tx, id, parentID)
+	})
+}
+
+// Get returns the vector of a resource.
+func (s *BoltStore) Get(id string) ([]float32, error) {
+	var vector []float32
+	err := s.db.View(func(tx *bolt.Tx) error {
+		rootID := tx.Bucket(_idsBucket).Get([]byte(id))
+		if rootID == nil {
+			return ErrNotFound
+		}
+
+		root := tx.Bucket(_rootsBucket).Bucket(rootID)
+		if root == nil {
+			return ErrNotFound
+		}
+
+		v := root.Get([]byte(id))
+		if v == nil {
+			return ErrNotFound
+		}
+		vector = decodeVector(v)
+
+		return nil
+	})
+
+	return vector, err
+}
+
+// Delete removes the vectors of a resource and all of its descendants, unknown resources are ignored.
+func (s *BoltStore) Delete(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		ids := []string{id}
+		for len(ids) > 0 {
+			id := ids[0]
+			ids = ids[1:]
+
+			if children := tx.Bucket(_childrenBucket).Bucket([]byte(id)); children != nil {
+				if err := children.ForEach(func(k, _ []byte) error {
+					ids = append(ids, string(k))
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := tx.Bucket(_childrenBucket).DeleteBucket([]byte(id)); err != nil {
+					return err
+				}
+			}
+
+			if err := deleteVector(tx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Nearest returns up to n resources of the space whose vectors are the most similar to the given one, most similar first.
+func (s *BoltStore) Nearest(rootID string, vector []float32, n int) ([]Neighbor, error) {
+	var neighbors []Neighbor
+	err := s.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(_rootsBucket).Bucket([]byte(rootID))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEach(func(k, v []byte) error {
+			// vectors of another provider configuration can't be compared
+			if len(v) != 4*len(vector) {
+				return nil
+			}
+
+			neighbors = append(neighbors, Neighbor{
+				ID:         string(k),
+				Similarity: Similarity(vector, decodeVector(v)),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].Similarity > neighbors[j].Similarity
+	})
+	if n >= 0 && len(neighbors) > n {
+		neighbors = neighbors[:n]
+	}
+
+	return neighbors, nil
+}
+
+// Close closes the database.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func deleteVector(tx *bolt.Tx, id string) error {
+	if err := setParent(tx, id, ""); err != nil {
+		return err
+	}
+
+	ids := tx.Bucket(_idsBucket)
+	rootID := ids.Get([]byte(id))
+	if rootID == nil {
+		return nil
+	}
+
+	if root := tx.Bucket(_rootsBucket).Bucket(rootID); root != nil {
+		if err := root.Delete([]byte(id)); err != nil {
+			return err
+		}
+	}
+
+	return ids.Delete([]byte(id))
+}
+
+// setParent moves the resource to the children of the given parent, an empty parent only removes it from its old one
+func setParent(tx *bolt.Tx, id, parentID string) error {
+	parents := tx.Bucket(_parentsBucket)
+	if old := parents.Get([]byte(id)); old != nil {
+		if children := tx.Bucket(_childrenBucket).Bucket(old); children != nil {
+			if err := children.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+		if err := parents.Delete([]byte(id)); err != nil {
+			return err
+		}
+	}
+
+	if parentID == "" {
+		return nil
+	}
+
+	children, err := tx.Bucket(_childrenBucket).CreateBucketIfNotExists([]byte(parentID))
+	if err != nil {
+		return err
+	}
+	if err := children.Put([]byte(id), nil); err != nil {
+		return err
+	}
+	return parents.Put([]byte(id), []byte(parentID))
+}
+
+func encodeVector(vector []float32) []byte {
+	b := make([]byte, 4*len(vector))
+	for i, x := range vector {
+		binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(x))
+	}
+	return b
+}
+
+func decodeVector(b []byte) []float32 {
+	vector := make([]float32, len(b)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return vector
+}
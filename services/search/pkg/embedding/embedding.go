@@ -0,0 +1,66 @@
+// Package embedding provides the vector representations of resources used by the semantic search.
+package embedding
+
+import (
+	"context"
+	"math"
+)
+
+// Provider computes the embeddings of texts, all vectors of a provider have the same number of dimensions.
+type Provider interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	Dimensions() int
+}
+
+// Neighbor is a resource found by its similarity to a vector
+type Neighbor struct {
+	ID         string
+	Similarity float32
+}
+
+// Store persists the vectors of resources, grouped by the root of their space.
+// Deleting the vector of a resource deletes the vectors of its descendants as well.
+type Store interface {
+	Upsert(id, rootID, parentID string, vector []float32) error
+	Move(id, parentID string) error
+	Get(id string) ([]float32, error)
+	Delete(id string) error
+	Nearest(rootID string, vector []float32, n int) ([]Neighbor, error)
+	Close() error
+}
+
+// Similarity returns the cosine similarity of two vectors, vectors of different length are not similar at all
+func Similarity(a, b []float32) float32 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+
+	return float32(dot / (math.Sqrt(na) * math.Sqrt(nb)))
+}
+
+// normalize scales the vector to unit length
+func normalize(v []float32) []float32 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return v
+	}
+
+	norm := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= norm
+	}
+	return v
+}
@@ -0,0 +1,120 @@
+package embedding_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
+)
+
+var _ = Describe("Embedding", func() {
+	var (
+		local = embedding.NewLocal(512)
+		embed = func(text string) []float32 {
+			vectors, err := local.Embed(context.Background(), []string{text})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vectors).To(HaveLen(1))
+			return vectors[0]
+		}
+	)
+
+	Describe("Local", func() {
+		It("computes normalized vectors", func() {
+			v := embed("The Quarterly Report")
+			Expect(v).To(HaveLen(local.Dimensions()))
+			Expect(embedding.Similarity(v, v)).To(BeNumerically("~", 1, 0.0001))
+		})
+
+		It("ignores case and punctuation", func() {
+			Expect(embedding.Similarity(embed("Quarterly report!"), embed("quarterly, REPORT"))).To(BeNumerically("~", 1, 0.0001))
+		})
+
+		It("ranks texts sharing words higher", func() {
+			query := embed("quarterly sales report")
+			Expect(embedding.Similarity(query, embed("the sales report of the last quarterly meeting"))).
+				To(BeNumerically(">", embedding.Similarity(query, embed("photos of the beach holiday"))))
+		})
+
+		It("returns a zero vector for texts without words", func() {
+			Expect(embed(" - ")).To(HaveEach(float32(0)))
+		})
+	})
+
+	Describe("BoltStore", func() {
+		var store *embedding.BoltStore
+
+		BeforeEach(func() {
+			var err error
+			store, err = embedding.NewBoltStore(GinkgoT().TempDir())
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(store.Close)
+
+			Expect(store.Upsert("a", "space1", "folder", embed("quarterly sales report"))).To(Succeed())
+			Expect(store.Upsert("b", "space1", "folder", embed("sales report"))).To(Succeed())
+			Expect(store.Upsert("c", "space1", "space1", embed("beach holiday"))).To(Succeed())
+			Expect(store.Upsert("d", "space2", "space2", embed("quarterly sales report"))).To(Succeed())
+		})
+
+		It("returns the stored vectors", func() {
+			v, err := store.Get("a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(Equal(embed("quarterly sales report")))
+
+			_, err = store.Get("unknown")
+			Expect(err).To(MatchError(embedding.ErrNotFound))
+		})
+
+		It("finds the nearest vectors of a space", func() {
+			neighbors, err := store.Nearest("space1", embed("quarterly sales report"), 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(neighbors).To(HaveLen(2))
+			Expect(neighbors[0].ID).To(Equal("a"))
+			Expect(neighbors[0].Similarity).To(BeNumerically("~", 1, 0.0001))
+			Expect(neighbors[1].ID).To(Equal("b"))
+
+			neighbors, err = store.Nearest("space3", embed("quarterly sales report"), 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(neighbors).To(BeEmpty())
+		})
+
+		It("skips vectors of a different size", func() {
+			neighbors, err := store.Nearest("space1", []float32{1, 0}, -1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(neighbors).To(BeEmpty())
+		})
+
+		It("replaces and deletes vectors", func() {
+			Expect(store.Upsert("a", "space1", "folder", embed("beach holiday"))).To(Succeed())
+			Expect(store.Delete("c")).To(Succeed())
+			Expect(store.Delete("unknown")).To(Succeed())
+
+			neighbors, err := store.Nearest("space1", embed("beach holiday"), -1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(neighbors).To(HaveLen(2))
+			Expect(neighbors[0].ID).To(Equal("a"))
+
+			_, err = store.Get("c")
+			Expect(err).To(MatchError(embedding.ErrNotFound))
+		})
+
+		It("deletes the vectors of all descendants", func() {
+			Expect(store.Upsert("folder", "space1", "space1", embed("folder"))).To(Succeed())
+			Expect(store.Upsert("e", "space1", "a", embed("nested"))).To(Succeed())
+			// b was moved out of the folder before
+			Expect(store.Move("b", "space1")).To(Succeed())
+
+			Expect(store.Delete("folder")).To(Succeed())
+
+			for _, id := range []string{"folder", "a", "e"} {
+				_, err := store.Get(id)
+				Expect(err).To(MatchError(embedding.ErrNotFound))
+			}
+			for _, id := range []string{"b", "c"} {
+				_, err := store.Get(id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Local is a stand-in for a language model, it hashes the words of a text into a vector of the given size.
+// Texts sharing many words are similar, synonyms or translations are not recognized.
+type Local struct {
+	dimensions int
+}
+
+// NewLocal creates a new Local instance.
+func NewLocal(dimensions int) *Local {
+	return &Local{dimensions: dimensions}
+}
+
+// Dimensions returns the size of the vectors.
+func (l *Local) Dimensions() int {
+	return l.dimensions
+}
+
+// Embed computes the vectors of the texts.
+func (l *Local) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, l.embed(text))
+	}
+
+	return vectors, nil
+}
+
+func (l *Local) embed(text string) []float32 {
+	counts := map[string]int{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if len([]rune(word)) > 1 {
+			counts[word]++
+		}
+	}
+
+	v := make([]float32, l.dimensions)
+	if l.dimensions == 0 {
+		return v
+	}
+	for word, count := range counts {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(word))
+		sum := h.Sum64()
+
+		// the sign halves the error of colliding words
+		weight := float32(1 + math.Log(float64(count)))
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		v[sum%uint64(l.dimensions)] += weight
+	}
+
+	return normalize(v)
+}
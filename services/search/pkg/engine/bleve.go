@@ -18,6 +18,7 @@ import (
 	"github.com/blevesearch/bleve/v2/analysis/tokenizer/single"
 	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
 	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search"
 	"github.com/blevesearch/bleve/v2/search/query"
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -30,6 +31,7 @@ import (
 
 	searchMessage "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
 	searchService "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
 	searchQuery "github.com/owncloud/ocis/v2/services/search/pkg/query"
 )
@@ -41,12 +43,12 @@ type Bleve struct {
 }
 
 // NewBleveIndex returns a new bleve index
-// given path must exist.
-func NewBleveIndex(root string) (bleve.Index, error) {
+// given path must exist. The custom properties are only mapped when the index is created.
+func NewBleveIndex(root string, properties ...config.CustomProperty) (bleve.Index, error) {
 	destination := filepath.Join(root, "bleve")
 	index, err := bleve.Open(destination)
 	if errors.Is(bleve.ErrorIndexPathDoesNotExist, err) {
-		m, err := BuildBleveMapping()
+		m, err := BuildBleveMapping(properties...)
 		if err != nil {
 			return nil, err
 		}
@@ -70,7 +72,7 @@ func NewBleveEngine(index bleve.Index, queryCreator searchQuery.Creator[query.Qu
 }
 
 // BuildBleveMapping builds a bleve index mapping which can be used for indexing
-func BuildBleveMapping() (mapping.IndexMapping, error) {
+func BuildBleveMapping(properties ...config.CustomProperty) (mapping.IndexMapping, error) {
 	nameMapping := bleve.NewTextFieldMapping()
 	nameMapping.Analyzer = "lowercaseKeyword"
 
@@ -86,6 +88,9 @@ func BuildBleveMapping() (mapping.IndexMapping, error) {
 	docMapping.AddFieldMappingsAt("Name", nameMapping)
 	docMapping.AddFieldMappingsAt("Tags", lowercaseMapping)
 	docMapping.AddFieldMappingsAt("Content", fulltextFieldMapping)
+	if len(properties) > 0 {
+		docMapping.AddSubDocumentMapping(propertiesField, bleveProperties(properties))
+	}
 
 	indexMapping := bleve.NewIndexMapping()
 	indexMapping.DefaultAnalyzer = keyword.Name
@@ -130,6 +135,9 @@ func (b *Bleve) Search(ctx context.Context, sir *searchService.SearchIndexReques
 		}
 		return nil, err
 	}
+	if err := validateFacets(sir.Facets); err != nil {
+		return nil, err
+	}
 
 	q := bleve.NewConjunctionQuery(
 		// Skip documents that have been marked as deleted
@@ -154,10 +162,22 @@ func (b *Bleve) Search(ctx context.Context, sir *searchService.SearchIndexReques
 				),
 			},
 		)
+
+		// restrict the query to the requested path, this way the total and the facets only count resources below it
+		if requestedPath := utils.MakeRelativePath(sir.Ref.Path); requestedPath != "." {
+			q.Conjuncts = append(
+				q.Conjuncts,
+				bleve.NewDisjunctionQuery(
+					&query.TermQuery{FieldVal: "Path", Term: requestedPath},
+					&query.PrefixQuery{FieldVal: "Path", Prefix: requestedPath + "/"},
+				),
+			)
+		}
 	}
 
 	bleveReq := bleve.NewSearchRequest(q)
 	bleveReq.Highlight = bleve.NewHighlight()
+	addBleveFacets(bleveReq, sir.Facets, time.Now())
 
 	switch {
 	case sir.PageSize == -1:
@@ -175,66 +195,78 @@ func (b *Bleve) Search(ctx context.Context, sir *searchService.SearchIndexReques
 	}
 
 	matches := make([]*searchMessage.Match, 0, len(res.Hits))
-	totalMatches := res.Total
 	for _, hit := range res.Hits {
-		if sir.Ref != nil {
-			hitPath := strings.TrimSuffix(getFieldValue[string](hit.Fields, "Path"), "/")
-			requestedPath := utils.MakeRelativePath(sir.Ref.Path)
-			isRoot := hitPath == requestedPath
-
-			if !isRoot && requestedPath != "." && !strings.HasPrefix(hitPath, requestedPath+"/") {
-				totalMatches--
-				continue
-			}
-		}
-
-		rootID, err := storagespace.ParseID(getFieldValue[string](hit.Fields, "RootID"))
+		match, err := matchFromFields(hit.Fields, hit.Score, getFragmentValue(hit.Fragments, "Content", 0))
 		if err != nil {
 			return nil, err
 		}
 
-		rID, err := storagespace.ParseID(getFieldValue[string](hit.Fields, "ID"))
-		if err != nil {
-			return nil, err
-		}
-
-		pID, _ := storagespace.ParseID(getFieldValue[string](hit.Fields, "ParentID"))
-		match := &searchMessage.Match{
-			Score: float32(hit.Score),
-			Entity: &searchMessage.Entity{
-				Ref: &searchMessage.Reference{
-					ResourceId: resourceIDtoSearchID(rootID),
-					Path:       getFieldValue[string](hit.Fields, "Path"),
-				},
-				Id:         resourceIDtoSearchID(rID),
-				Name:       getFieldValue[string](hit.Fields, "Name"),
-				ParentId:   resourceIDtoSearchID(pID),
-				Size:       uint64(getFieldValue[float64](hit.Fields, "Size")),
-				Type:       uint64(getFieldValue[float64](hit.Fields, "Type")),
-				MimeType:   getFieldValue[string](hit.Fields, "MimeType"),
-				Deleted:    getFieldValue[bool](hit.Fields, "Deleted"),
-				Tags:       getFieldSliceValue[string](hit.Fields, "Tags"),
-				Highlights: getFragmentValue(hit.Fragments, "Content", 0),
-				Audio:      getAudioValue[searchMessage.Audio](hit.Fields),
-				Image:      getImageValue[searchMessage.Image](hit.Fields),
-				Location:   getLocationValue[searchMessage.GeoCoordinates](hit.Fields),
-				Photo:      getPhotoValue[searchMessage.Photo](hit.Fields),
-			},
-		}
-
-		if mtime, err := time.Parse(time.RFC3339, getFieldValue[string](hit.Fields, "Mtime")); err == nil {
-			match.Entity.LastModifiedTime = &timestamppb.Timestamp{Seconds: mtime.Unix(), Nanos: int32(mtime.Nanosecond())}
-		}
-
 		matches = append(matches, match)
 	}
 
 	return &searchService.SearchIndexResponse{
 		Matches:      matches,
-		TotalMatches: int32(totalMatches),
+		TotalMatches: int32(res.Total),
+		Facets:       bleveFacets(sir.Facets, res.Facets),
 	}, nil
 }
 
+// addBleveFacets adds the requested facets to the bleve search request
+func addBleveFacets(bleveReq *bleve.SearchRequest, reqs []*searchService.FacetRequest, t time.Time) {
+	for _, fr := range reqs {
+		switch fr.GetField() {
+		case FacetMediaType:
+			bleveReq.AddFacet(FacetMediaType, bleve.NewFacetRequest("MimeType", facetTermsLimit))
+		case FacetTags:
+			bleveReq.AddFacet(FacetTags, bleve.NewFacetRequest("Tags", facetSize(fr)))
+		case FacetSpace:
+			bleveReq.AddFacet(FacetSpace, bleve.NewFacetRequest("RootID", facetSize(fr)))
+		case FacetMtime:
+			ranges := mtimeFacetRanges(t)
+			f := bleve.NewFacetRequest("Mtime", len(ranges))
+			for _, r := range ranges {
+				f.AddDateTimeRange(r.Name, r.Start, r.End)
+			}
+			bleveReq.AddFacet(FacetMtime, f)
+		case FacetSize:
+			f := bleve.NewFacetRequest("Size", len(sizeFacetRanges))
+			for _, r := range sizeFacetRanges {
+				min, max := r.Min, r.Max
+				if max == 0 {
+					f.AddNumericRange(r.Name, &min, nil)
+					continue
+				}
+				f.AddNumericRange(r.Name, &min, &max)
+			}
+			bleveReq.AddFacet(FacetSize, f)
+		}
+	}
+}
+
+// bleveFacets converts the facet results of bleve
+func bleveFacets(reqs []*searchService.FacetRequest, results search.FacetResults) []*searchService.Facet {
+	counts := facetCounts{}
+	for field, result := range results {
+		for _, t := range result.Terms.Terms() {
+			switch field {
+			case FacetMediaType:
+				counts.add(field, mediaTypeCategory(t.Term), int64(t.Count))
+			case FacetSpace:
+				counts.add(field, spaceFromRootID(t.Term), int64(t.Count))
+			default:
+				counts.add(field, t.Term, int64(t.Count))
+			}
+		}
+		for _, r := range result.DateRanges {
+			counts.add(field, r.Name, int64(r.Count))
+		}
+		for _, r := range result.NumericRanges {
+			counts.add(field, r.Name, int64(r.Count))
+		}
+	}
+	return buildFacets(reqs, counts)
+}
+
 // Upsert indexes or stores Resource data fields.
 func (b *Bleve) Upsert(id string, r Resource) error {
 	return b.index.Index(id, r)
@@ -327,6 +359,7 @@ func (b *Bleve) getResource(id string) (*Resource, error) {
 		Path:     getFieldValue[string](fields, "Path"),
 		ParentID: getFieldValue[string](fields, "ParentID"),
 		Type:     uint64(getFieldValue[float64](fields, "Type")),
+		Etag:     getFieldValue[string](fields, "Etag"),
 		Deleted:  getFieldValue[bool](fields, "Deleted"),
 		Document: content.Document{
 			Name:     getFieldValue[string](fields, "Name"),
@@ -340,6 +373,8 @@ func (b *Bleve) getResource(id string) (*Resource, error) {
 			Image:    getImageValue[libregraph.Image](fields),
 			Location: getLocationValue[libregraph.GeoCoordinates](fields),
 			Photo:    getPhotoValue[libregraph.Photo](fields),
+
+			Properties: getPropertiesValue(fields),
 		},
 	}, nil
 }
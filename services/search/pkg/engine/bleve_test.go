@@ -3,6 +3,7 @@ package engine_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	bleveSearch "github.com/blevesearch/bleve/v2"
 	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -13,6 +14,7 @@ import (
 	libregraph "github.com/owncloud/libre-graph-api-go"
 	searchmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
 	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
 	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
 	"github.com/owncloud/ocis/v2/services/search/pkg/query/bleve"
@@ -113,6 +115,15 @@ var _ = Describe("Bleve", func() {
 				assertDocCount(rootResource.ID, "Tags:baz", 0)
 			})
 
+			It("finds files by a list of ids", func() {
+				for _, r := range []engine.Resource{rootResource, parentResource, childResource} {
+					Expect(eng.Upsert(r.ID, r)).To(Succeed())
+				}
+
+				assertDocCount(rootResource.ID, "id:"+parentResource.ID+" OR id:"+childResource.ID, 2)
+				assertDocCount(rootResource.ID, "id:"+childResource.ID+" OR id:1$2!5", 1)
+			})
+
 			It("finds files by size", func() {
 				parentResource.Document.Size = 12345
 				err := eng.Upsert(parentResource.ID, parentResource)
@@ -125,6 +136,68 @@ var _ = Describe("Bleve", func() {
 				assertDocCount(rootResource.ID, "Size:<1000", 0)
 				assertDocCount(rootResource.ID, "Size:>100000", 0)
 			})
+
+			It("finds files by size ranges with units", func() {
+				parentResource.Document.Size = 12345
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				assertDocCount(rootResource.ID, "size>10KB", 1)
+				assertDocCount(rootResource.ID, "size<=12345", 1)
+				assertDocCount(rootResource.ID, "size:10KB..1MB", 1)
+				assertDocCount(rootResource.ID, "size<12345", 0)
+				assertDocCount(rootResource.ID, "size>1MB", 0)
+				assertDocCount(rootResource.ID, "size:1KB..10KB", 0)
+			})
+		})
+
+		Context("by content", func() {
+			BeforeEach(func() {
+				parentResource.Document.Content = "The quick brown fox jumps over the lazy dogs"
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("finds phrases", func() {
+				assertDocCount(rootResource.ID, `content:"brown fox"`, 1)
+				assertDocCount(rootResource.ID, `content:"quick fox"`, 0)
+				assertDocCount(rootResource.ID, `content:"fox brown"`, 0)
+			})
+
+			It("finds prefixes", func() {
+				assertDocCount(rootResource.ID, `content:jum*`, 1)
+				assertDocCount(rootResource.ID, `content:fox*`, 1)
+				assertDocCount(rootResource.ID, `content:cat*`, 0)
+			})
+
+			It("finds terms near each other", func() {
+				assertDocCount(rootResource.ID, `quick NEAR fox`, 1)
+				assertDocCount(rootResource.ID, `fox NEAR quick`, 1)
+				assertDocCount(rootResource.ID, `quick NEAR(1) fox`, 1)
+				assertDocCount(rootResource.ID, `quick NEAR(0) fox`, 0)
+				assertDocCount(rootResource.ID, `"quick brown" NEAR(5) dog`, 1)
+				assertDocCount(rootResource.ID, `"quick brown" NEAR(4) dog`, 0)
+				assertDocCount(rootResource.ID, `content:(quick NEAR cat)`, 0)
+			})
+
+			It("finds terms near each other in order", func() {
+				assertDocCount(rootResource.ID, `quick ONEAR(n=1) fox`, 1)
+				assertDocCount(rootResource.ID, `fox ONEAR(n=1) quick`, 0)
+			})
+
+			It("boosts resources with XRANK", func() {
+				childResource.Document.Content = "The quick brown fox"
+				err := eng.Upsert(childResource.ID, childResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				matches := assertDocCount(rootResource.ID, `content:fox XRANK(cb=100) name:child*`, 2)
+				Expect(matches[0].Entity.Name).To(Equal("child.pdf"))
+
+				matches = assertDocCount(rootResource.ID, `content:fox XRANK(cb=100) content:lazy`, 2)
+				Expect(matches[0].Entity.Name).To(Equal("parent d!r"))
+
+				assertDocCount(rootResource.ID, `content:cat XRANK(cb=100) name:child*`, 0)
+			})
 		})
 
 		Context("by filename", func() {
@@ -187,6 +260,7 @@ var _ = Describe("Bleve", func() {
 				parentResource.Document.Name = "bar.pdf"
 				parentResource.Type = 3
 				parentResource.MimeType = "application/pdf"
+				parentResource.Etag = "\"etag\""
 
 				err := eng.Upsert(parentResource.ID, parentResource)
 				Expect(err).ToNot(HaveOccurred())
@@ -198,6 +272,7 @@ var _ = Describe("Bleve", func() {
 				Expect(match.Entity.Size).To(Equal(parentResource.Size))
 				Expect(match.Entity.Type).To(Equal(parentResource.Type))
 				Expect(match.Entity.MimeType).To(Equal(parentResource.MimeType))
+				Expect(match.Entity.Etag).To(Equal(parentResource.Etag))
 				Expect(match.Entity.Deleted).To(BeFalse())
 				Expect(match.Score > 0).To(BeTrue())
 			})
@@ -354,6 +429,150 @@ var _ = Describe("Bleve", func() {
 			})
 		})
 
+		Context("with custom properties", func() {
+			BeforeEach(func() {
+				properties := []config.CustomProperty{
+					{Name: "project", Type: config.PropertyTypeKeyword},
+					{Name: "summary", Type: config.PropertyTypeString},
+					{Name: "retention", Type: config.PropertyTypeNumber},
+					{Name: "reviewed", Type: config.PropertyTypeDate},
+				}
+
+				mapping, err := engine.BuildBleveMapping(properties...)
+				Expect(err).ToNot(HaveOccurred())
+
+				idx, err = bleveSearch.NewMemOnly(mapping)
+				Expect(err).ToNot(HaveOccurred())
+
+				eng = engine.NewBleveEngine(idx, bleve.NewCreator(engine.PropertyFields(properties)))
+
+				childResource.Document.Properties = map[string]interface{}{
+					"project":   "Apollo-11",
+					"summary":   "Landing on the moon",
+					"retention": 10.0,
+					"reviewed":  time.Date(2023, 7, 20, 20, 17, 0, 0, time.UTC),
+				}
+				Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+			})
+
+			It("finds resources by custom properties", func() {
+				assertDocCount(rootResource.ID, `project:apollo-11`, 1)
+				assertDocCount(rootResource.ID, `Project:"Apollo-11"`, 1)
+				assertDocCount(rootResource.ID, `project:apollo`, 0)
+				assertDocCount(rootResource.ID, `project:apollo*`, 1)
+				assertDocCount(rootResource.ID, `summary:moon`, 1)
+				assertDocCount(rootResource.ID, `summary:"landing on the moon"`, 1)
+				assertDocCount(rootResource.ID, `summary:mars`, 0)
+				assertDocCount(rootResource.ID, `retention>=10`, 1)
+				assertDocCount(rootResource.ID, `retention:5..20`, 1)
+				assertDocCount(rootResource.ID, `retention>10`, 0)
+				assertDocCount(rootResource.ID, `reviewed:2023-07-01..2023-07-31`, 1)
+				assertDocCount(rootResource.ID, `reviewed>2023-07-21`, 0)
+			})
+
+			It("keeps the custom properties when moving", func() {
+				Expect(eng.Move(childResource.ID, rootResource.ID, "./child.pdf")).To(Succeed())
+
+				assertDocCount(rootResource.ID, `project:apollo-11`, 1)
+				assertDocCount(rootResource.ID, `retention>=10`, 1)
+				assertDocCount(rootResource.ID, `reviewed:2023-07-01..2023-07-31`, 1)
+			})
+		})
+
+		Context("with facets", func() {
+			BeforeEach(func() {
+				parentResource.MimeType = "httpd/unix-directory"
+				childResource.Document = content.Document{
+					Name:     "child.pdf",
+					MimeType: "application/pdf",
+					Size:     200 << 10,
+					Mtime:    time.Now().UTC().Format(time.RFC3339),
+					Tags:     []string{"invoice", "2023"},
+				}
+				imageResource := engine.Resource{
+					ID:       "1$2!5",
+					ParentID: parentResource.ID,
+					RootID:   rootResource.ID,
+					Path:     "./parent d!r/child.png",
+					Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_FILE),
+					Document: content.Document{
+						Name:     "child.png",
+						MimeType: "image/png",
+						Size:     42,
+						Mtime:    "2020-01-01T00:00:00Z",
+						Tags:     []string{"invoice"},
+					},
+				}
+
+				Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+				Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+				Expect(eng.Upsert(imageResource.ID, imageResource)).To(Succeed())
+			})
+
+			search := func(path string, facets ...*searchsvc.FacetRequest) *searchsvc.SearchIndexResponse {
+				res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+					Query: "name:*child*",
+					Ref: &searchmsg.Reference{
+						ResourceId: &searchmsg.ResourceID{StorageId: "1", SpaceId: "2", OpaqueId: "2"},
+						Path:       path,
+					},
+					Facets: facets,
+				})
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				return res
+			}
+
+			values := func(f *searchsvc.Facet) map[string]int64 {
+				v := map[string]int64{}
+				for _, fv := range f.GetValues() {
+					v[fv.GetValue()] = fv.GetCount()
+				}
+				return v
+			}
+
+			It("counts the media types", func() {
+				res := search("", &searchsvc.FacetRequest{Field: engine.FacetMediaType})
+				Expect(res.Facets).To(HaveLen(1))
+				Expect(res.Facets[0].Field).To(Equal(engine.FacetMediaType))
+				Expect(values(res.Facets[0])).To(Equal(map[string]int64{"pdf": 1, "image": 1}))
+			})
+
+			It("counts the tags and limits them to the requested size", func() {
+				res := search("", &searchsvc.FacetRequest{Field: engine.FacetTags, Size: 1})
+				Expect(res.Facets[0].Values).To(Equal([]*searchsvc.FacetValue{{Value: "invoice", Count: 2}}))
+			})
+
+			It("counts the size and mtime ranges", func() {
+				res := search("", &searchsvc.FacetRequest{Field: engine.FacetSize}, &searchsvc.FacetRequest{Field: engine.FacetMtime})
+				Expect(res.Facets).To(HaveLen(2))
+				Expect(res.Facets[0].Values).To(HaveLen(5))
+				Expect(values(res.Facets[0])).To(Equal(map[string]int64{
+					"<100KB": 1, "100KB..1MB": 1, "1MB..10MB": 0, "10MB..100MB": 0, ">100MB": 0,
+				}))
+				Expect(values(res.Facets[1])).To(Equal(map[string]int64{
+					"today": 1, "last 7 days": 1, "last 30 days": 1, "older": 1,
+				}))
+			})
+
+			It("counts the spaces", func() {
+				res := search("", &searchsvc.FacetRequest{Field: engine.FacetSpace})
+				Expect(values(res.Facets[0])).To(Equal(map[string]int64{"1$2": 2}))
+			})
+
+			It("only counts the resources below the requested path", func() {
+				res := search("./parent d!r/child.png", &searchsvc.FacetRequest{Field: engine.FacetMediaType})
+				Expect(res.TotalMatches).To(Equal(int32(1)))
+				Expect(values(res.Facets[0])).To(Equal(map[string]int64{"image": 1}))
+			})
+
+			It("fails for unknown facets", func() {
+				_, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+					Query:  "name:*child*",
+					Facets: []*searchsvc.FacetRequest{{Field: "color"}},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("Upsert", func() {
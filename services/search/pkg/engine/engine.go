@@ -3,9 +3,12 @@ package engine
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/blevesearch/bleve/v2/search"
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	searchMessage "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
 	searchService "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
@@ -34,6 +37,7 @@ type Resource struct {
 	Path     string
 	ParentID string
 	Type     uint64
+	Etag     string
 	Deleted  bool
 	Hidden   bool
 }
@@ -45,6 +49,50 @@ func resourceIDtoSearchID(id storageProvider.ResourceId) *searchMessage.Resource
 		OpaqueId:  id.GetOpaqueId()}
 }
 
+// matchFromFields builds the search match from the flat fields of an indexed resource
+func matchFromFields(fields map[string]interface{}, score float64, highlights string) (*searchMessage.Match, error) {
+	rootID, err := storagespace.ParseID(getFieldValue[string](fields, "RootID"))
+	if err != nil {
+		return nil, err
+	}
+
+	rID, err := storagespace.ParseID(getFieldValue[string](fields, "ID"))
+	if err != nil {
+		return nil, err
+	}
+
+	pID, _ := storagespace.ParseID(getFieldValue[string](fields, "ParentID"))
+	match := &searchMessage.Match{
+		Score: float32(score),
+		Entity: &searchMessage.Entity{
+			Ref: &searchMessage.Reference{
+				ResourceId: resourceIDtoSearchID(rootID),
+				Path:       getFieldValue[string](fields, "Path"),
+			},
+			Id:         resourceIDtoSearchID(rID),
+			Name:       getFieldValue[string](fields, "Name"),
+			ParentId:   resourceIDtoSearchID(pID),
+			Size:       uint64(getFieldValue[float64](fields, "Size")),
+			Type:       uint64(getFieldValue[float64](fields, "Type")),
+			MimeType:   getFieldValue[string](fields, "MimeType"),
+			Deleted:    getFieldValue[bool](fields, "Deleted"),
+			Etag:       getFieldValue[string](fields, "Etag"),
+			Tags:       getFieldSliceValue[string](fields, "Tags"),
+			Highlights: highlights,
+			Audio:      getAudioValue[searchMessage.Audio](fields),
+			Image:      getImageValue[searchMessage.Image](fields),
+			Location:   getLocationValue[searchMessage.GeoCoordinates](fields),
+			Photo:      getPhotoValue[searchMessage.Photo](fields),
+		},
+	}
+
+	if mtime, err := time.Parse(time.RFC3339, getFieldValue[string](fields, "Mtime")); err == nil {
+		match.Entity.LastModifiedTime = &timestamppb.Timestamp{Seconds: mtime.Unix(), Nanos: int32(mtime.Nanosecond())}
+	}
+
+	return match, nil
+}
+
 func escapeQuery(s string) string {
 	return queryEscape.ReplaceAllString(s, "\\$1")
 }
@@ -0,0 +1,181 @@
+package engine
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/jinzhu/now"
+
+	searchService "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	searchQuery "github.com/owncloud/ocis/v2/services/search/pkg/query"
+)
+
+// The fields which can be counted along with a search
+const (
+	FacetMediaType = "mediatype"
+	FacetTags      = "tags"
+	FacetMtime     = "mtime"
+	FacetSize      = "size"
+	FacetSpace     = "space"
+)
+
+const (
+	// defaultFacetSize is the number of values returned for term facets if the request does not set a size
+	defaultFacetSize = 10
+	// facetTermsLimit limits the number of distinct mime types which are counted before they are categorized
+	facetTermsLimit = 1000
+	// mediaTypeOther is the category of all mime types which do not belong to a known category
+	mediaTypeOther = "other"
+)
+
+// sizeFacetRange is a bucket of the size facet, the lower bound is inclusive, the upper one exclusive.
+// A zero upper bound means the bucket is open.
+type sizeFacetRange struct {
+	Name     string
+	Min, Max float64
+}
+
+var sizeFacetRanges = []sizeFacetRange{
+	{Name: "<100KB", Min: 0, Max: 100 << 10},
+	{Name: "100KB..1MB", Min: 100 << 10, Max: 1 << 20},
+	{Name: "1MB..10MB", Min: 1 << 20, Max: 10 << 20},
+	{Name: "10MB..100MB", Min: 10 << 20, Max: 100 << 20},
+	{Name: ">100MB", Min: 100 << 20},
+}
+
+// mtimeFacetRange is a bucket of the mtime facet, zero bounds are open.
+type mtimeFacetRange struct {
+	Name       string
+	Start, End time.Time
+}
+
+// mtimeFacetRanges returns the buckets of the mtime facet relative to the given time.
+// The names match the KQL date ranges, so a bucket can be turned into a query restriction.
+func mtimeFacetRanges(t time.Time) []mtimeFacetRange {
+	n := now.With(t)
+	end := n.EndOfDay()
+	return []mtimeFacetRange{
+		{Name: "today", Start: n.BeginningOfDay(), End: end},
+		{Name: "last 7 days", Start: now.With(t.AddDate(0, 0, -6)).BeginningOfDay(), End: end},
+		{Name: "last 30 days", Start: now.With(t.AddDate(0, 0, -29)).BeginningOfDay(), End: end},
+		{Name: "older", End: now.With(t.AddDate(0, 0, -29)).BeginningOfDay()},
+	}
+}
+
+// validateFacets checks that all requested facets are supported
+func validateFacets(reqs []*searchService.FacetRequest) error {
+	for _, fr := range reqs {
+		switch fr.GetField() {
+		case FacetMediaType, FacetTags, FacetMtime, FacetSize, FacetSpace:
+		default:
+			return errtypes.BadRequest(fmt.Sprintf("unsupported facet '%s'", fr.GetField()))
+		}
+	}
+	return nil
+}
+
+func facetSize(fr *searchService.FacetRequest) int {
+	if fr.GetSize() > 0 {
+		return int(fr.GetSize())
+	}
+	return defaultFacetSize
+}
+
+// mediaTypeCategory returns the 'mediatype' category of the given mime type
+func mediaTypeCategory(mimeType string) string {
+	mimeType = strings.ToLower(mimeType)
+
+	categories := make([]string, 0, len(searchQuery.MediaTypes))
+	for c := range searchQuery.MediaTypes {
+		categories = append(categories, c)
+	}
+	sort.Strings(categories)
+
+	for _, c := range categories {
+		for _, pattern := range searchQuery.MediaTypes[c] {
+			if ok, _ := path.Match(pattern, mimeType); ok {
+				return c
+			}
+		}
+	}
+	return mediaTypeOther
+}
+
+// spaceFromRootID returns the space id of the given space root id, the id is returned unchanged if it can't be parsed
+func spaceFromRootID(rootID string) string {
+	id, err := storagespace.ParseID(rootID)
+	if err != nil {
+		return rootID
+	}
+	return storagespace.FormatStorageID(id.GetStorageId(), id.GetSpaceId())
+}
+
+// facetCounts holds the counted values per facet field
+type facetCounts map[string]map[string]int64
+
+func (fc facetCounts) add(field, value string, count int64) {
+	if fc[field] == nil {
+		fc[field] = map[string]int64{}
+	}
+	fc[field][value] += count
+}
+
+// buildFacets converts the counts into facets in the order of the requests.
+// Range facets contain all buckets in their natural order, term facets the values with the highest counts.
+func buildFacets(reqs []*searchService.FacetRequest, counts facetCounts) []*searchService.Facet {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	facets := make([]*searchService.Facet, 0, len(reqs))
+	for _, fr := range reqs {
+		facet := &searchService.Facet{Field: fr.GetField()}
+
+		switch fr.GetField() {
+		case FacetSize:
+			for _, r := range sizeFacetRanges {
+				facet.Values = append(facet.Values, &searchService.FacetValue{Value: r.Name, Count: counts[FacetSize][r.Name]})
+			}
+		case FacetMtime:
+			for _, r := range mtimeFacetRanges(time.Now()) {
+				facet.Values = append(facet.Values, &searchService.FacetValue{Value: r.Name, Count: counts[FacetMtime][r.Name]})
+			}
+		default:
+			for value, count := range counts[fr.GetField()] {
+				if count > 0 {
+					facet.Values = append(facet.Values, &searchService.FacetValue{Value: value, Count: count})
+				}
+			}
+			sort.Slice(facet.Values, func(i, j int) bool {
+				if facet.Values[i].Count == facet.Values[j].Count {
+					return facet.Values[i].Value < facet.Values[j].Value
+				}
+				return facet.Values[i].Count > facet.Values[j].Count
+			})
+			if size := facetSize(fr); len(facet.Values) > size {
+				facet.Values = facet.Values[:size]
+			}
+		}
+
+		facets = append(facets, facet)
+	}
+	return facets
+}
+
+// MergeFacets sums up the facets of several search responses, like the results of different spaces.
+func MergeFacets(reqs []*searchService.FacetRequest, facets ...[]*searchService.Facet) []*searchService.Facet {
+	counts := facetCounts{}
+	for _, ff := range facets {
+		for _, f := range ff {
+			for _, v := range f.GetValues() {
+				counts.add(f.GetField(), v.GetValue(), v.GetCount())
+			}
+		}
+	}
+	return buildFacets(reqs, counts)
+}
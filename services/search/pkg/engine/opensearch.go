@@ -0,0 +1,451 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+
+	searchMessage "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
+	searchService "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	searchQuery "github.com/owncloud/ocis/v2/services/search/pkg/query"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query/opensearch"
+)
+
+// openSearchMaxResults is the default max_result_window of OpenSearch, it limits requests for all results.
+// Further results are requested with the NextPageToken of the response.
+const openSearchMaxResults = 10000
+
+// openSearchMapping is the mapping of the index, it matches the bleve mapping as close as possible
+var openSearchMapping = opensearch.Query{
+	"settings": opensearch.Query{
+		"analysis": opensearch.Query{
+			"normalizer": opensearch.Query{
+				"lowercase": opensearch.Query{"type": "custom", "filter": []string{"lowercase"}},
+			},
+		},
+	},
+	"mappings": opensearch.Query{
+		"properties": opensearch.Query{
+			"ID":       opensearch.Query{"type": "keyword"},
+			"RootID":   opensearch.Query{"type": "keyword"},
+			"ParentID": opensearch.Query{"type": "keyword"},
+			"Path":     opensearch.Query{"type": "keyword"},
+			"Name":     opensearch.Query{"type": "keyword", "normalizer": "lowercase"},
+			"Tags":     opensearch.Query{"type": "keyword", "normalizer": "lowercase"},
+			"MimeType": opensearch.Query{"type": "keyword", "normalizer": "lowercase"},
+			"Title":    opensearch.Query{"type": "text"},
+			"Content":  opensearch.Query{"type": "text", "analyzer": "english"},
+			"Size":     opensearch.Query{"type": "long"},
+			"Type":     opensearch.Query{"type": "long"},
+			"Etag":     opensearch.Query{"type": "keyword"},
+			// resources without modification time have an empty string
+			"Mtime":   opensearch.Query{"type": "date", "ignore_malformed": true},
+			"Deleted": opensearch.Query{"type": "boolean"},
+			"Hidden":  opensearch.Query{"type": "boolean"},
+		},
+	},
+}
+
+// OpenSearch represents a search engine which stores and searches resources in an OpenSearch compatible server.
+type OpenSearch struct {
+	client       *http.Client
+	cfg          config.EngineOpenSearch
+	queryCreator searchQuery.Creator[opensearch.Query]
+}
+
+type openSearchHit struct {
+	Score     float64                `json:"_score"`
+	Source    map[string]interface{} `json:"_source"`
+	Highlight map[string][]string    `json:"highlight"`
+	Sort      []interface{}          `json:"sort"`
+}
+
+type openSearchBucket struct {
+	Key      interface{} `json:"key"`
+	DocCount int64       `json:"doc_count"`
+}
+
+type openSearchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []openSearchHit `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]struct {
+		Buckets []openSearchBucket `json:"buckets"`
+	} `json:"aggregations"`
+}
+
+// NewOpenSearchEngine creates a new OpenSearch instance. The index is created if it does not exist,
+// the mapping of the custom properties is added to the existing mapping.
+func NewOpenSearchEngine(cfg config.EngineOpenSearch, queryCreator searchQuery.Creator[opensearch.Query], properties ...config.CustomProperty) (*OpenSearch, error) {
+	o := &OpenSearch{
+		client: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.Insecure}, //nolint:gosec
+			},
+		},
+		cfg:          cfg,
+		queryCreator: queryCreator,
+	}
+	o.cfg.Address = strings.TrimSuffix(cfg.Address, "/")
+
+	err := o.do(context.Background(), http.MethodHead, "", nil, nil)
+	switch {
+	case err == nil:
+	case isNotFound(err):
+		if err := o.do(context.Background(), http.MethodPut, "", openSearchMapping, nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if len(properties) == 0 {
+		return o, nil
+	}
+	return o, o.do(context.Background(), http.MethodPut, "/_mapping", opensearch.Query{
+		"properties": opensearch.Query{propertiesField: openSearchProperties(properties)},
+	}, nil)
+}
+
+// Search executes a search request operation within the index.
+// Returns a SearchIndexResponse object or an error.
+func (o *OpenSearch) Search(ctx context.Context, sir *searchService.SearchIndexRequest) (*searchService.SearchIndexResponse, error) {
+	createdQuery, err := o.queryCreator.Create(sir.Query)
+	if err != nil {
+		if searchQuery.IsValidationError(err) {
+			return nil, errtypes.BadRequest(err.Error())
+		}
+		return nil, err
+	}
+	if err := validateFacets(sir.Facets); err != nil {
+		return nil, err
+	}
+
+	// skip documents that have been marked as deleted
+	filters := []opensearch.Query{term("Deleted", false)}
+	if sir.Ref != nil {
+		filters = append(filters, term("RootID", storagespace.FormatResourceID(
+			&storageProvider.ResourceId{
+				StorageId: sir.Ref.GetResourceId().GetStorageId(),
+				SpaceId:   sir.Ref.GetResourceId().GetSpaceId(),
+				OpaqueId:  sir.Ref.GetResourceId().GetOpaqueId(),
+			},
+		)))
+
+		if requestedPath := utils.MakeRelativePath(sir.Ref.Path); requestedPath != "." {
+			filters = append(filters, opensearch.Query{"bool": opensearch.Query{
+				"should": []opensearch.Query{
+					term("Path", requestedPath),
+					{"prefix": opensearch.Query{"Path": requestedPath + "/"}},
+				},
+				"minimum_should_match": 1,
+			}})
+		}
+	}
+
+	size := int(sir.PageSize)
+	switch {
+	case sir.PageSize == -1:
+		size = openSearchMaxResults
+	case sir.PageSize == 0:
+		size = 200
+	}
+
+	body := opensearch.Query{
+		"query": opensearch.Query{"bool": opensearch.Query{
+			"must":   []opensearch.Query{createdQuery},
+			"filter": filters,
+		}},
+		"size":             size,
+		"track_total_hits": true,
+		"highlight":        opensearch.Query{"fields": opensearch.Query{"Content": opensearch.Query{}}},
+		// the id breaks ties of the score, the hits have a stable order to continue after
+		"sort": []opensearch.Query{{"_score": "desc"}, {"ID": "asc"}},
+	}
+	if sir.PageToken != "" {
+		var after []interface{}
+		if err := json.Unmarshal([]byte(sir.PageToken), &after); err != nil {
+			return nil, errtypes.BadRequest("invalid page token")
+		}
+		body["search_after"] = after
+	}
+	if aggs := openSearchAggregations(sir.Facets, time.Now()); len(aggs) > 0 {
+		body["aggs"] = aggs
+	}
+
+	var res openSearchResponse
+	if err := o.do(ctx, http.MethodPost, "/_search", body, &res); err != nil {
+		return nil, err
+	}
+
+	matches := make([]*searchMessage.Match, 0, len(res.Hits.Hits))
+	for _, hit := range res.Hits.Hits {
+		var highlights string
+		if h := hit.Highlight["Content"]; len(h) > 0 {
+			highlights = h[0]
+		}
+
+		match, err := matchFromFields(flattenFields(hit.Source, "", map[string]interface{}{}), hit.Score, highlights)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	counts := facetCounts{}
+	for field, agg := range res.Aggregations {
+		for _, b := range agg.Buckets {
+			value := fmt.Sprint(b.Key)
+			switch field {
+			case FacetMediaType:
+				value = mediaTypeCategory(value)
+			case FacetSpace:
+				value = spaceFromRootID(value)
+			}
+			counts.add(field, value, b.DocCount)
+		}
+	}
+
+	// a full page might be followed by more hits
+	var next string
+	if n := len(res.Hits.Hits); n > 0 && n == size {
+		b, err := json.Marshal(res.Hits.Hits[n-1].Sort)
+		if err != nil {
+			return nil, err
+		}
+		next = string(b)
+	}
+
+	return &searchService.SearchIndexResponse{
+		Matches:       matches,
+		TotalMatches:  int32(res.Hits.Total.Value),
+		NextPageToken: next,
+		Facets:        buildFacets(sir.Facets, counts),
+	}, nil
+}
+
+// openSearchAggregations returns the aggregations which count the requested facets
+func openSearchAggregations(reqs []*searchService.FacetRequest, t time.Time) opensearch.Query {
+	aggs := opensearch.Query{}
+	for _, fr := range reqs {
+		switch fr.GetField() {
+		case FacetMediaType:
+			aggs[FacetMediaType] = opensearch.Query{"terms": opensearch.Query{"field": "MimeType", "size": facetTermsLimit}}
+		case FacetTags:
+			aggs[FacetTags] = opensearch.Query{"terms": opensearch.Query{"field": "Tags", "size": facetSize(fr)}}
+		case FacetSpace:
+			aggs[FacetSpace] = opensearch.Query{"terms": opensearch.Query{"field": "RootID", "size": facetSize(fr)}}
+		case FacetMtime:
+			ranges := make([]opensearch.Query, 0, 4)
+			for _, r := range mtimeFacetRanges(t) {
+				rq := opensearch.Query{"key": r.Name}
+				if !r.Start.IsZero() {
+					rq["from"] = r.Start.Format(time.RFC3339Nano)
+				}
+				if !r.End.IsZero() {
+					rq["to"] = r.End.Format(time.RFC3339Nano)
+				}
+				ranges = append(ranges, rq)
+			}
+			aggs[FacetMtime] = opensearch.Query{"date_range": opensearch.Query{"field": "Mtime", "ranges": ranges}}
+		case FacetSize:
+			ranges := make([]opensearch.Query, 0, len(sizeFacetRanges))
+			for _, r := range sizeFacetRanges {
+				rq := opensearch.Query{"key": r.Name, "from": r.Min}
+				if r.Max > 0 {
+					rq["to"] = r.Max
+				}
+				ranges = append(ranges, rq)
+			}
+			aggs[FacetSize] = opensearch.Query{"range": opensearch.Query{"field": "Size", "ranges": ranges}}
+		}
+	}
+	return aggs
+}
+
+// Upsert indexes or stores Resource data fields.
+func (o *OpenSearch) Upsert(id string, r Resource) error {
+	return o.do(context.Background(), http.MethodPut, "/_doc/"+url.PathEscape(id)+"?refresh=true", r, nil)
+}
+
+// Move updates the resource location and all of its necessary fields.
+func (o *OpenSearch) Move(id string, parentid string, target string) error {
+	r, err := o.getResource(id)
+	if err != nil {
+		return err
+	}
+	currentPath := r.Path
+	nextPath := utils.MakeRelativePath(target)
+
+	r.Path = nextPath
+	r.Name = path.Base(nextPath)
+	r.ParentID = parentid
+	if err := o.Upsert(id, *r); err != nil {
+		return err
+	}
+
+	if r.Type != uint64(storageProvider.ResourceType_RESOURCE_TYPE_CONTAINER) {
+		return nil
+	}
+	return o.updateChildren(r.RootID, currentPath, opensearch.Query{
+		"source": "ctx._source.Path = params.path + ctx._source.Path.substring(params.prefix.length())",
+		"params": opensearch.Query{"path": nextPath, "prefix": currentPath},
+	})
+}
+
+// Delete marks the resource as deleted.
+// The resource object will stay in the index,
+// instead of removing the resource it just marks it as deleted!
+// can be undone
+func (o *OpenSearch) Delete(id string) error {
+	return o.setDeleted(id, true)
+}
+
+// Restore is the counterpart to Delete.
+// It restores the resource which makes it available again.
+func (o *OpenSearch) Restore(id string) error {
+	return o.setDeleted(id, false)
+}
+
+// Purge removes a resource from the index, irreversible operation.
+func (o *OpenSearch) Purge(id string) error {
+	err := o.do(context.Background(), http.MethodDelete, "/_doc/"+url.PathEscape(id)+"?refresh=true", nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// DocCount returns the number of resources in the index.
+func (o *OpenSearch) DocCount() (uint64, error) {
+	var res struct {
+		Count uint64 `json:"count"`
+	}
+	if err := o.do(context.Background(), http.MethodGet, "/_count", nil, &res); err != nil {
+		return 0, err
+	}
+	return res.Count, nil
+}
+
+func (o *OpenSearch) getResource(id string) (*Resource, error) {
+	var res struct {
+		Source Resource `json:"_source"`
+	}
+	if err := o.do(context.Background(), http.MethodGet, "/_doc/"+url.PathEscape(id), nil, &res); err != nil {
+		return nil, err
+	}
+	return &res.Source, nil
+}
+
+func (o *OpenSearch) setDeleted(id string, deleted bool) error {
+	r, err := o.getResource(id)
+	if err != nil {
+		return err
+	}
+
+	if err := o.do(context.Background(), http.MethodPost, "/_update/"+url.PathEscape(id)+"?refresh=true", opensearch.Query{
+		"doc": opensearch.Query{"Deleted": deleted},
+	}, nil); err != nil {
+		return err
+	}
+
+	if r.Type != uint64(storageProvider.ResourceType_RESOURCE_TYPE_CONTAINER) {
+		return nil
+	}
+	return o.updateChildren(r.RootID, r.Path, opensearch.Query{
+		"source": "ctx._source.Deleted = params.deleted",
+		"params": opensearch.Query{"deleted": deleted},
+	})
+}
+
+// updateChildren runs the script on all resources below the given path
+func (o *OpenSearch) updateChildren(rootID, parentPath string, script opensearch.Query) error {
+	return o.do(context.Background(), http.MethodPost, "/_update_by_query?refresh=true&conflicts=proceed", opensearch.Query{
+		"query": opensearch.Query{"bool": opensearch.Query{
+			"filter": []opensearch.Query{
+				term("RootID", rootID),
+				{"prefix": opensearch.Query{"Path": parentPath + "/"}},
+			},
+		}},
+		"script": script,
+	}, nil)
+}
+
+// do sends the request to the index and decodes the response into out
+func (o *OpenSearch) do(ctx context.Context, method, p string, body, out interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, o.cfg.Address+"/"+url.PathEscape(o.cfg.Index)+p, r)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if o.cfg.Username != "" {
+		req.SetBasicAuth(o.cfg.Username, o.cfg.Password)
+	}
+
+	res, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return errtypes.NotFound(method + " " + p)
+	case res.StatusCode >= http.StatusBadRequest:
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return fmt.Errorf("opensearch: %s %s: %s: %s", method, p, res.Status, msg)
+	case out == nil:
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func isNotFound(err error) bool {
+	_, ok := err.(errtypes.IsNotFound)
+	return ok
+}
+
+func term(field string, value interface{}) opensearch.Query {
+	return opensearch.Query{"term": opensearch.Query{field: opensearch.Query{"value": value}}}
+}
+
+// flattenFields converts the nested source of a hit to the flat field names used by bleve, like 'audio.album'
+func flattenFields(source map[string]interface{}, prefix string, fields map[string]interface{}) map[string]interface{} {
+	for k, v := range source {
+		if nested, ok := v.(map[string]interface{}); ok {
+			flattenFields(nested, prefix+k+".", fields)
+			continue
+		}
+		fields[prefix+k] = v
+	}
+	return fields
+}
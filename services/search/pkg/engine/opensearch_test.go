@@ -0,0 +1,363 @@
+package engine_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	searchmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query/opensearch"
+)
+
+// openSearchStandIn is a minimal stand-in for the OpenSearch document API. It does not evaluate queries,
+// searches return all documents, the requests are recorded for inspection.
+type openSearchStandIn struct {
+	sync.Mutex
+	index    bool
+	mapping  map[string]interface{}
+	docs     map[string]map[string]interface{}
+	requests map[string]map[string]interface{}
+}
+
+func (s *openSearchStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	p := strings.TrimPrefix(r.URL.EscapedPath(), "/ocis-resources")
+	s.requests[r.Method+" "+strings.SplitN(p+"/", "/", 3)[1]] = body
+
+	reply := func(v interface{}) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	docID := func() string {
+		id, _ := url.PathUnescape(p[strings.LastIndex(p, "/")+1:])
+		return id
+	}
+
+	switch {
+	case p == "" && r.Method == http.MethodHead:
+		if !s.index {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case p == "" && r.Method == http.MethodPut:
+		s.index, s.mapping = true, body
+	case strings.HasPrefix(p, "/_doc/") && r.Method == http.MethodPut:
+		s.docs[docID()] = body
+	case strings.HasPrefix(p, "/_doc/") && r.Method == http.MethodGet:
+		doc, ok := s.docs[docID()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		reply(map[string]interface{}{"_source": doc})
+	case strings.HasPrefix(p, "/_doc/") && r.Method == http.MethodDelete:
+		if _, ok := s.docs[docID()]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.docs, docID())
+	case strings.HasPrefix(p, "/_update/"):
+		for k, v := range body["doc"].(map[string]interface{}) {
+			s.docs[docID()][k] = v
+		}
+	case p == "/_count":
+		reply(map[string]interface{}{"count": len(s.docs)})
+	case p == "/_search":
+		ids := make([]string, 0, len(s.docs))
+		for id := range s.docs {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		var after string
+		if sa, ok := body["search_after"].([]interface{}); ok && len(sa) == 2 {
+			after, _ = sa[1].(string)
+		}
+		size := len(ids)
+		if sz, ok := body["size"].(float64); ok && int(sz) < size {
+			size = int(sz)
+		}
+
+		hits := make([]interface{}, 0, len(s.docs))
+		for _, id := range ids {
+			if id <= after || len(hits) == size {
+				continue
+			}
+			hits = append(hits, map[string]interface{}{
+				"_score":    1.5,
+				"_source":   s.docs[id],
+				"highlight": map[string]interface{}{"Content": []string{"<mark>brown</mark> fox"}},
+				"sort":      []interface{}{1.5, id},
+			})
+		}
+		res := map[string]interface{}{"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": len(hits)},
+			"hits":  hits,
+		}}
+		if _, ok := body["aggs"]; ok {
+			res["aggregations"] = map[string]interface{}{
+				"mediatype": map[string]interface{}{"buckets": []interface{}{
+					map[string]interface{}{"key": "application/pdf", "doc_count": 3},
+					map[string]interface{}{"key": "image/png", "doc_count": 2},
+					map[string]interface{}{"key": "image/jpeg", "doc_count": 1},
+				}},
+				"size": map[string]interface{}{"buckets": []interface{}{
+					map[string]interface{}{"key": "<100KB", "doc_count": 4},
+					map[string]interface{}{"key": ">100MB", "doc_count": 2},
+				}},
+			}
+		}
+		reply(res)
+	case p == "/_mapping" && r.Method == http.MethodPut:
+	case p == "/_update_by_query":
+		reply(map[string]interface{}{"updated": 0})
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+var _ = Describe("OpenSearch", func() {
+	var (
+		eng     *engine.OpenSearch
+		standIn *openSearchStandIn
+		srv     *httptest.Server
+
+		parentResource engine.Resource
+		childResource  engine.Resource
+	)
+
+	BeforeEach(func() {
+		standIn = &openSearchStandIn{
+			docs:     map[string]map[string]interface{}{},
+			requests: map[string]map[string]interface{}{},
+		}
+		srv = httptest.NewServer(standIn)
+
+		var err error
+		eng, err = engine.NewOpenSearchEngine(config.EngineOpenSearch{
+			Address: srv.URL + "/",
+			Index:   "ocis-resources",
+		}, opensearch.DefaultCreator)
+		Expect(err).ToNot(HaveOccurred())
+
+		parentResource = engine.Resource{
+			ID:       "1$2!3",
+			ParentID: "1$2!2",
+			RootID:   "1$2!2",
+			Path:     "./parent d!r",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_CONTAINER),
+			Document: content.Document{Name: "parent d!r"},
+		}
+
+		childResource = engine.Resource{
+			ID:       "1$2!4",
+			ParentID: parentResource.ID,
+			RootID:   "1$2!2",
+			Path:     "./parent d!r/child.pdf",
+			Type:     uint64(sprovider.ResourceType_RESOURCE_TYPE_FILE),
+			Document: content.Document{
+				Name:     "child.pdf",
+				Size:     42,
+				MimeType: "application/pdf",
+				Tags:     []string{"invoice"},
+				Mtime:    "2023-09-05T08:42:11Z",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	Describe("NewOpenSearchEngine", func() {
+		It("creates the index with the mapping", func() {
+			Expect(standIn.index).To(BeTrue())
+			Expect(standIn.mapping).To(HaveKey("mappings"))
+			Expect(standIn.requests).ToNot(HaveKey("PUT _mapping"))
+		})
+
+		It("adds the mapping of custom properties", func() {
+			_, err := engine.NewOpenSearchEngine(config.EngineOpenSearch{
+				Address: srv.URL,
+				Index:   "ocis-resources",
+			}, opensearch.DefaultCreator, config.CustomProperty{Name: "project", Type: config.PropertyTypeKeyword})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(standIn.requests).To(HaveKeyWithValue("PUT _mapping", map[string]interface{}{
+				"properties": map[string]interface{}{
+					"properties": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"project": map[string]interface{}{"type": "keyword", "normalizer": "lowercase"},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("stores the resource", func() {
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+
+			count, err := eng.DocCount()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(1)))
+			Expect(standIn.docs[childResource.ID]["Name"]).To(Equal("child.pdf"))
+		})
+	})
+
+	Describe("Search", func() {
+		It("restricts the query to the reference and converts the hits", func() {
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+
+			res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+				Query: `name:"*child*"`,
+				Ref: &searchmsg.Reference{
+					ResourceId: &searchmsg.ResourceID{StorageId: "1", SpaceId: "2", OpaqueId: "2"},
+					Path:       "./parent d!r",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.TotalMatches).To(Equal(int32(1)))
+			Expect(res.Matches).To(HaveLen(1))
+
+			m := res.Matches[0]
+			Expect(m.Score).To(Equal(float32(1.5)))
+			Expect(m.Entity.Name).To(Equal("child.pdf"))
+			Expect(m.Entity.Id.OpaqueId).To(Equal("4"))
+			Expect(m.Entity.Ref.ResourceId.OpaqueId).To(Equal("2"))
+			Expect(m.Entity.Ref.Path).To(Equal("./parent d!r/child.pdf"))
+			Expect(m.Entity.Size).To(Equal(uint64(42)))
+			Expect(m.Entity.Tags).To(Equal([]string{"invoice"}))
+			Expect(m.Entity.Highlights).To(Equal("<mark>brown</mark> fox"))
+			Expect(m.Entity.LastModifiedTime.AsTime().Unix()).To(Equal(int64(1693903331)))
+
+			b, err := json.Marshal(standIn.requests["POST _search"])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring(`{"wildcard":{"Name":{"value":"*child*"}}}`))
+			Expect(string(b)).To(ContainSubstring(`{"term":{"Deleted":{"value":false}}}`))
+			Expect(string(b)).To(ContainSubstring(`{"term":{"RootID":{"value":"1$2!2"}}}`))
+			Expect(string(b)).To(ContainSubstring(`{"prefix":{"Path":"./parent d!r/"}}`))
+		})
+
+		It("continues after the last hit of a full page", func() {
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+
+			res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: "id:*", PageSize: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Matches).To(HaveLen(1))
+			Expect(res.Matches[0].Entity.Id.OpaqueId).To(Equal("3"))
+			Expect(res.NextPageToken).ToNot(BeEmpty())
+
+			res, err = eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: "id:*", PageSize: 1, PageToken: res.NextPageToken})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Matches).To(HaveLen(1))
+			Expect(res.Matches[0].Entity.Id.OpaqueId).To(Equal("4"))
+
+			res, err = eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: "id:*", PageSize: 1, PageToken: res.NextPageToken})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Matches).To(BeEmpty())
+			Expect(res.NextPageToken).To(BeEmpty())
+		})
+
+		It("rejects invalid page tokens", func() {
+			_, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: "id:*", PageToken: "{"})
+			Expect(err).To(MatchError(errtypes.BadRequest("invalid page token")))
+		})
+
+		It("counts the requested facets", func() {
+			res, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{
+				Query: "child",
+				Facets: []*searchsvc.FacetRequest{
+					{Field: engine.FacetMediaType},
+					{Field: engine.FacetSize},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Facets).To(HaveLen(2))
+			Expect(res.Facets[0].Values).To(Equal([]*searchsvc.FacetValue{
+				{Value: "image", Count: 3},
+				{Value: "pdf", Count: 3},
+			}))
+			Expect(res.Facets[1].Values).To(Equal([]*searchsvc.FacetValue{
+				{Value: "<100KB", Count: 4},
+				{Value: "100KB..1MB", Count: 0},
+				{Value: "1MB..10MB", Count: 0},
+				{Value: "10MB..100MB", Count: 0},
+				{Value: ">100MB", Count: 2},
+			}))
+
+			aggs := standIn.requests["POST _search"]["aggs"].(map[string]interface{})
+			Expect(aggs["mediatype"]).To(Equal(map[string]interface{}{"terms": map[string]interface{}{"field": "MimeType", "size": float64(1000)}}))
+			Expect(aggs["size"]).To(HaveKey("range"))
+		})
+
+		It("returns errors of the server", func() {
+			srv.Close()
+			_, err := eng.Search(context.Background(), &searchsvc.SearchIndexRequest{Query: "child"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Move", func() {
+		It("moves the resource and its children", func() {
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+			Expect(eng.Move(parentResource.ID, "1$2!5", "./new/parent")).To(Succeed())
+
+			Expect(standIn.docs[parentResource.ID]["Path"]).To(Equal("./new/parent"))
+			Expect(standIn.docs[parentResource.ID]["Name"]).To(Equal("parent"))
+			Expect(standIn.docs[parentResource.ID]["ParentID"]).To(Equal("1$2!5"))
+
+			Expect(standIn.requests).To(HaveKey("POST _update_by_query"))
+			script := standIn.requests["POST _update_by_query"]["script"].(map[string]interface{})
+			Expect(script["params"]).To(Equal(map[string]interface{}{"path": "./new/parent", "prefix": "./parent d!r"}))
+		})
+	})
+
+	Describe("Delete and Restore", func() {
+		It("marks the resource and its children", func() {
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+
+			Expect(eng.Delete(parentResource.ID)).To(Succeed())
+			Expect(standIn.docs[parentResource.ID]["Deleted"]).To(BeTrue())
+			script := standIn.requests["POST _update_by_query"]["script"].(map[string]interface{})
+			Expect(script["params"]).To(Equal(map[string]interface{}{"deleted": true}))
+
+			Expect(eng.Restore(parentResource.ID)).To(Succeed())
+			Expect(standIn.docs[parentResource.ID]["Deleted"]).To(BeFalse())
+		})
+
+		It("fails for unknown resources", func() {
+			Expect(eng.Delete("1$2!9")).ToNot(Succeed())
+		})
+	})
+
+	Describe("Purge", func() {
+		It("removes the resource", func() {
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+			Expect(eng.Purge(childResource.ID)).To(Succeed())
+			Expect(standIn.docs).To(BeEmpty())
+
+			// purging twice is fine
+			Expect(eng.Purge(childResource.ID)).To(Succeed())
+		})
+	})
+})
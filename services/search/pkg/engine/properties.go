@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/mapping"
+
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query/opensearch"
+)
+
+// propertiesField is the field of the document which holds the custom properties
+const propertiesField = "properties"
+
+// PropertyFields maps the KQL keys of the custom properties to their index fields,
+// the keys are lowercase because KQL keys are case-insensitive
+func PropertyFields(properties []config.CustomProperty) map[string]string {
+	fields := make(map[string]string, len(properties))
+	for _, p := range properties {
+		fields[strings.ToLower(p.Name)] = propertiesField + "." + p.Name
+	}
+	return fields
+}
+
+// bleveProperties returns the document mapping of the custom properties
+func bleveProperties(properties []config.CustomProperty) *mapping.DocumentMapping {
+	dm := bleve.NewDocumentMapping()
+	for _, p := range properties {
+		var fm *mapping.FieldMapping
+		switch p.Type {
+		case config.PropertyTypeNumber:
+			fm = bleve.NewNumericFieldMapping()
+		case config.PropertyTypeDate:
+			fm = bleve.NewDateTimeFieldMapping()
+		case config.PropertyTypeKeyword:
+			fm = bleve.NewTextFieldMapping()
+			fm.Analyzer = "lowercaseKeyword"
+		default:
+			fm = bleve.NewTextFieldMapping()
+			fm.Analyzer = "fulltext"
+		}
+		fm.IncludeInAll = false
+		dm.AddFieldMappingsAt(p.Name, fm)
+	}
+	return dm
+}
+
+// openSearchProperties returns the mapping of the custom properties
+func openSearchProperties(properties []config.CustomProperty) opensearch.Query {
+	mappings := opensearch.Query{}
+	for _, p := range properties {
+		switch p.Type {
+		case config.PropertyTypeNumber:
+			mappings[p.Name] = opensearch.Query{"type": "double"}
+		case config.PropertyTypeDate:
+			mappings[p.Name] = opensearch.Query{"type": "date", "ignore_malformed": true}
+		case config.PropertyTypeKeyword:
+			mappings[p.Name] = opensearch.Query{"type": "keyword", "normalizer": "lowercase"}
+		default:
+			mappings[p.Name] = opensearch.Query{"type": "text", "analyzer": "english"}
+		}
+	}
+	return opensearch.Query{"properties": mappings}
+}
+
+// getPropertiesValue collects the custom properties from the flat fields of an indexed resource
+func getPropertiesValue(fields map[string]interface{}) map[string]interface{} {
+	var properties map[string]interface{}
+	for k, v := range fields {
+		name, ok := strings.CutPrefix(k, propertiesField+".")
+		if !ok {
+			continue
+		}
+
+		if properties == nil {
+			properties = map[string]interface{}{}
+		}
+		properties[name] = v
+	}
+	return properties
+}
@@ -32,3 +32,8 @@ func (c Creator[T]) Create(qs string) (T, error) {
 
 // DefaultCreator exposes a kql to bleve query creator.
 var DefaultCreator = Creator[bQuery.Query]{kql.Builder{}, Compiler{}}
+
+// NewCreator returns a kql to bleve query creator which maps the given KQL keys to index fields.
+func NewCreator(fields map[string]string) Creator[bQuery.Query] {
+	return Creator[bQuery.Query]{kql.Builder{}, NewCompiler(fields)}
+}
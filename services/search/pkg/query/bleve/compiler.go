@@ -8,6 +8,7 @@ import (
 	bleveQuery "github.com/blevesearch/bleve/v2/search/query"
 	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
 	"github.com/owncloud/ocis/v2/ocis-pkg/kql"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query"
 )
 
 var _fields = map[string]string{
@@ -54,10 +55,19 @@ var bleveEscaper = strings.NewReplacer(
 )
 
 // Compiler represents a KQL query search string to the bleve query formatter.
-type Compiler struct{}
+type Compiler struct {
+	fields map[string]string
+}
+
+// NewCompiler returns a compiler which additionally maps the given KQL keys to index fields,
+// the keys must be lowercase.
+func NewCompiler(fields map[string]string) Compiler {
+	return Compiler{fields: fields}
+}
 
 // Compile implements the query formatter which converts the KQL query search string to the bleve query.
 func (c Compiler) Compile(givenAst *ast.Ast) (bleveQuery.Query, error) {
+	query.RenameKeys(givenAst.Nodes, c.fields)
 	q, err := compile(givenAst)
 	if err != nil {
 		return nil, err
@@ -96,12 +106,16 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 
 			var q bleveQuery.Query
 			var group bool
-			switch k {
-			case "MimeType":
+			switch {
+			case k == "MimeType":
 				q, group = mimeType(k, v)
 				if prev == nil {
 					isGroup = group
 				}
+			case k == "Content" && isPhrase(n.Value):
+				mq := bleveQuery.NewMatchPhraseQuery(strings.ToLower(n.Value))
+				mq.SetField(k)
+				q = mq
 			default:
 				q = bleveQuery.NewQueryStringQuery(k + ":" + v)
 			}
@@ -141,6 +155,54 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 				continue
 			}
 
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.NumericNode:
+			q := &bleveQuery.NumericRangeQuery{
+				FieldVal: getField(n.Key),
+			}
+
+			if n.Operator == nil {
+				continue
+			}
+
+			switch n.Operator.Value {
+			case ">":
+				q.Min = &n.Value
+				q.InclusiveMin = &[]bool{false}[0]
+			case ">=":
+				q.Min = &n.Value
+				q.InclusiveMin = &[]bool{true}[0]
+			case "<":
+				q.Max = &n.Value
+				q.InclusiveMax = &[]bool{false}[0]
+			case "<=":
+				q.Max = &n.Value
+				q.InclusiveMax = &[]bool{true}[0]
+			default:
+				continue
+			}
+
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.ProximityNode:
+			q := newProximityQuery(getProximityField(n.Key), n.Terms, n.Distance, n.Ordered)
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.RankNode:
+			q, err := rank(n)
+			if err != nil {
+				return nil, 0, err
+			}
 			if prev == nil {
 				prev = q
 			} else {
@@ -275,72 +337,68 @@ func getField(name string) string {
 	return name
 }
 
+// getProximityField returns the field of a proximity query, which only makes sense for tokenized text,
+// so the content is searched if no key is given
+func getProximityField(name string) string {
+	if name == "" {
+		return "Content"
+	}
+	return getField(name)
+}
+
+// isPhrase reports whether the value consists of several words and is not a wildcard pattern
+func isPhrase(v string) bool {
+	return strings.ContainsAny(strings.TrimSpace(v), " \t") && !strings.ContainsAny(v, "*?")
+}
+
 func normalizeGroupingProperty(group *ast.GroupNode) *ast.GroupNode {
 	for _, n := range group.Nodes {
-		if onode, ok := n.(*ast.StringNode); ok {
+		switch onode := n.(type) {
+		case *ast.StringNode:
+			onode.Key = group.Key
+		case *ast.ProximityNode:
 			onode.Key = group.Key
 		}
 	}
 	return group
 }
 
+// rank compiles a XRANK expression, all results match the first expression,
+// the ones which also match the second expression are boosted
+func rank(n *ast.RankNode) (bleveQuery.Query, error) {
+	match, _, err := walk(0, []ast.Node{n.Match})
+	if err != nil {
+		return nil, err
+	}
+
+	boost, _, err := walk(0, []ast.Node{n.Rank})
+	if err != nil {
+		return nil, err
+	}
+	if bq, ok := boost.(bleveQuery.BoostableQuery); ok {
+		bq.SetBoost(n.Boost)
+	}
+
+	q := bleve.NewBooleanQuery()
+	q.AddMust(match)
+	q.AddShould(boost)
+	return q, nil
+}
+
 func mimeType(k, v string) (bleveQuery.Query, bool) {
-	switch v {
-	case "file":
+	if v == "file" {
 		q := bleve.NewBooleanQuery()
-		q.AddMustNot(bleveQuery.NewQueryStringQuery(k + ":httpd/unix-directory"))
+		q.AddMustNot(bleveQuery.NewQueryStringQuery(k + ":" + query.MediaTypeFolder))
 		return q, false
-	case "folder":
-		return bleveQuery.NewQueryStringQuery(k + ":httpd/unix-directory"), false
-	case "document":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/msword",
-			"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
-			"application/vnd.openxmlformats-officedocument.wordprocessingml.form",
-			"application/vnd.oasis.opendocument.text",
-			"text/plain",
-			"text/markdown",
-			"application/rtf",
-			"application/vnd.apple.pages",
-		)), true
-	case "spreadsheet":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/vnd.ms-excel",
-			"application/vnd.oasis.opendocument.spreadsheet",
-			"text/csv",
-			"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
-			"application/vnd.oasis.opendocument.spreadsheet",
-			"application/vnd.apple.numbers",
-		)), true
-	case "presentation":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/vnd.openxmlformats-officedocument.presentationml.presentation",
-			"application/vnd.oasis.opendocument.presentation",
-			"application/vnd.ms-powerpoint",
-			"application/vnd.apple.keynote",
-		)), true
-	case "pdf":
-		return bleveQuery.NewQueryStringQuery(k + ":application/pdf"), false
-	case "image":
-		return bleveQuery.NewQueryStringQuery(k + ":image/*"), false
-	case "video":
-		return bleveQuery.NewQueryStringQuery(k + ":video/*"), false
-	case "audio":
-		return bleveQuery.NewQueryStringQuery(k + ":audio/*"), false
-	case "archive":
-		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k,
-			"application/zip",
-			"application/gzip",
-			"application/x-gzip",
-			"application/x-7z-compressed",
-			"application/x-rar-compressed",
-			"application/x-tar",
-			"application/x-bzip2",
-			"application/x-bzip",
-			"application/x-tgz",
-		)), true
-	default:
+	}
+
+	switch mimeTypes := query.MediaTypes[v]; len(mimeTypes) {
+	case 0:
 		return bleveQuery.NewQueryStringQuery(k + ":" + v), false
+	case 1:
+		return bleveQuery.NewQueryStringQuery(k + ":" + mimeTypes[0]), false
+	default:
+		return bleveQuery.NewDisjunctionQuery(newQueryStringQueryList(k, mimeTypes...)), true
 	}
 }
 
@@ -385,6 +385,118 @@ func Test_compile(t *testing.T) {
 			}),
 			wantErr: false,
 		},
+		{
+			name: `ast.NumericNode`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{
+						Key: "size",
+						// ":" is not supported by bleve, ignore
+						Operator: &ast.OperatorNode{Value: ":"},
+						Value:    1024,
+					},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.NumericNode{
+						Key:      "size",
+						Operator: &ast.OperatorNode{Value: ">"},
+						Value:    1024,
+					},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: ">="},
+							Value:    1024,
+						},
+						&ast.OperatorNode{Value: "AND"},
+						&ast.NumericNode{
+							Key:      "size",
+							Operator: &ast.OperatorNode{Value: "<="},
+							Value:    5242880,
+						},
+					}},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					q := query.NewNumericRangeInclusiveQuery(&[]float64{1024}[0], nil, &[]bool{false}[0], nil)
+					q.FieldVal = "Size"
+					return q
+				}(),
+				query.NewConjunctionQuery([]query.Query{
+					func() query.Query {
+						q := query.NewNumericRangeInclusiveQuery(&[]float64{1024}[0], nil, &[]bool{true}[0], nil)
+						q.FieldVal = "Size"
+						return q
+					}(),
+					func() query.Query {
+						q := query.NewNumericRangeInclusiveQuery(nil, &[]float64{5242880}[0], nil, &[]bool{true}[0])
+						q.FieldVal = "Size"
+						return q
+					}(),
+				}),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `content:"Brown Fox" content:fed*`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "Brown Fox"},
+					&ast.OperatorNode{Value: "OR"},
+					&ast.StringNode{Key: "content", Value: "fed*"},
+				},
+			},
+			want: query.NewDisjunctionQuery([]query.Query{
+				func() query.Query {
+					q := query.NewMatchPhraseQuery("brown fox")
+					q.SetField("Content")
+					return q
+				}(),
+				query.NewQueryStringQuery(`Content:fed*`),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `content:(acquisition NEAR(n=3) debt) OR cat ONEAR dog`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Key: "content", Nodes: []ast.Node{
+						&ast.ProximityNode{Terms: []string{"acquisition", "debt"}, Distance: 3},
+					}},
+					&ast.OperatorNode{Value: "OR"},
+					&ast.ProximityNode{Terms: []string{"cat", "dog"}, Distance: 100, Ordered: true},
+				},
+			},
+			want: query.NewDisjunctionQuery([]query.Query{
+				&proximityQuery{Terms: []string{"acquisition", "debt"}, Field: "Content", Distance: 3},
+				&proximityQuery{Terms: []string{"cat", "dog"}, Field: "Content", Distance: 32, Ordered: true},
+			}),
+			wantErr: false,
+		},
+		{
+			name: `invoice XRANK(cb=2) tag:important`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Match: &ast.StringNode{Value: "invoice"},
+						Rank:  &ast.StringNode{Key: "tag", Value: "important"},
+						Boost: 2,
+					},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					rank := query.NewQueryStringQuery(`Tags:important`)
+					rank.SetBoost(2)
+					q := query.NewBooleanQuery(nil, nil, nil)
+					q.AddMust(query.NewQueryStringQuery(`Name:invoice`))
+					q.AddShould(rank)
+					return q
+				}(),
+			}),
+			wantErr: false,
+		},
 		{
 			name: `MimeType:document`,
 			args: &ast.Ast{
@@ -542,6 +654,24 @@ func Test_compile(t *testing.T) {
 	}
 }
 
+func TestCompiler_fields(t *testing.T) {
+	c := NewCompiler(map[string]string{"project": "properties.Project"})
+
+	got, err := c.Compile(&ast.Ast{
+		Nodes: []ast.Node{
+			&ast.StringNode{Key: "Project", Value: "Apollo"},
+			&ast.OperatorNode{Value: "AND"},
+			&ast.StringNode{Key: "name", Value: "moon"},
+		},
+	})
+
+	tAssert.NoError(t, err)
+	tAssert.Equal(t, query.NewConjunctionQuery([]query.Query{
+		query.NewQueryStringQuery(`properties.Project:apollo`),
+		query.NewQueryStringQuery(`Name:moon`),
+	}), got)
+}
+
 func Test_escape(t *testing.T) {
 	type args struct {
 		str string
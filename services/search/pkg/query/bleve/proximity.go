@@ -0,0 +1,105 @@
+package bleve
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search"
+	bleveQuery "github.com/blevesearch/bleve/v2/search/query"
+	index "github.com/blevesearch/bleve_index_api"
+)
+
+// _maxProximityDistance limits the distance of proximity queries, each possible gap results in a phrase query
+const _maxProximityDistance = 32
+
+// proximityQuery finds documents where the terms occur within the given distance of each other.
+// bleve has no sloppy phrases, but phrase queries accept empty terms which match any term at their position.
+// The query therefore tries a phrase for every gap size up to the distance, and for both orders if the
+// terms are not ordered. The terms are analyzed like the field, a term with several words is matched as phrase.
+type proximityQuery struct {
+	Terms    []string
+	Field    string
+	Distance int
+	Ordered  bool
+}
+
+func newProximityQuery(field string, terms []string, distance int, ordered bool) *proximityQuery {
+	if distance > _maxProximityDistance {
+		distance = _maxProximityDistance
+	}
+
+	return &proximityQuery{
+		Terms:    terms,
+		Field:    field,
+		Distance: distance,
+		Ordered:  ordered,
+	}
+}
+
+// Searcher implements the bleve query interface
+func (q *proximityQuery) Searcher(ctx context.Context, i index.IndexReader, m mapping.IndexMapping, options search.SearcherOptions) (search.Searcher, error) {
+	analyzerName := m.AnalyzerNameForPath(q.Field)
+	analyzer := m.AnalyzerNamed(analyzerName)
+	if analyzer == nil {
+		return nil, fmt.Errorf("no analyzer named '%s' registered", analyzerName)
+	}
+
+	if len(q.Terms) != 2 {
+		return nil, fmt.Errorf("proximity query needs two terms, got %d", len(q.Terms))
+	}
+
+	left := analyzePhrase(analyzer, q.Terms[0])
+	right := analyzePhrase(analyzer, q.Terms[1])
+	if len(left) == 0 || len(right) == 0 {
+		return bleveQuery.NewMatchNoneQuery().Searcher(ctx, i, m, options)
+	}
+
+	var phrases []bleveQuery.Query
+	for gap := 0; gap <= q.Distance; gap++ {
+		phrases = append(phrases, bleveQuery.NewMultiPhraseQuery(gapPhrase(left, right, gap), q.Field))
+		if !q.Ordered {
+			phrases = append(phrases, bleveQuery.NewMultiPhraseQuery(gapPhrase(right, left, gap), q.Field))
+		}
+	}
+
+	return bleveQuery.NewDisjunctionQuery(phrases).Searcher(ctx, i, m, options)
+}
+
+// analyzePhrase returns the terms per position of the analyzed text, positions without term are empty
+func analyzePhrase(analyzer analysis.Analyzer, text string) [][]string {
+	tokens := analyzer.Analyze([]byte(text))
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	first, last := tokens[0].Position, tokens[0].Position
+	for _, token := range tokens {
+		first = min(first, token.Position)
+		last = max(last, token.Position)
+	}
+
+	phrase := make([][]string, last-first+1)
+	for _, token := range tokens {
+		phrase[token.Position-first] = append(phrase[token.Position-first], string(token.Term))
+	}
+	for i := range phrase {
+		if len(phrase[i]) == 0 {
+			phrase[i] = []string{""}
+		}
+	}
+
+	return phrase
+}
+
+// gapPhrase joins both phrases with the given number of positions in between which match any term
+func gapPhrase(first, second [][]string, gap int) [][]string {
+	phrase := make([][]string, 0, len(first)+gap+len(second))
+	phrase = append(phrase, first...)
+	for i := 0; i < gap; i++ {
+		phrase = append(phrase, []string{""})
+	}
+
+	return append(phrase, second...)
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
+)
+
+// RenameKeys replaces the keys of the given nodes with the mapped ones, the lookup is case-insensitive.
+// It is used for keys which are not known to the compilers, like the ones of custom properties.
+func RenameKeys(nodes []ast.Node, keys map[string]string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	rename := func(key *string) {
+		if k, ok := keys[strings.ToLower(*key)]; ok {
+			*key = k
+		}
+	}
+
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *ast.StringNode:
+			rename(&n.Key)
+		case *ast.BooleanNode:
+			rename(&n.Key)
+		case *ast.DateTimeNode:
+			rename(&n.Key)
+		case *ast.NumericNode:
+			rename(&n.Key)
+		case *ast.ProximityNode:
+			rename(&n.Key)
+		case *ast.GroupNode:
+			rename(&n.Key)
+			RenameKeys(n.Nodes, keys)
+		case *ast.RankNode:
+			RenameKeys([]ast.Node{n.Match, n.Rank}, keys)
+		}
+	}
+}
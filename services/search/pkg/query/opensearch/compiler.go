@@ -0,0 +1,322 @@
+package opensearch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
+	"github.com/owncloud/ocis/v2/ocis-pkg/kql"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query"
+)
+
+var _fields = map[string]string{
+	"rootid":    "RootID",
+	"path":      "Path",
+	"id":        "ID",
+	"name":      "Name",
+	"size":      "Size",
+	"mtime":     "Mtime",
+	"mediatype": "MimeType",
+	"type":      "Type",
+	"tag":       "Tags",
+	"tags":      "Tags",
+	"content":   "Content",
+	"hidden":    "Hidden",
+}
+
+// _lowercaseFields are the keyword fields which are normalized to lowercase in the index
+var _lowercaseFields = map[string]bool{
+	"Name":     true,
+	"Tags":     true,
+	"MimeType": true,
+}
+
+// Query is a query of the OpenSearch query DSL
+type Query map[string]interface{}
+
+// Compiler represents a KQL query search string to the OpenSearch query DSL formatter.
+type Compiler struct {
+	fields map[string]string
+}
+
+// NewCompiler returns a compiler which additionally maps the given KQL keys to index fields,
+// the keys must be lowercase.
+func NewCompiler(fields map[string]string) Compiler {
+	return Compiler{fields: fields}
+}
+
+// Compile implements the query formatter which converts the KQL query search string to the OpenSearch query DSL.
+func (c Compiler) Compile(givenAst *ast.Ast) (Query, error) {
+	query.RenameKeys(givenAst.Nodes, c.fields)
+	return c.compile(givenAst.Nodes)
+}
+
+// compile translates the nodes into a boolean query. NOT applies to the following node,
+// AND binds stronger than OR like in the bleve compiler.
+func (c Compiler) compile(nodes []ast.Node) (Query, error) {
+	var (
+		disjuncts [][]Query
+		conjuncts []Query
+		negate    bool
+	)
+
+	for _, node := range nodes {
+		var q Query
+		switch n := node.(type) {
+		case *ast.OperatorNode:
+			switch n.Value {
+			case kql.BoolOR:
+				if len(conjuncts) > 0 {
+					disjuncts = append(disjuncts, conjuncts)
+					conjuncts = nil
+				}
+			case kql.BoolNOT:
+				negate = !negate
+			}
+			continue
+		case *ast.StringNode:
+			q = c.stringQuery(n)
+		case *ast.BooleanNode:
+			q = term(getField(n.Key), n.Value)
+		case *ast.DateTimeNode:
+			if n.Operator == nil {
+				continue
+			}
+			var ok bool
+			if q, ok = dateTimeQuery(n); !ok {
+				continue
+			}
+		case *ast.NumericNode:
+			if n.Operator == nil {
+				continue
+			}
+			var ok bool
+			if q, ok = numericQuery(n); !ok {
+				continue
+			}
+		case *ast.ProximityNode:
+			q = proximityQuery(n)
+		case *ast.RankNode:
+			var err error
+			if q, err = c.rankQuery(n); err != nil {
+				return nil, err
+			}
+		case *ast.GroupNode:
+			if n.Key != "" {
+				n = normalizeGroupingProperty(n)
+			}
+			var err error
+			if q, err = c.compile(n.Nodes); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported node '%T'", node)
+		}
+
+		if negate {
+			q = Query{"bool": Query{"must_not": []Query{q}}}
+			negate = false
+		}
+		conjuncts = append(conjuncts, q)
+	}
+
+	if len(conjuncts) > 0 {
+		disjuncts = append(disjuncts, conjuncts)
+	}
+	if len(disjuncts) == 0 {
+		return nil, fmt.Errorf("can not compile the query")
+	}
+
+	should := make([]Query, 0, len(disjuncts))
+	for _, d := range disjuncts {
+		if len(d) == 1 {
+			should = append(should, d[0])
+			continue
+		}
+		should = append(should, Query{"bool": Query{"must": d}})
+	}
+	if len(should) == 1 {
+		return should[0], nil
+	}
+	return Query{"bool": Query{"should": should, "minimum_should_match": 1}}, nil
+}
+
+func (c Compiler) stringQuery(n *ast.StringNode) Query {
+	k := getField(n.Key)
+	v := n.Value
+	if _lowercaseFields[k] {
+		v = strings.ToLower(v)
+	}
+
+	switch {
+	case c.isMappedField(k):
+		return mappedFieldQuery(k, v)
+	case k == "Content" && isPhrase(v):
+		return Query{"match_phrase": Query{k: Query{"query": v}}}
+	case k == "Content" && isPrefix(v):
+		return Query{"match_phrase_prefix": Query{k: Query{"query": strings.TrimSuffix(v, "*")}}}
+	case k == "Content":
+		return Query{"match": Query{k: Query{"query": v, "operator": "and"}}}
+	case k == "MimeType":
+		return mimeType(k, v)
+	default:
+		return match(k, v)
+	}
+}
+
+// proximityQuery uses an intervals query, the terms are analyzed like the field and terms with several words are phrases.
+// Without key, the content is searched because proximity only makes sense for tokenized text.
+func proximityQuery(n *ast.ProximityNode) Query {
+	k := "Content"
+	if n.Key != "" {
+		k = getField(n.Key)
+	}
+
+	intervals := make([]Query, 0, len(n.Terms))
+	for _, t := range n.Terms {
+		intervals = append(intervals, Query{"match": Query{"query": t, "max_gaps": 0, "ordered": true}})
+	}
+
+	return Query{"intervals": Query{k: Query{"all_of": Query{
+		"intervals": intervals,
+		"max_gaps":  n.Distance,
+		"ordered":   n.Ordered,
+	}}}}
+}
+
+// rankQuery compiles a XRANK expression, all results match the first expression,
+// the ones which also match the second expression are boosted
+func (c Compiler) rankQuery(n *ast.RankNode) (Query, error) {
+	match, err := c.compile([]ast.Node{n.Match})
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := c.compile([]ast.Node{n.Rank})
+	if err != nil {
+		return nil, err
+	}
+
+	return Query{"bool": Query{
+		"must":   []Query{match},
+		"should": []Query{{"bool": Query{"must": []Query{rank}, "boost": n.Boost}}},
+	}}, nil
+}
+
+func dateTimeQuery(n *ast.DateTimeNode) (Query, bool) {
+	op, ok := rangeOperator(n.Operator)
+	if !ok {
+		return nil, false
+	}
+
+	return Query{"range": Query{getField(n.Key): Query{op: n.Value.Format(time.RFC3339Nano)}}}, true
+}
+
+func numericQuery(n *ast.NumericNode) (Query, bool) {
+	op, ok := rangeOperator(n.Operator)
+	if !ok {
+		return nil, false
+	}
+
+	return Query{"range": Query{getField(n.Key): Query{op: n.Value}}}, true
+}
+
+// rangeOperator returns the range query parameter of the comparison operator
+func rangeOperator(o *ast.OperatorNode) (string, bool) {
+	switch o.Value {
+	case ">":
+		return "gt", true
+	case ">=":
+		return "gte", true
+	case "<":
+		return "lt", true
+	case "<=":
+		return "lte", true
+	default:
+		return "", false
+	}
+}
+
+func mimeType(k, v string) Query {
+	if v == "file" {
+		return Query{"bool": Query{"must_not": []Query{term(k, query.MediaTypeFolder)}}}
+	}
+
+	mimeTypes, ok := query.MediaTypes[v]
+	if !ok {
+		return match(k, v)
+	}
+	if len(mimeTypes) == 1 {
+		return match(k, mimeTypes[0])
+	}
+
+	should := make([]Query, 0, len(mimeTypes))
+	for _, m := range mimeTypes {
+		should = append(should, match(k, m))
+	}
+	return Query{"bool": Query{"should": should, "minimum_should_match": 1}}
+}
+
+// match returns a wildcard query if the value contains wildcards, an exact term query otherwise
+func match(k, v string) Query {
+	if strings.ContainsAny(v, "*?") {
+		return Query{"wildcard": Query{k: Query{"value": v}}}
+	}
+	return term(k, v)
+}
+
+func term(k string, v interface{}) Query {
+	return Query{"term": Query{k: Query{"value": v}}}
+}
+
+func getField(name string) string {
+	if name == "" {
+		return "Name"
+	}
+	if _, ok := _fields[strings.ToLower(name)]; ok {
+		return _fields[strings.ToLower(name)]
+	}
+	return name
+}
+
+// isMappedField reports whether the field is one of the additionally mapped fields, like custom properties
+func (c Compiler) isMappedField(k string) bool {
+	for _, f := range c.fields {
+		if f == k {
+			return true
+		}
+	}
+	return false
+}
+
+// mappedFieldQuery returns a match query which works for all field types, the value is analyzed like the field.
+// Wildcards ignore the case because the type of the field is unknown.
+func mappedFieldQuery(k, v string) Query {
+	if strings.ContainsAny(v, "*?") {
+		return Query{"wildcard": Query{k: Query{"value": v, "case_insensitive": true}}}
+	}
+	return Query{"match": Query{k: Query{"query": v, "operator": "and"}}}
+}
+
+// isPhrase reports whether the value consists of several words and is not a wildcard pattern
+func isPhrase(v string) bool {
+	return strings.ContainsAny(strings.TrimSpace(v), " \t") && !strings.ContainsAny(v, "*?")
+}
+
+// isPrefix reports whether the only wildcard of the value is a trailing '*', like in 'fed*'
+func isPrefix(v string) bool {
+	return strings.HasSuffix(v, "*") && !strings.ContainsAny(strings.TrimSuffix(v, "*"), "*?")
+}
+
+func normalizeGroupingProperty(group *ast.GroupNode) *ast.GroupNode {
+	for _, n := range group.Nodes {
+		switch onode := n.(type) {
+		case *ast.StringNode:
+			onode.Key = group.Key
+		case *ast.ProximityNode:
+			onode.Key = group.Key
+		}
+	}
+	return group
+}
@@ -0,0 +1,286 @@
+package opensearch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/ast"
+	tAssert "github.com/stretchr/testify/assert"
+)
+
+func Test_compile(t *testing.T) {
+	mtime := time.Date(2023, 9, 5, 8, 42, 11, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    *ast.Ast
+		want    Query
+		wantErr bool
+	}{
+		{
+			name: `federated`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "federated"},
+				},
+			},
+			want: Query{"term": Query{"Name": Query{"value": "federated"}}},
+		},
+		{
+			name: `name:"*John Smith*"`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "name", Value: "*John Smith*"},
+				},
+			},
+			want: Query{"wildcard": Query{"Name": Query{"value": "*john smith*"}}},
+		},
+		{
+			name: `content:"brown fox"`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "brown fox"},
+				},
+			},
+			want: Query{"match_phrase": Query{"Content": Query{"query": "brown fox"}}},
+		},
+		{
+			name: `content:fed*`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "fed*"},
+				},
+			},
+			want: Query{"match_phrase_prefix": Query{"Content": Query{"query": "fed"}}},
+		},
+		{
+			name: `acquisition ONEAR(n=3) "debt ratio"`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Terms: []string{"acquisition", "debt ratio"}, Distance: 3, Ordered: true},
+				},
+			},
+			want: Query{"intervals": Query{"Content": Query{"all_of": Query{
+				"intervals": []Query{
+					{"match": Query{"query": "acquisition", "max_gaps": 0, "ordered": true}},
+					{"match": Query{"query": "debt ratio", "max_gaps": 0, "ordered": true}},
+				},
+				"max_gaps": 3,
+				"ordered":  true,
+			}}}},
+		},
+		{
+			name: `invoice XRANK(cb=2) tag:important`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.RankNode{
+						Match: &ast.StringNode{Value: "invoice"},
+						Rank:  &ast.StringNode{Key: "tag", Value: "important"},
+						Boost: 2,
+					},
+				},
+			},
+			want: Query{"bool": Query{
+				"must": []Query{{"term": Query{"Name": Query{"value": "invoice"}}}},
+				"should": []Query{{"bool": Query{
+					"must":  []Query{{"term": Query{"Tags": Query{"value": "important"}}}},
+					"boost": 2.0,
+				}}},
+			}},
+		},
+		{
+			name: `tag:bestseller tag:book`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "tag", Value: "bestseller"},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.StringNode{Key: "tag", Value: "book"},
+				},
+			},
+			want: Query{"bool": Query{"must": []Query{
+				{"term": Query{"Tags": Query{"value": "bestseller"}}},
+				{"term": Query{"Tags": Query{"value": "book"}}},
+			}}},
+		},
+		{
+			name: `a OR b AND c`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "a"},
+					&ast.OperatorNode{Value: "OR"},
+					&ast.StringNode{Value: "b"},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.StringNode{Value: "c"},
+				},
+			},
+			want: Query{"bool": Query{
+				"should": []Query{
+					{"term": Query{"Name": Query{"value": "a"}}},
+					{"bool": Query{"must": []Query{
+						{"term": Query{"Name": Query{"value": "b"}}},
+						{"term": Query{"Name": Query{"value": "c"}}},
+					}}},
+				},
+				"minimum_should_match": 1,
+			}},
+		},
+		{
+			name: `NOT a AND (b OR c)`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.OperatorNode{Value: "NOT"},
+					&ast.StringNode{Value: "a"},
+					&ast.OperatorNode{Value: "AND"},
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.StringNode{Value: "b"},
+						&ast.OperatorNode{Value: "OR"},
+						&ast.StringNode{Value: "c"},
+					}},
+				},
+			},
+			want: Query{"bool": Query{"must": []Query{
+				{"bool": Query{"must_not": []Query{{"term": Query{"Name": Query{"value": "a"}}}}}},
+				{"bool": Query{
+					"should": []Query{
+						{"term": Query{"Name": Query{"value": "b"}}},
+						{"term": Query{"Name": Query{"value": "c"}}},
+					},
+					"minimum_should_match": 1,
+				}},
+			}}},
+		},
+		{
+			name: `author:("John Smith" OR "Jane")`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Key: "author", Nodes: []ast.Node{
+						&ast.StringNode{Value: "John Smith"},
+						&ast.OperatorNode{Value: "OR"},
+						&ast.StringNode{Value: "Jane"},
+					}},
+				},
+			},
+			want: Query{"bool": Query{
+				"should": []Query{
+					{"term": Query{"author": Query{"value": "John Smith"}}},
+					{"term": Query{"author": Query{"value": "Jane"}}},
+				},
+				"minimum_should_match": 1,
+			}},
+		},
+		{
+			name: `mtime>=2023-09-05T08:42:11Z`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.DateTimeNode{Key: "Mtime", Operator: &ast.OperatorNode{Value: ">="}, Value: mtime},
+				},
+			},
+			want: Query{"range": Query{"Mtime": Query{"gte": "2023-09-05T08:42:11Z"}}},
+		},
+		{
+			name: `size:1KB..5MB`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{Nodes: []ast.Node{
+						&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1024},
+						&ast.OperatorNode{Value: "AND"},
+						&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<="}, Value: 5242880},
+					}},
+				},
+			},
+			want: Query{"bool": Query{"must": []Query{
+				{"range": Query{"Size": Query{"gte": float64(1024)}}},
+				{"range": Query{"Size": Query{"lte": float64(5242880)}}},
+			}}},
+		},
+		{
+			name: `hidden:true`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.BooleanNode{Key: "hidden", Value: true},
+				},
+			},
+			want: Query{"term": Query{"Hidden": Query{"value": true}}},
+		},
+		{
+			name: `mediatype:file`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "mediatype", Value: "file"},
+				},
+			},
+			want: Query{"bool": Query{"must_not": []Query{{"term": Query{"MimeType": Query{"value": "httpd/unix-directory"}}}}}},
+		},
+		{
+			name: `mediatype:image`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "mediatype", Value: "image"},
+				},
+			},
+			want: Query{"wildcard": Query{"MimeType": Query{"value": "image/*"}}},
+		},
+		{
+			name: `mediatype:presentation`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "mediatype", Value: "presentation"},
+				},
+			},
+			want: Query{"bool": Query{
+				"should": []Query{
+					{"term": Query{"MimeType": Query{"value": "application/vnd.openxmlformats-officedocument.presentationml.presentation"}}},
+					{"term": Query{"MimeType": Query{"value": "application/vnd.oasis.opendocument.presentation"}}},
+					{"term": Query{"MimeType": Query{"value": "application/vnd.ms-powerpoint"}}},
+					{"term": Query{"MimeType": Query{"value": "application/vnd.apple.keynote"}}},
+				},
+				"minimum_should_match": 1,
+			}},
+		},
+		{
+			name: `only operators`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.OperatorNode{Value: "NOT"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	assert := tAssert.New(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compiler{}.Compile(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestCompiler_fields(t *testing.T) {
+	c := NewCompiler(map[string]string{"project": "properties.Project", "retention": "properties.Retention"})
+
+	got, err := c.Compile(&ast.Ast{
+		Nodes: []ast.Node{
+			&ast.StringNode{Key: "Project", Value: "Apollo 11"},
+			&ast.OperatorNode{Value: "AND"},
+			&ast.GroupNode{Key: "project", Nodes: []ast.Node{
+				&ast.StringNode{Value: "gem*"},
+			}},
+			&ast.OperatorNode{Value: "AND"},
+			&ast.NumericNode{Key: "retention", Operator: &ast.OperatorNode{Value: ">="}, Value: 5},
+		},
+	})
+
+	tAssert.NoError(t, err)
+	tAssert.Equal(t, Query{"bool": Query{"must": []Query{
+		{"match": Query{"properties.Project": Query{"query": "Apollo 11", "operator": "and"}}},
+		{"wildcard": Query{"properties.Project": Query{"value": "gem*", "case_insensitive": true}}},
+		{"range": Query{"properties.Retention": Query{"gte": 5.0}}},
+	}}}, got)
+}
@@ -0,0 +1,31 @@
+// Package opensearch provides the ability to work with queries of OpenSearch compatible search engines.
+package opensearch
+
+import (
+	"github.com/owncloud/ocis/v2/ocis-pkg/kql"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query"
+)
+
+// Creator combines a Builder and a Compiler which is used to Create the query.
+type Creator struct {
+	builder  query.Builder
+	compiler query.Compiler[Query]
+}
+
+// Create implements the Creator interface
+func (c Creator) Create(qs string) (Query, error) {
+	builderAst, err := c.builder.Build(qs)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.compiler.Compile(builderAst)
+}
+
+// DefaultCreator exposes a kql to OpenSearch query creator.
+var DefaultCreator = Creator{kql.Builder{}, Compiler{}}
+
+// NewCreator returns a kql to OpenSearch query creator which maps the given KQL keys to index fields.
+func NewCreator(fields map[string]string) Creator {
+	return Creator{kql.Builder{}, NewCompiler(fields)}
+}
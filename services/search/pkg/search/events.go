@@ -16,6 +16,7 @@ func HandleEvents(s Searcher, bus events.Consumer, logger log.Logger, cfg *confi
 	evts := []events.Unmarshaller{
 		events.ItemTrashed{},
 		events.ItemRestored{},
+		events.ItemPurged{},
 		events.ItemMoved{},
 		events.ContainerCreated{},
 		events.FileTouched{},
@@ -70,7 +71,10 @@ func HandleEvents(s Searcher, bus events.Consumer, logger log.Logger, cfg *confi
 						indexSpaceDebouncer.Debounce(getSpaceID(ev.Ref))
 					case events.ItemMoved:
 						s.MoveItem(ev.Ref)
+						s.EvaluateAlerts(ev.Ref, ev.Executant)
 						indexSpaceDebouncer.Debounce(getSpaceID(ev.Ref))
+					case events.ItemPurged:
+						s.PurgeItem(ev.ID)
 					case events.ItemRestored:
 						s.RestoreItem(ev.Ref)
 						indexSpaceDebouncer.Debounce(getSpaceID(ev.Ref))
@@ -87,6 +91,11 @@ func HandleEvents(s Searcher, bus events.Consumer, logger log.Logger, cfg *confi
 					case events.FileUploaded:
 						indexSpaceDebouncer.Debounce(getSpaceID(ev.Ref))
 					case events.UploadReady:
+						// alerts are evaluated against the index, it can't wait for the debounced reindexing of the space
+						if !ev.Failed && s.AlertsEnabled() {
+							s.UpsertItem(ev.FileRef)
+							s.EvaluateAlerts(ev.FileRef, ev.ExecutingUser.GetId())
+						}
 						indexSpaceDebouncer.Debounce(getSpaceID(ev.FileRef))
 					case events.SpaceRenamed:
 						indexSpaceDebouncer.Debounce(ev.ID)
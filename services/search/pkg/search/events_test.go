@@ -31,6 +31,7 @@ var _ = DescribeTable("events",
 			},
 		})
 
+		s.On("AlertsEnabled").Return(true).Maybe()
 		for _, mck := range mcks {
 			s.On(mck, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 				calls.Add(1)
@@ -45,7 +46,7 @@ var _ = DescribeTable("events",
 		}, "2s").Should(Equal(len(mcks)))
 	},
 	Entry("ItemTrashed", []string{"TrashItem", "IndexSpace"}, events.ItemTrashed{}, false),
-	Entry("ItemMoved", []string{"MoveItem", "IndexSpace"}, events.ItemMoved{}, false),
+	Entry("ItemMoved", []string{"MoveItem", "EvaluateAlerts", "IndexSpace"}, events.ItemMoved{}, false),
 	Entry("ItemRestored", []string{"RestoreItem", "IndexSpace"}, events.ItemRestored{}, false),
 	Entry("ContainerCreated", []string{"IndexSpace"}, events.ContainerCreated{}, false),
 	Entry("FileTouched", []string{"IndexSpace"}, events.FileTouched{}, false),
@@ -53,5 +54,6 @@ var _ = DescribeTable("events",
 	Entry("TagsAdded", []string{"UpsertItem"}, events.TagsAdded{}, false),
 	Entry("TagsRemoved", []string{"UpsertItem"}, events.TagsRemoved{}, false),
 	Entry("FileUploaded", []string{"IndexSpace"}, events.FileUploaded{}, false),
-	Entry("UploadReady", []string{"IndexSpace"}, events.UploadReady{ExecutingUser: &userv1beta1.User{}}, true),
+	Entry("UploadReady", []string{"UpsertItem", "EvaluateAlerts", "IndexSpace"}, events.UploadReady{ExecutingUser: &userv1beta1.User{}}, true),
+	Entry("UploadReady failed", []string{"IndexSpace"}, events.UploadReady{ExecutingUser: &userv1beta1.User{}, Failed: true}, true),
 )
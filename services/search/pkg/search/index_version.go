@@ -0,0 +1,84 @@
+package search
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+)
+
+// _indexVersionPrefix is the prefix of the store keys of the index versions of the spaces, the keys end with the space id
+const _indexVersionPrefix = "_indexversion/"
+
+// IndexVersions keeps track of the configuration the spaces were indexed with.
+// Unchanged resources are skipped when a space is indexed, unless the space was indexed with another configuration,
+// e.g. before a custom property was added or the semantic search was enabled. The space is then indexed completely.
+type IndexVersions struct {
+	store   microstore.Store
+	current string
+	initial string
+}
+
+// NewIndexVersions creates a new IndexVersions instance for the given configuration
+func NewIndexVersions(store microstore.Store, cfg *config.Config) *IndexVersions {
+	return &IndexVersions{
+		store:   store,
+		current: indexVersion(cfg),
+		initial: indexVersion(&config.Config{}),
+	}
+}
+
+// Outdated returns true if the space was indexed with another configuration
+func (v *IndexVersions) Outdated(spaceID string) bool {
+	recs, err := v.store.Read(_indexVersionPrefix + spaceID)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		// spaces indexed before their versions were tracked were indexed without any custom properties or vectors
+		return v.current != v.initial
+	case err != nil || len(recs) != 1:
+		return true
+	}
+
+	return string(recs[0].Value) != v.current
+}
+
+// Update records that the space was indexed completely with the current configuration
+func (v *IndexVersions) Update(spaceID string) error {
+	return v.store.Write(&microstore.Record{
+		Key:   _indexVersionPrefix + spaceID,
+		Value: []byte(v.current),
+	})
+}
+
+// indexVersion fingerprints the parts of the configuration which change the indexed fields
+func indexVersion(cfg *config.Config) string {
+	properties := slices.Clone(cfg.CustomProperties)
+	slices.SortFunc(properties, func(a, b config.CustomProperty) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// vectors of another embedding provider or size can't be compared, all of them are computed again
+	var embedding string
+	switch cfg.Embedding.Type {
+	case "", "none":
+	case "local":
+		embedding = cfg.Embedding.Type + "/" + strconv.Itoa(cfg.Embedding.Local.Dimensions)
+	default:
+		embedding = cfg.Embedding.Type
+	}
+
+	b, _ := json.Marshal(struct {
+		CustomProperties []config.CustomProperty
+		Embedding        string `json:",omitempty"`
+	}{properties, embedding})
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:8])
+}
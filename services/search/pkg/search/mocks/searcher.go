@@ -8,6 +8,8 @@ import (
 	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	mock "github.com/stretchr/testify/mock"
 
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+
 	v0 "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
 )
 
@@ -24,6 +26,85 @@ func (_m *Searcher) EXPECT() *Searcher_Expecter {
 	return &Searcher_Expecter{mock: &_m.Mock}
 }
 
+// AlertsEnabled provides a mock function with given fields:
+func (_m *Searcher) AlertsEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Searcher_AlertsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlertsEnabled'
+type Searcher_AlertsEnabled_Call struct {
+	*mock.Call
+}
+
+// AlertsEnabled is a helper method to define mock.On call
+func (_e *Searcher_Expecter) AlertsEnabled() *Searcher_AlertsEnabled_Call {
+	return &Searcher_AlertsEnabled_Call{Call: _e.mock.On("AlertsEnabled")}
+}
+
+func (_c *Searcher_AlertsEnabled_Call) Run(run func()) *Searcher_AlertsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Searcher_AlertsEnabled_Call) Return(_a0 bool) *Searcher_AlertsEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Searcher_AlertsEnabled_Call) RunAndReturn(run func() bool) *Searcher_AlertsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EvaluateAlerts provides a mock function with given fields: ref, executant
+func (_m *Searcher) EvaluateAlerts(ref *providerv1beta1.Reference, executant *userv1beta1.UserId) {
+	_m.Called(ref, executant)
+}
+
+// Searcher_EvaluateAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateAlerts'
+type Searcher_EvaluateAlerts_Call struct {
+	*mock.Call
+}
+
+// EvaluateAlerts is a helper method to define mock.On call
+//   - ref *providerv1beta1.Reference
+//   - executant *userv1beta1.UserId
+func (_e *Searcher_Expecter) EvaluateAlerts(ref interface{}, executant interface{}) *Searcher_EvaluateAlerts_Call {
+	return &Searcher_EvaluateAlerts_Call{Call: _e.mock.On("EvaluateAlerts", ref, executant)}
+}
+
+func (_c *Searcher_EvaluateAlerts_Call) Run(run func(ref *providerv1beta1.Reference, executant *userv1beta1.UserId)) *Searcher_EvaluateAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*providerv1beta1.Reference), args[1].(*userv1beta1.UserId))
+	})
+	return _c
+}
+
+func (_c *Searcher_EvaluateAlerts_Call) Return() *Searcher_EvaluateAlerts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Searcher_EvaluateAlerts_Call) RunAndReturn(run func(*providerv1beta1.Reference, *userv1beta1.UserId)) *Searcher_EvaluateAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexSpace provides a mock function with given fields: rID
 func (_m *Searcher) IndexSpace(rID *providerv1beta1.StorageSpaceId) error {
 	ret := _m.Called(rID)
@@ -103,6 +184,39 @@ func (_c *Searcher_MoveItem_Call) RunAndReturn(run func(*providerv1beta1.Referen
 	return _c
 }
 
+// PurgeItem provides a mock function with given fields: rID
+func (_m *Searcher) PurgeItem(rID *providerv1beta1.ResourceId) {
+	_m.Called(rID)
+}
+
+// Searcher_PurgeItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeItem'
+type Searcher_PurgeItem_Call struct {
+	*mock.Call
+}
+
+// PurgeItem is a helper method to define mock.On call
+//   - rID *providerv1beta1.ResourceId
+func (_e *Searcher_Expecter) PurgeItem(rID interface{}) *Searcher_PurgeItem_Call {
+	return &Searcher_PurgeItem_Call{Call: _e.mock.On("PurgeItem", rID)}
+}
+
+func (_c *Searcher_PurgeItem_Call) Run(run func(rID *providerv1beta1.ResourceId)) *Searcher_PurgeItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*providerv1beta1.ResourceId))
+	})
+	return _c
+}
+
+func (_c *Searcher_PurgeItem_Call) Return() *Searcher_PurgeItem_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Searcher_PurgeItem_Call) RunAndReturn(run func(*providerv1beta1.ResourceId)) *Searcher_PurgeItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreItem provides a mock function with given fields: ref
 func (_m *Searcher) RestoreItem(ref *providerv1beta1.Reference) {
 	_m.Called(ref)
@@ -261,6 +375,65 @@ func (_c *Searcher_UpsertItem_Call) RunAndReturn(run func(*providerv1beta1.Refer
 	return _c
 }
 
+// VerifySpace provides a mock function with given fields: rID, fix
+func (_m *Searcher) VerifySpace(rID *providerv1beta1.StorageSpaceId, fix bool) (*v0.VerifySpaceResponse, error) {
+	ret := _m.Called(rID, fix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifySpace")
+	}
+
+	var r0 *v0.VerifySpaceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*providerv1beta1.StorageSpaceId, bool) (*v0.VerifySpaceResponse, error)); ok {
+		return rf(rID, fix)
+	}
+	if rf, ok := ret.Get(0).(func(*providerv1beta1.StorageSpaceId, bool) *v0.VerifySpaceResponse); ok {
+		r0 = rf(rID, fix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.VerifySpaceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*providerv1beta1.StorageSpaceId, bool) error); ok {
+		r1 = rf(rID, fix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Searcher_VerifySpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySpace'
+type Searcher_VerifySpace_Call struct {
+	*mock.Call
+}
+
+// VerifySpace is a helper method to define mock.On call
+//   - rID *providerv1beta1.StorageSpaceId
+//   - fix bool
+func (_e *Searcher_Expecter) VerifySpace(rID interface{}, fix interface{}) *Searcher_VerifySpace_Call {
+	return &Searcher_VerifySpace_Call{Call: _e.mock.On("VerifySpace", rID, fix)}
+}
+
+func (_c *Searcher_VerifySpace_Call) Run(run func(rID *providerv1beta1.StorageSpaceId, fix bool)) *Searcher_VerifySpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*providerv1beta1.StorageSpaceId), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *Searcher_VerifySpace_Call) Return(_a0 *v0.VerifySpaceResponse, _a1 error) *Searcher_VerifySpace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Searcher_VerifySpace_Call) RunAndReturn(run func(*providerv1beta1.StorageSpaceId, bool) (*v0.VerifySpaceResponse, error)) *Searcher_VerifySpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSearcher creates a new instance of Searcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSearcher(t interface {
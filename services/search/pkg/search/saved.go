@@ -0,0 +1,360 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/google/uuid"
+	microstore "go-micro.dev/v4/store"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/ocis-pkg/kql"
+	searchmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+)
+
+const (
+	// _maxSavedSearches is the number of searches a user can save
+	_maxSavedSearches = 100
+
+	// _alertUsersPrefix is the prefix of the store keys marking the users with at least one alert, the keys end with
+	// the user id. Every user has its own key, this way replicas sharing the store don't overwrite each other.
+	// The searches are stored by user id.
+	_alertUsersPrefix = "_alertusers/"
+)
+
+// SavedSearches stores the saved searches of the users and publishes their alerts
+type SavedSearches struct {
+	store     microstore.Store
+	publisher events.Publisher
+	mu        sync.Mutex
+}
+
+// NewSavedSearches creates a new SavedSearches instance.
+// Alerts are not published if no publisher is given.
+func NewSavedSearches(store microstore.Store, publisher events.Publisher) *SavedSearches {
+	return &SavedSearches{
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// List returns the saved searches of the user
+func (s *SavedSearches) List(userID string) ([]*searchsvc.SavedSearch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(userID)
+}
+
+// Save creates or updates a saved search of the user, a new id is assigned if the given search has none
+func (s *SavedSearches) Save(userID string, search *searchsvc.SavedSearch) (*searchsvc.SavedSearch, error) {
+	switch {
+	case search.GetName() == "":
+		return nil, errtypes.BadRequest("the name of the saved search must not be empty")
+	case search.GetQuery() == "":
+		return nil, errtypes.BadRequest("the query of the saved search must not be empty")
+	}
+
+	query, _ := ParseScope(search.GetQuery())
+	query, similarID, similarText := ParseSimilar(query)
+	if similarID != "" || similarText != "" {
+		if search.GetAlert() {
+			return nil, errtypes.BadRequest("alerts are not supported for similar queries")
+		}
+	} else if _, err := (kql.Builder{}).Build(query); err != nil {
+		return nil, errtypes.BadRequest("invalid query: " + err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.read(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	search = proto.Clone(search).(*searchsvc.SavedSearch)
+	if search.GetId() == "" {
+		if len(searches) >= _maxSavedSearches {
+			return nil, errtypes.BadRequest("too many saved searches")
+		}
+		search.Id = uuid.New().String()
+		searches = append(searches, search)
+	} else {
+		i := indexOf(searches, search.GetId())
+		if i < 0 {
+			return nil, errtypes.NotFound(search.GetId())
+		}
+		searches[i] = search
+	}
+
+	return search, s.write(userID, searches)
+}
+
+// AlertUsers returns the ids of all users with at least one alert
+func (s *SavedSearches) AlertUsers() (map[string]bool, error) {
+	keys, err := s.store.List(microstore.ListPrefix(_alertUsersPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		users[strings.TrimPrefix(key, _alertUsersPrefix)] = true
+	}
+	return users, nil
+}
+
+// Delete removes a saved search of the user
+func (s *SavedSearches) Delete(userID, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.read(userID)
+	if err != nil {
+		return err
+	}
+
+	i := indexOf(searches, id)
+	if i < 0 {
+		return errtypes.NotFound(id)
+	}
+
+	return s.write(userID, append(searches[:i], searches[i+1:]...))
+}
+
+func (s *SavedSearches) read(userID string) ([]*searchsvc.SavedSearch, error) {
+	records, err := s.store.Read(userID)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case len(records) == 0:
+		return nil, nil
+	}
+
+	list := &searchsvc.ListSavedSearchesResponse{}
+	if err := protojson.Unmarshal(records[0].Value, list); err != nil {
+		return nil, err
+	}
+
+	return list.GetSavedSearches(), nil
+}
+
+func (s *SavedSearches) write(userID string, searches []*searchsvc.SavedSearch) error {
+	if len(searches) == 0 {
+		if err := s.store.Delete(userID); err != nil {
+			return err
+		}
+		return s.updateAlertUsers(userID, searches)
+	}
+
+	value, err := protojson.Marshal(&searchsvc.ListSavedSearchesResponse{SavedSearches: searches})
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.Write(&microstore.Record{Key: userID, Value: value}); err != nil {
+		return err
+	}
+	return s.updateAlertUsers(userID, searches)
+}
+
+// updateAlertUsers keeps the key marking the user as a user with alerts in sync with the saved searches of the user
+func (s *SavedSearches) updateAlertUsers(userID string, searches []*searchsvc.SavedSearch) error {
+	if slices.ContainsFunc(searches, (*searchsvc.SavedSearch).GetAlert) {
+		return s.store.Write(&microstore.Record{Key: _alertUsersPrefix + userID, Value: []byte(userID)})
+	}
+
+	if err := s.store.Delete(_alertUsersPrefix + userID); err != nil && !errors.Is(err, microstore.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func indexOf(searches []*searchsvc.SavedSearch, id string) int {
+	for i, search := range searches {
+		if search.GetId() == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// AlertsEnabled returns true if alerts are published and at least one user has an alert
+func (s *Service) AlertsEnabled() bool {
+	if s.savedSearches == nil || s.savedSearches.publisher == nil {
+		return false
+	}
+
+	users, err := s.savedSearches.AlertUsers()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to read the users with alerts")
+		return false
+	}
+	return len(users) > 0
+}
+
+// alert is a saved search with an alert of a user
+type alert struct {
+	userID string
+	search *searchsvc.SavedSearch
+}
+
+// alertQuery is a query of a saved search with an alert and the scope it is restricted to
+type alertQuery struct {
+	query string
+	scope string
+}
+
+// EvaluateAlerts notifies the members of the space if the resource matches one of their saved searches with an alert.
+// The executant is not notified about its own changes. Every distinct query and scope is evaluated once.
+func (s *Service) EvaluateAlerts(ref *provider.Reference, executant *user.UserId) {
+	if s.savedSearches == nil || s.savedSearches.publisher == nil {
+		return
+	}
+
+	users, err := s.savedSearches.AlertUsers()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to read the users with alerts")
+		return
+	}
+	delete(users, executant.GetOpaqueId())
+	if len(users) == 0 {
+		return
+	}
+
+	ctx, stat, path := s.resInfo(ref)
+	if ctx == nil || stat == nil || path == "" {
+		return
+	}
+
+	gatewayClient, err := s.gatewaySelector.Next()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("could not get reva gatewayClient")
+		return
+	}
+
+	id := stat.GetInfo().GetId()
+	spaceID := storagespace.FormatResourceID(&provider.ResourceId{StorageId: id.GetStorageId(), SpaceId: id.GetSpaceId()})
+	members, err := utils.GetSpaceMembers(ctx, spaceID, gatewayClient, utils.ViewerRole)
+	if err != nil {
+		s.logger.Error().Err(err).Str("spaceID", spaceID).Msg("failed to get the members of the space")
+		return
+	}
+
+	// alerts cover the whole space of the resource unless they are restricted to a scope
+	byQuery := map[alertQuery][]alert{}
+	for _, member := range members {
+		if !users[member] {
+			continue
+		}
+
+		searches, err := s.savedSearches.List(member)
+		if err != nil {
+			s.logger.Error().Err(err).Str("userID", member).Msg("failed to read the saved searches")
+			continue
+		}
+
+		for _, search := range searches {
+			if !search.GetAlert() {
+				continue
+			}
+			query, scope := ParseScope(search.GetQuery())
+			aq := alertQuery{query: query, scope: scope}
+			byQuery[aq] = append(byQuery[aq], alert{userID: member, search: search})
+		}
+	}
+
+	for aq, alerts := range byQuery {
+		scopePath, ok := s.scopePath(ctx, gatewayClient, id, aq.scope)
+		if !ok || !s.matches(ctx, stat.GetInfo(), aq.query, scopePath) {
+			continue
+		}
+
+		for _, a := range alerts {
+			if err := events.Publish(ctx, s.savedSearches.publisher, event.SavedSearchMatched{
+				SearchID:   a.search.GetId(),
+				SearchName: a.search.GetName(),
+				UserID:     &user.UserId{OpaqueId: a.userID},
+				Executant:  executant,
+				ResourceID: id,
+				SpaceID:    spaceID,
+				Path:       utils.MakeRelativePath(path),
+				Filename:   filepath.Base(path),
+				Timestamp:  utils.TSNow(),
+			}); err != nil {
+				s.logger.Error().Err(err).Str("searchID", a.search.GetId()).Msg("failed to publish the saved search alert")
+			}
+		}
+	}
+}
+
+// scopePath returns the path of the scope of a saved search within the space of the resource,
+// false is returned if the scope can't be resolved or belongs to another space
+func (s *Service) scopePath(ctx context.Context, gatewayClient gateway.GatewayAPIClient, id *provider.ResourceId, scope string) (string, bool) {
+	if scope == "" {
+		return "", true
+	}
+
+	scopedID, err := storagespace.ParseID(scope)
+	if err != nil {
+		s.logger.Debug().Err(err).Str("scope", scope).Msg("failed to parse the scope of the saved search")
+		return "", false
+	}
+
+	statRes, err := gatewayClient.Stat(ctx, &provider.StatRequest{Ref: &provider.Reference{ResourceId: &scopedID}})
+	if err != nil || statRes.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		s.logger.Debug().Err(err).Str("scope", scope).Msg("failed to stat the scope of the saved search")
+		return "", false
+	}
+	if statRes.GetInfo().GetId().GetStorageId() != id.GetStorageId() || statRes.GetInfo().GetId().GetSpaceId() != id.GetSpaceId() {
+		return "", false
+	}
+
+	gpRes, err := gatewayClient.GetPath(ctx, &provider.GetPathRequest{ResourceId: statRes.GetInfo().GetId()})
+	if err != nil || gpRes.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		s.logger.Debug().Err(err).Str("scope", scope).Msg("failed to get the path of the scope of the saved search")
+		return "", false
+	}
+
+	return gpRes.GetPath(), true
+}
+
+// matches checks if the indexed resource is found by the query below the given path of its space
+func (s *Service) matches(ctx context.Context, info *provider.ResourceInfo, query, path string) bool {
+	res, err := s.engine.Search(ctx, &searchsvc.SearchIndexRequest{
+		Query: "id:" + storagespace.FormatResourceID(info.GetId()) + " AND (" + query + ")",
+		Ref: &searchmsg.Reference{
+			ResourceId: &searchmsg.ResourceID{
+				StorageId: info.GetId().GetStorageId(),
+				SpaceId:   info.GetId().GetSpaceId(),
+				OpaqueId:  info.GetId().GetSpaceId(),
+			},
+			Path: path,
+		},
+		PageSize: 1,
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("query", query).Msg("failed to evaluate the saved search")
+		return false
+	}
+
+	return res.GetTotalMatches() > 0
+}
@@ -0,0 +1,231 @@
+package search_test
+
+import (
+	"context"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/status"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/cs3org/reva/v2/tests/cs3mocks/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	mEvents "go-micro.dev/v4/events"
+	microstore "go-micro.dev/v4/store"
+	"google.golang.org/grpc"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/event"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	contentMocks "github.com/owncloud/ocis/v2/services/search/pkg/content/mocks"
+	engineMocks "github.com/owncloud/ocis/v2/services/search/pkg/engine/mocks"
+	"github.com/owncloud/ocis/v2/services/search/pkg/search"
+)
+
+var _ = Describe("SavedSearches", func() {
+	var saved *search.SavedSearches
+
+	BeforeEach(func() {
+		saved = search.NewSavedSearches(microstore.NewMemoryStore(), nil)
+	})
+
+	It("saves, updates and deletes the searches of a user", func() {
+		created, err := saved.Save("user", &searchsvc.SavedSearch{Name: "reports", Query: "name:*.pdf"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.Id).ToNot(BeEmpty())
+
+		created.Query = "name:*.docx"
+		_, err = saved.Save("user", created)
+		Expect(err).ToNot(HaveOccurred())
+
+		searches, err := saved.List("user")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(HaveLen(1))
+		Expect(searches[0].Query).To(Equal("name:*.docx"))
+
+		searches, err = saved.List("other")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(BeEmpty())
+
+		Expect(saved.Delete("user", created.Id)).To(Succeed())
+		searches, err = saved.List("user")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(searches).To(BeEmpty())
+	})
+
+	It("keeps the users with alerts of instances sharing a store", func() {
+		store := microstore.NewMemoryStore()
+		replica1, replica2 := search.NewSavedSearches(store, nil), search.NewSavedSearches(store, nil)
+
+		_, err := replica1.Save("user1", &searchsvc.SavedSearch{Name: "pdfs", Query: "name:*.pdf", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = replica2.Save("user2", &searchsvc.SavedSearch{Name: "pdfs", Query: "name:*.pdf", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+
+		users, err := replica1.AlertUsers()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(users).To(Equal(map[string]bool{"user1": true, "user2": true}))
+	})
+
+	It("fails to update or delete unknown searches", func() {
+		_, err := saved.Save("user", &searchsvc.SavedSearch{Id: "unknown", Name: "reports", Query: "name:*.pdf"})
+		Expect(err).To(BeAssignableToTypeOf(errtypes.NotFound("")))
+		Expect(saved.Delete("user", "unknown")).To(BeAssignableToTypeOf(errtypes.NotFound("")))
+	})
+
+	DescribeTable("validates the saved search",
+		func(search *searchsvc.SavedSearch, message string) {
+			_, err := saved.Save("user", search)
+			Expect(err).To(BeAssignableToTypeOf(errtypes.BadRequest("")))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("When the name is missing", &searchsvc.SavedSearch{Query: "name:foo"}, "name"),
+		Entry("When the query is missing", &searchsvc.SavedSearch{Name: "foo"}, "query"),
+		Entry("When the query is invalid", &searchsvc.SavedSearch{Name: "foo", Query: "AND name:foo"}, "invalid query"),
+		Entry("When an alert is set for a similar query", &searchsvc.SavedSearch{Name: "foo", Query: `similar:"foo"`, Alert: true}, "similar"),
+	)
+})
+
+var _ = Describe("Alerts", func() {
+	var (
+		s             *search.Service
+		saved         *search.SavedSearches
+		gatewayClient *cs3mocks.GatewayAPIClient
+		indexClient   *engineMocks.Engine
+		matched       <-chan events.Event
+		ref           = &sprovider.Reference{
+			ResourceId: &sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "personalspace"},
+			Path:       "./report.pdf",
+		}
+	)
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "com.owncloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"com.owncloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+		indexClient = &engineMocks.Engine{}
+
+		bus, err := mEvents.NewStream()
+		Expect(err).ToNot(HaveOccurred())
+		matched, err = events.Consume(bus, "test", event.SavedSearchMatched{})
+		Expect(err).ToNot(HaveOccurred())
+
+		saved = search.NewSavedSearches(microstore.NewMemoryStore(), bus)
+		s = search.NewService(gatewaySelector, indexClient, &contentMocks.Extractor{}, nil, nil, saved, nil, log.NewLogger(), &config.Config{})
+
+		ctx := context.Background()
+		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+			Status: status.NewOK(ctx),
+			Token:  "authtoken",
+		}, nil)
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+			Status: status.NewOK(ctx),
+			Info: &sprovider.ResourceInfo{
+				Id:   &sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "report"},
+				Path: "report.pdf",
+			},
+		}, nil)
+		gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+			Status: status.NewOK(ctx),
+			StorageSpaces: []*sprovider.StorageSpace{{
+				SpaceType: "personal",
+				Owner:     &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "owner"}},
+			}},
+		}, nil)
+
+		_, err = saved.Save("owner", &searchsvc.SavedSearch{Name: "pdfs", Query: "name:*.pdf", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = saved.Save("owner", &searchsvc.SavedSearch{Name: "quiet", Query: "name:*.pdf"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("notifies the members about matching resources", func() {
+		indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return req.Query == "id:storageid$personalspace!report AND (name:*.pdf)"
+		})).Return(&searchsvc.SearchIndexResponse{TotalMatches: 1}, nil)
+
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "editor"})
+
+		var e events.Event
+		Eventually(matched).Should(Receive(&e))
+		ev := e.Event.(event.SavedSearchMatched)
+		Expect(ev.SearchName).To(Equal("pdfs"))
+		Expect(ev.UserID.GetOpaqueId()).To(Equal("owner"))
+		Expect(ev.Path).To(Equal("./report.pdf"))
+		Expect(ev.Filename).To(Equal("report.pdf"))
+		Consistently(matched).ShouldNot(Receive())
+	})
+
+	It("does not notify about resources which do not match", func() {
+		indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{}, nil)
+
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "editor"})
+		Consistently(matched).ShouldNot(Receive())
+	})
+
+	It("evaluates every query once", func() {
+		_, err := saved.Save("owner", &searchsvc.SavedSearch{Name: "reports", Query: "name:*.pdf", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{TotalMatches: 1}, nil)
+
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "editor"})
+
+		Eventually(matched).Should(Receive())
+		Eventually(matched).Should(Receive())
+		indexClient.AssertNumberOfCalls(GinkgoT(), "Search", 1)
+	})
+
+	It("knows if there are alerts", func() {
+		Expect(s.AlertsEnabled()).To(BeTrue())
+
+		searches, err := saved.List("owner")
+		Expect(err).ToNot(HaveOccurred())
+		for _, search := range searches {
+			Expect(saved.Delete("owner", search.GetId())).To(Succeed())
+		}
+		Expect(s.AlertsEnabled()).To(BeFalse())
+
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "editor"})
+		gatewayClient.AssertNotCalled(GinkgoT(), "Stat", mock.Anything, mock.Anything)
+	})
+
+	It("restricts the alerts to their scope", func() {
+		_, err := saved.Save("owner", &searchsvc.SavedSearch{Name: "scoped", Query: "scope:storageid$personalspace!folder name:*.pdf", Alert: true})
+		Expect(err).ToNot(HaveOccurred())
+		gatewayClient.On("GetPath", mock.Anything, mock.Anything).Return(&sprovider.GetPathResponse{
+			Status: status.NewOK(context.Background()),
+			Path:   "/folder",
+		}, nil)
+		indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return req.GetRef().GetPath() == ""
+		})).Return(&searchsvc.SearchIndexResponse{TotalMatches: 1}, nil)
+		indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return req.GetRef().GetPath() == "/folder"
+		})).Return(&searchsvc.SearchIndexResponse{}, nil)
+
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "editor"})
+
+		var e events.Event
+		Eventually(matched).Should(Receive(&e))
+		Expect(e.Event.(event.SavedSearchMatched).SearchName).To(Equal("pdfs"))
+		Consistently(matched).ShouldNot(Receive())
+		indexClient.AssertNumberOfCalls(GinkgoT(), "Search", 2)
+	})
+
+	It("does not notify the executant", func() {
+		s.EvaluateAlerts(ref, &userv1beta1.UserId{OpaqueId: "owner"})
+		Consistently(matched).ShouldNot(Receive())
+		indexClient.AssertNotCalled(GinkgoT(), "Search", mock.Anything, mock.Anything)
+	})
+})
@@ -22,6 +22,9 @@ import (
 
 var scopeRegex = regexp.MustCompile(`scope:\s*([^" "\n\r]*)`)
 
+// similarRegex only matches similar as a key of its own, not as part of other keys or values like name:dissimilar
+var similarRegex = regexp.MustCompile(`(?:^|\s)similar:\s*("([^"]*)"|([^\s"]+))`)
+
 // ResolveReference makes sure the path is relative to the space root
 func ResolveReference(ctx context.Context, ref *provider.Reference, ri *provider.ResourceInfo, gatewaySelector pool.Selectable[gateway.GatewayAPIClient]) (*provider.Reference, error) {
 	if ref.GetResourceId().GetOpaqueId() == ref.GetResourceId().GetSpaceId() {
@@ -158,3 +161,14 @@ func ParseScope(query string) (string, string) {
 	}
 	return query, ""
 }
+
+// ParseSimilar extracts a similar value from the query string and returns the remaining query with the value,
+// a quoted value is a free text, all other values are resource ids
+func ParseSimilar(query string) (rest string, id string, text string) {
+	match := similarRegex.FindStringSubmatch(query)
+	if match == nil {
+		return query, "", ""
+	}
+
+	return strings.TrimSpace(strings.Replace(query, match[0], "", 1)), match[3], strings.TrimSpace(match[2])
+}
@@ -0,0 +1,238 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/errtypes"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"google.golang.org/protobuf/proto"
+
+	searchmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
+	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
+)
+
+const (
+	// _defaultPageSize is the number of matches returned if the request doesn't limit them
+	_defaultPageSize = 200
+
+	// _similarBatchSize is the number of similar resources looked up in the index at once
+	_similarBatchSize = 100
+)
+
+// semanticQuery is the vector the resources of a semantic search are compared to
+type semanticQuery struct {
+	vector []float32
+	// text is the free text which is also searched by keywords
+	text string
+	// exclude is the resource whose similar resources are searched
+	exclude string
+}
+
+// newSemanticQuery computes the vector of a similar query, either from the free text or from the resource with the given id.
+// The resource must be accessible by the user of the context.
+func (s *Service) newSemanticQuery(ctx context.Context, gatewayClient gateway.GatewayAPIClient, req *searchsvc.SearchRequest, query, id, text string) (*semanticQuery, error) {
+	switch {
+	case s.embedder == nil || s.vectors == nil:
+		return nil, errtypes.BadRequest("semantic search is not enabled")
+	case query != "":
+		return nil, errtypes.BadRequest("similar can not be combined with other query terms")
+	case len(req.Facets) > 0:
+		return nil, errtypes.BadRequest("facets are not supported by semantic queries")
+	}
+
+	if text != "" {
+		vectors, err := s.embedder.Embed(ctx, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		return &semanticQuery{vector: vectors[0], text: text}, nil
+	}
+
+	rID, err := storagespace.ParseID(id)
+	if err != nil {
+		return nil, errtypes.BadRequest("invalid resource id: " + id)
+	}
+
+	statRes, err := gatewayClient.Stat(ctx, &provider.StatRequest{Ref: &provider.Reference{ResourceId: &rID}})
+	switch {
+	case err != nil:
+		return nil, err
+	case statRes.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return nil, errtypes.NotFound(id)
+	}
+
+	resourceID := storagespace.FormatResourceID(statRes.GetInfo().GetId())
+	vector, err := s.vectors.Get(resourceID)
+	switch {
+	case errors.Is(err, embedding.ErrNotFound):
+		return nil, errtypes.NotFound("no vector for resource " + id)
+	case err != nil:
+		return nil, err
+	}
+
+	return &semanticQuery{vector: vector, exclude: resourceID}, nil
+}
+
+// semanticSearch searches the resources of the space whose vectors are the most similar to the query.
+// For free texts the similarity is merged with the keyword score of the text, the weight of the similarity is configurable.
+func (s *Service) semanticSearch(ctx context.Context, req *searchsvc.SearchIndexRequest, sq *semanticQuery) (*searchsvc.SearchIndexResponse, error) {
+	limit := int(req.PageSize)
+	if limit == 0 {
+		limit = _defaultPageSize
+	}
+
+	weight := float32(1)
+	if sq.text != "" {
+		weight = float32(s.semanticWeight)
+	}
+
+	neighbors, err := s.vectors.Nearest(storagespace.FormatResourceID(&provider.ResourceId{
+		StorageId: req.GetRef().GetResourceId().GetStorageId(),
+		SpaceId:   req.GetRef().GetResourceId().GetSpaceId(),
+		OpaqueId:  req.GetRef().GetResourceId().GetOpaqueId(),
+	}), sq.vector, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	similarity := make(map[string]float32, len(neighbors))
+	candidates := make([]string, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		if neighbor.ID == sq.exclude || neighbor.Similarity <= 0 {
+			continue
+		}
+		similarity[neighbor.ID] = neighbor.Similarity
+		candidates = append(candidates, neighbor.ID)
+	}
+
+	// the engine applies the path restriction and skips deleted resources. The most similar candidates
+	// are filtered in batches until enough of them are left.
+	merged := map[string]*searchmsg.Match{}
+	for start := 0; start < len(candidates) && (limit <= 0 || len(merged) < limit); start += _similarBatchSize {
+		batch := candidates[start:min(start+_similarBatchSize, len(candidates))]
+		terms := make([]string, 0, len(batch))
+		for _, id := range batch {
+			terms = append(terms, "id:"+id)
+		}
+
+		similarReq := proto.Clone(req).(*searchsvc.SearchIndexRequest)
+		similarReq.Query = strings.Join(terms, " OR ")
+		similarReq.PageSize = -1
+
+		res, err := s.engine.Search(ctx, similarReq)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range res.Matches {
+			id := matchID(match)
+			match.Score = weight * similarity[id]
+			merged[id] = match
+		}
+	}
+
+	if sq.text != "" {
+		keywordReq := proto.Clone(req).(*searchsvc.SearchIndexRequest)
+		keywordReq.Query = sq.text
+
+		res, err := s.engine.Search(ctx, keywordReq)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range res.Matches {
+			// the unbounded keyword score is mapped to [0,1) to be comparable with the similarity
+			score := (1 - weight) * match.Score / (match.Score + 1)
+
+			id := matchID(match)
+			if m, ok := merged[id]; ok {
+				m.Score += score
+				continue
+			}
+			match.Score = score
+			merged[id] = match
+		}
+	}
+
+	matches := make(matchArray, 0, len(merged))
+	for _, match := range merged {
+		matches = append(matches, match)
+	}
+	sort.Stable(matches)
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return &searchsvc.SearchIndexResponse{
+		Matches:      matches,
+		TotalMatches: int32(len(merged)),
+	}, nil
+}
+
+// upsertVector stores the vector of the name, title and content of a resource
+func (s *Service) upsertVector(ctx context.Context, r engine.Resource) {
+	if s.embedder == nil || s.vectors == nil {
+		return
+	}
+
+	vectors, err := s.embedder.Embed(ctx, []string{vectorText(r.Document)})
+	if err != nil {
+		s.logger.Error().Err(err).Str("id", r.ID).Msg("failed to compute the vector of the resource")
+		return
+	}
+
+	if err := s.vectors.Upsert(r.ID, r.RootID, r.ParentID, vectors[0]); err != nil {
+		s.logger.Error().Err(err).Str("id", r.ID).Msg("failed to store the vector of the resource")
+	}
+}
+
+// moveVector updates the parent of the vector of a resource
+func (s *Service) moveVector(id, parentID string) {
+	if s.vectors == nil {
+		return
+	}
+
+	if err := s.vectors.Move(id, parentID); err != nil {
+		s.logger.Error().Err(err).Str("id", id).Msg("failed to move the vector of the resource")
+	}
+}
+
+// deleteVector removes the vectors of a resource and its descendants
+func (s *Service) deleteVector(id string) {
+	if s.vectors == nil {
+		return
+	}
+
+	if err := s.vectors.Delete(id); err != nil {
+		s.logger.Error().Err(err).Str("id", id).Msg("failed to remove the vector of the resource")
+	}
+}
+
+// vectorMissing returns true if the semantic search is enabled but no vector is stored for the resource
+func (s *Service) vectorMissing(id string) bool {
+	if s.embedder == nil || s.vectors == nil {
+		return false
+	}
+
+	_, err := s.vectors.Get(id)
+	return errors.Is(err, embedding.ErrNotFound)
+}
+
+func vectorText(doc content.Document) string {
+	return strings.Join([]string{doc.Name, doc.Title, doc.Content}, " ")
+}
+
+func matchID(match *searchmsg.Match) string {
+	return storagespace.FormatResourceID(&provider.ResourceId{
+		StorageId: match.GetEntity().GetId().GetStorageId(),
+		SpaceId:   match.GetEntity().GetId().GetSpaceId(),
+		OpaqueId:  match.GetEntity().GetId().GetOpaqueId(),
+	})
+}
@@ -0,0 +1,224 @@
+package search_test
+
+import (
+	"context"
+	"strings"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/status"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/cs3org/reva/v2/tests/cs3mocks/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	searchmsg "github.com/owncloud/ocis/v2/protogen/gen/ocis/messages/search/v0"
+	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
+	"github.com/owncloud/ocis/v2/services/search/pkg/config"
+	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	contentMocks "github.com/owncloud/ocis/v2/services/search/pkg/content/mocks"
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
+	engineMocks "github.com/owncloud/ocis/v2/services/search/pkg/engine/mocks"
+	"github.com/owncloud/ocis/v2/services/search/pkg/search"
+)
+
+var _ = Describe("Semantic search", func() {
+	var (
+		s               *search.Service
+		gatewayClient   *cs3mocks.GatewayAPIClient
+		gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+		indexClient     *engineMocks.Engine
+		extractor       *contentMocks.Extractor
+		embedder        *embedding.Local
+		vectors         *embedding.BoltStore
+		ctx             context.Context
+		rootID          = "storageid$personalspace!personalspace"
+		space           = &sprovider.StorageSpace{
+			Id:        &sprovider.StorageSpaceId{OpaqueId: rootID},
+			Root:      &sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "personalspace"},
+			SpaceType: "personal",
+		}
+		info = &sprovider.ResourceInfo{
+			Id:   &sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "a"},
+			Path: "report.txt",
+		}
+		match = func(id string, score float32) *searchmsg.Match {
+			return &searchmsg.Match{
+				Score: score,
+				Entity: &searchmsg.Entity{
+					Ref: &searchmsg.Reference{Path: "./" + id},
+					Id:  &searchmsg.ResourceID{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: id},
+				},
+			}
+		}
+		upsert = func(id, text string) {
+			v, err := embedder.Embed(context.Background(), []string{text})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vectors.Upsert("storageid$personalspace!"+id, rootID, rootID, v[0])).To(Succeed())
+		}
+		ids = func(res *searchsvc.SearchResponse) []string {
+			var ids []string
+			for _, m := range res.Matches {
+				ids = append(ids, m.GetEntity().GetId().GetOpaqueId())
+			}
+			return ids
+		}
+	)
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "com.owncloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector = pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"com.owncloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+		indexClient = &engineMocks.Engine{}
+		extractor = &contentMocks.Extractor{}
+		ctx = revactx.ContextSetUser(context.Background(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "user"}})
+
+		var err error
+		embedder = embedding.NewLocal(1024)
+		vectors, err = embedding.NewBoltStore(GinkgoT().TempDir())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(vectors.Close)
+
+		s = search.NewService(gatewaySelector, indexClient, extractor, embedder, vectors, nil, nil, log.NewLogger(), &config.Config{
+			Embedding: config.Embedding{Weight: 0.5},
+		})
+
+		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+			Status: status.NewOK(ctx),
+			Token:  "authtoken",
+		}, nil)
+		gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+			Status: status.NewOK(ctx),
+		}, nil)
+		gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+			Status:        status.NewOK(ctx),
+			StorageSpaces: []*sprovider.StorageSpace{space},
+		}, nil)
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+			Status: status.NewOK(ctx),
+			Info:   info,
+		}, nil)
+		indexClient.On("DocCount").Return(uint64(1), nil)
+
+		upsert("a", "quarterly sales report revenue")
+		upsert("b", "sales report for the quarterly revenue numbers")
+		upsert("c", "holiday photos beach")
+	})
+
+	It("fails if the semantic search is not enabled", func() {
+		s := search.NewService(gatewaySelector, indexClient, extractor, nil, nil, nil, nil, log.NewLogger(), &config.Config{})
+		_, err := s.Search(ctx, &searchsvc.SearchRequest{Query: `similar:"sales"`})
+		Expect(err).To(MatchError(ContainSubstring("not enabled")))
+	})
+
+	It("fails if other query terms are given", func() {
+		_, err := s.Search(ctx, &searchsvc.SearchRequest{Query: `similar:"sales" name:foo`})
+		Expect(err).To(MatchError(ContainSubstring("can not be combined")))
+	})
+
+	It("finds resources similar to a resource", func() {
+		indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+			Matches: []*searchmsg.Match{match("b", 1)},
+		}, nil)
+
+		res, err := s.Search(ctx, &searchsvc.SearchRequest{Query: "similar:storageid$personalspace!a"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids(res)).To(Equal([]string{"b"}))
+		Expect(res.Matches[0].Score).To(BeNumerically("~", embedding.Similarity(
+			mustGet(vectors, "storageid$personalspace!a"),
+			mustGet(vectors, "storageid$personalspace!b"),
+		), 0.0001))
+
+		// the resource itself and unrelated resources are not searched
+		indexClient.AssertCalled(GinkgoT(), "Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return req.Query == "id:storageid$personalspace!b" && req.PageSize == -1
+		}))
+	})
+
+	It("filters the similar resources before limiting them", func() {
+		// the most similar resource is outside of the searched path
+		indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+			Matches: []*searchmsg.Match{match("c", 1)},
+		}, nil)
+
+		res, err := s.Search(ctx, &searchsvc.SearchRequest{Query: `similar:"quarterly sales"`, PageSize: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids(res)).To(Equal([]string{"c"}))
+	})
+
+	It("removes the vectors of purged resources", func() {
+		s.PurgeItem(&sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "a"})
+
+		_, err := vectors.Get("storageid$personalspace!a")
+		Expect(err).To(MatchError(embedding.ErrNotFound))
+		_, err = vectors.Get("storageid$personalspace!b")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("merges the similarity of a free text with the keyword score", func() {
+		indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return strings.HasPrefix(req.Query, "id:")
+		})).Return(&searchsvc.SearchIndexResponse{
+			Matches: []*searchmsg.Match{match("a", 1), match("b", 1)},
+		}, nil)
+		indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+			return req.Query == "quarterly sales"
+		})).Return(&searchsvc.SearchIndexResponse{
+			Matches: []*searchmsg.Match{match("b", 3), match("d", 1)},
+		}, nil)
+
+		res, err := s.Search(ctx, &searchsvc.SearchRequest{Query: `similar:"quarterly sales"`})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ids(res)).To(Equal([]string{"b", "a", "d"}))
+		Expect(res.TotalMatches).To(Equal(int32(3)))
+		Expect(res.Matches[2].Score).To(BeNumerically("~", 0.25, 0.0001))
+	})
+
+	It("stores the vectors of upserted resources", func() {
+		extractor.On("Extract", mock.Anything, mock.Anything).Return(content.Document{Name: "report.txt", Content: "holiday photos"}, nil)
+		indexClient.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+
+		s.UpsertItem(&sprovider.Reference{
+			ResourceId: &sprovider.ResourceId{StorageId: "storageid", SpaceId: "personalspace", OpaqueId: "personalspace"},
+			Path:       "./report.txt",
+		})
+
+		neighbors, err := vectors.Nearest(rootID, mustGet(vectors, "storageid$personalspace!c"), 2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(neighbors[1].ID).To(Equal("storageid$personalspace!a"))
+		Expect(neighbors[1].Similarity).To(BeNumerically(">", 0.5))
+	})
+})
+
+func mustGet(store embedding.Store, id string) []float32 {
+	v, err := store.Get(id)
+	Expect(err).ToNot(HaveOccurred())
+	return v
+}
+
+var _ = DescribeTable("Parse Similar",
+	func(pattern, wantQuery, wantID, wantText string) {
+		gotQuery, gotID, gotText := search.ParseSimilar(pattern)
+		Expect(gotQuery).To(Equal(wantQuery))
+		Expect(gotID).To(Equal(wantID))
+		Expect(gotText).To(Equal(wantText))
+	},
+	Entry("When a resource id is given", `similar:storageid$spaceid!opaqueid`, ``, `storageid$spaceid!opaqueid`, ``),
+	Entry("When a free text is given", `similar:"quarterly report "`, ``, ``, `quarterly report`),
+	Entry("When other terms are given", `name:foo similar: storageid$spaceid!opaqueid`, `name:foo`, `storageid$spaceid!opaqueid`, ``),
+	Entry("When no similar is given", `name:foo`, `name:foo`, ``, ``),
+	Entry("When similar is in the middle", `name:foo similar:storageid$spaceid!opaqueid tag:bar`, `name:foo tag:bar`, `storageid$spaceid!opaqueid`, ``),
+	Entry("When similar is part of another key", `dissimilar:foo`, `dissimilar:foo`, ``, ``),
+	Entry("When similar is part of a value", `name:dissimilar:foo name:similar:bar`, `name:dissimilar:foo name:similar:bar`, ``, ``),
+)
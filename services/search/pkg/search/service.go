@@ -10,6 +10,7 @@ import (
 	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	collaborationv1beta1 "github.com/cs3org/go-cs3apis/cs3/sharing/collaboration/v1beta1"
@@ -30,6 +31,7 @@ import (
 	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
 	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
 	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
 )
 
@@ -42,14 +44,25 @@ const (
 	_slowQueryDuration   = 500 * time.Millisecond
 )
 
+// The kinds of discrepancies between the index and a space
+const (
+	DiscrepancyMissing  = "missing"
+	DiscrepancyStale    = "stale"
+	DiscrepancyOrphaned = "orphaned"
+)
+
 // Searcher is the interface to the SearchService
 type Searcher interface {
 	Search(ctx context.Context, req *searchsvc.SearchRequest) (*searchsvc.SearchResponse, error)
 	IndexSpace(rID *provider.StorageSpaceId) error
+	VerifySpace(rID *provider.StorageSpaceId, fix bool) (*searchsvc.VerifySpaceResponse, error)
 	TrashItem(rID *provider.ResourceId)
 	UpsertItem(ref *provider.Reference)
 	RestoreItem(ref *provider.Reference)
 	MoveItem(ref *provider.Reference)
+	PurgeItem(rID *provider.ResourceId)
+	AlertsEnabled() bool
+	EvaluateAlerts(ref *provider.Reference, executant *user.UserId)
 }
 
 // Service is responsible for indexing spaces and pass on a search
@@ -59,6 +72,11 @@ type Service struct {
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 	engine          engine.Engine
 	extractor       content.Extractor
+	embedder        embedding.Provider
+	vectors         embedding.Store
+	semanticWeight  float64
+	savedSearches   *SavedSearches
+	indexVersions   *IndexVersions
 
 	serviceAccountID     string
 	serviceAccountSecret string
@@ -67,12 +85,20 @@ type Service struct {
 var errSkipSpace error
 
 // NewService creates a new Provider instance.
-func NewService(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], eng engine.Engine, extractor content.Extractor, logger log.Logger, cfg *config.Config) *Service {
+// The semantic search is disabled if no embedding provider or vector store is given,
+// the alerts of the saved searches are disabled if no saved searches are given.
+// Without index versions, spaces are never indexed completely again after the configuration changed.
+func NewService(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], eng engine.Engine, extractor content.Extractor, embedder embedding.Provider, vectors embedding.Store, savedSearches *SavedSearches, indexVersions *IndexVersions, logger log.Logger, cfg *config.Config) *Service {
 	var s = &Service{
 		gatewaySelector: gatewaySelector,
 		engine:          eng,
 		logger:          logger,
 		extractor:       extractor,
+		embedder:        embedder,
+		vectors:         vectors,
+		semanticWeight:  cfg.Embedding.Weight,
+		savedSearches:   savedSearches,
+		indexVersions:   indexVersions,
 
 		serviceAccountID:     cfg.ServiceAccount.ServiceAccountID,
 		serviceAccountSecret: cfg.ServiceAccount.ServiceAccountSecret,
@@ -93,9 +119,17 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 
 	// Extract scope from query if set
 	query, scope := ParseScope(req.Query)
-	if query == "" {
+	query, similarID, similarText := ParseSimilar(query)
+	if query == "" && similarID == "" && similarText == "" {
 		return nil, errtypes.BadRequest("empty query provided")
 	}
+
+	var sq *semanticQuery
+	if similarID != "" || similarText != "" {
+		if sq, err = s.newSemanticQuery(ctx, gatewayClient, req, query, similarID, similarText); err != nil {
+			return nil, err
+		}
+	}
 	req.Query = query
 	if len(scope) > 0 {
 		scopedID, err := storagespace.ParseID(scope)
@@ -203,7 +237,7 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 	for i := 0; i < numWorkers; i++ {
 		errg.Go(func() error {
 			for space := range work {
-				res, err := s.searchIndex(ctx, req, space, mountpointMap[space.Id.OpaqueId])
+				res, err := s.searchIndex(ctx, req, sq, space, mountpointMap[space.Id.OpaqueId])
 				if err != nil && err != errSkipSpace {
 					return err
 				}
@@ -235,6 +269,7 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 		return nil, err
 	}
 
+	facets := make([][]*searchsvc.Facet, 0, len(responses))
 	for _, res := range responses {
 		if res == nil {
 			continue
@@ -243,13 +278,14 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 		for _, match := range res.Matches {
 			matches = append(matches, match)
 		}
+		facets = append(facets, res.Facets)
 	}
 
 	// compile one sorted list of matches from all spaces and apply the limit if needed
 	sort.Sort(matches)
 	limit := req.PageSize
 	if limit == 0 {
-		limit = 200
+		limit = _defaultPageSize
 	}
 	if int32(len(matches)) > limit && limit != -1 {
 		matches = matches[0:limit]
@@ -258,10 +294,11 @@ func (s *Service) Search(ctx context.Context, req *searchsvc.SearchRequest) (*se
 	return &searchsvc.SearchResponse{
 		Matches:      matches,
 		TotalMatches: total,
+		Facets:       engine.MergeFacets(req.Facets, facets...),
 	}, nil
 }
 
-func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest, space *provider.StorageSpace, mountpointID string) (*searchsvc.SearchIndexResponse, error) {
+func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest, sq *semanticQuery, space *provider.StorageSpace, mountpointID string) (*searchsvc.SearchIndexResponse, error) {
 	if req.Ref != nil &&
 		(req.Ref.ResourceId.StorageId != space.Root.StorageId ||
 			req.Ref.ResourceId.SpaceId != space.Root.SpaceId) {
@@ -364,9 +401,16 @@ func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest,
 			Path:       searchPathPrefix,
 		},
 		PageSize: req.PageSize,
+		Facets:   req.Facets,
 	}
 	start := time.Now()
-	res, err := s.engine.Search(ctx, searchRequest)
+	var res *searchsvc.SearchIndexResponse
+	var err error
+	if sq != nil {
+		res, err = s.semanticSearch(ctx, searchRequest, sq)
+	} else {
+		res, err = s.engine.Search(ctx, searchRequest)
+	}
 	duration := time.Since(start)
 	if err != nil {
 		s.logger.Error().Err(err).Str("duration", fmt.Sprint(duration)).Str("space", space.Id.OpaqueId).Msg("failed to search the index")
@@ -404,6 +448,18 @@ func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest,
 
 	res.Matches = matches
 
+	// results of shares are counted for the space the user sees them in
+	if mountpointRootID != nil {
+		for _, facet := range res.Facets {
+			if facet.Field != engine.FacetSpace {
+				continue
+			}
+			for _, v := range facet.Values {
+				v.Value = storagespace.FormatStorageID(mountpointRootID.StorageId, mountpointRootID.SpaceId)
+			}
+		}
+	}
+
 	return res, nil
 }
 
@@ -414,19 +470,20 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 		return err
 	}
 
-	rootID, err := storagespace.ParseID(spaceID.OpaqueId)
+	rootID, err := s.spaceRootID(spaceID)
 	if err != nil {
-		s.logger.Error().Err(err).Msg("invalid space id")
 		return err
 	}
-	if rootID.StorageId == "" || rootID.SpaceId == "" {
-		s.logger.Error().Err(err).Msg("invalid space id")
-		return fmt.Errorf("invalid space id")
+
+	// unchanged resources are skipped, unless the space was indexed with another configuration
+	space := storagespace.FormatStorageID(rootID.StorageId, rootID.SpaceId)
+	outdated := s.indexVersions != nil && s.indexVersions.Outdated(space)
+	if outdated {
+		s.logger.Info().Str("space", space).Msg("the space was indexed with another configuration, indexing it completely")
 	}
-	rootID.OpaqueId = rootID.SpaceId
 
 	w := walker.NewWalker(s.gatewaySelector)
-	err = w.Walk(ownerCtx, &rootID, func(wd string, info *provider.ResourceInfo, err error) error {
+	err = w.Walk(ownerCtx, rootID, func(wd string, info *provider.ResourceInfo, err error) error {
 		if err != nil {
 			s.logger.Error().Err(err).Msg("error walking the tree")
 			return err
@@ -438,7 +495,7 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 
 		ref := &provider.Reference{
 			Path:       utils.MakeRelativePath(filepath.Join(wd, info.Path)),
-			ResourceId: &rootID,
+			ResourceId: rootID,
 		}
 		s.logger.Debug().Str("path", ref.Path).Msg("Walking tree")
 
@@ -446,7 +503,11 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 			Query: "id:" + storagespace.FormatResourceID(info.Id) + ` mtime>=` + utils.TSToTime(info.Mtime).Format(time.RFC3339Nano),
 		})
 
-		if err == nil && len(searchRes.Matches) >= 1 {
+		if !outdated && err == nil && len(searchRes.Matches) >= 1 {
+			if s.vectorMissing(storagespace.FormatResourceID(info.Id)) {
+				s.logger.Debug().Str("path", ref.Path).Msg("element hasn't changed but has no vector. Updating.")
+				s.UpsertItem(ref)
+			}
 			if info.Type == provider.ResourceType_RESOURCE_TYPE_CONTAINER {
 				s.logger.Debug().Str("path", ref.Path).Msg("subtree hasn't changed. Skipping.")
 				return filepath.SkipDir
@@ -464,11 +525,175 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 		return err
 	}
 
+	if outdated {
+		if err := s.indexVersions.Update(space); err != nil {
+			s.logger.Error().Err(err).Str("space", space).Msg("failed to store the index version of the space")
+		}
+	}
+
 	logDocCount(s.engine, s.logger)
 
 	return nil
 }
 
+// VerifySpace compares the index with the resources of a given space.
+// Resources which are not indexed are reported as missing, resources whose etag, mtime or path differ as stale
+// and indexed resources which no longer exist as orphaned. If fix is set only those resources are repaired.
+func (s *Service) VerifySpace(spaceID *provider.StorageSpaceId, fix bool) (*searchsvc.VerifySpaceResponse, error) {
+	ownerCtx, err := getAuthContext(s.serviceAccountID, s.gatewaySelector, s.serviceAccountSecret, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	rootID, err := s.spaceRootID(spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// engines limit the number of results per request, the index of the space is read page by page
+	indexed := make(map[string]*searchmsg.Entity)
+	req := &searchsvc.SearchIndexRequest{
+		Query: "id:*",
+		Ref: &searchmsg.Reference{
+			ResourceId: &searchmsg.ResourceID{
+				StorageId: rootID.StorageId,
+				SpaceId:   rootID.SpaceId,
+				OpaqueId:  rootID.OpaqueId,
+			},
+		},
+		PageSize: -1,
+	}
+	for {
+		searchRes, err := s.engine.Search(ownerCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range searchRes.Matches {
+			indexed[storagespace.FormatResourceID(&provider.ResourceId{
+				StorageId: match.GetEntity().GetId().GetStorageId(),
+				SpaceId:   match.GetEntity().GetId().GetSpaceId(),
+				OpaqueId:  match.GetEntity().GetId().GetOpaqueId(),
+			})] = match.GetEntity()
+		}
+
+		if searchRes.NextPageToken == "" {
+			break
+		}
+		req.PageToken = searchRes.NextPageToken
+	}
+
+	res := &searchsvc.VerifySpaceResponse{}
+	w := walker.NewWalker(s.gatewaySelector)
+	err = w.Walk(ownerCtx, rootID, func(wd string, info *provider.ResourceInfo, err error) error {
+		if err != nil {
+			s.logger.Error().Err(err).Msg("error walking the tree")
+			return err
+		}
+
+		if info == nil {
+			return nil
+		}
+
+		ref := &provider.Reference{
+			Path:       utils.MakeRelativePath(filepath.Join(wd, info.Path)),
+			ResourceId: rootID,
+		}
+		id := storagespace.FormatResourceID(info.Id)
+		res.Checked++
+
+		entity, ok := indexed[id]
+		delete(indexed, id)
+
+		discrepancy := &searchsvc.IndexDiscrepancy{
+			SpaceId:    spaceID.OpaqueId,
+			ResourceId: id,
+			Path:       ref.Path,
+		}
+		switch reason := staleReason(entity, info, ref.Path); {
+		case !ok:
+			discrepancy.Kind = DiscrepancyMissing
+			discrepancy.Reason = "not indexed"
+		case reason != "":
+			discrepancy.Kind = DiscrepancyStale
+			discrepancy.Reason = reason
+		default:
+			return nil
+		}
+
+		if fix {
+			s.UpsertItem(ref)
+			discrepancy.Fixed = true
+		}
+		res.Discrepancies = append(res.Discrepancies, discrepancy)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orphaned := make([]string, 0, len(indexed))
+	for id := range indexed {
+		orphaned = append(orphaned, id)
+	}
+	sort.Strings(orphaned)
+
+	for _, id := range orphaned {
+		discrepancy := &searchsvc.IndexDiscrepancy{
+			Kind:       DiscrepancyOrphaned,
+			SpaceId:    spaceID.OpaqueId,
+			ResourceId: id,
+			Path:       indexed[id].GetRef().GetPath(),
+			Reason:     "resource does not exist",
+		}
+
+		if fix {
+			if err := s.engine.Purge(id); err != nil {
+				s.logger.Error().Err(err).Str("id", id).Msg("failed to remove orphaned resource from index")
+			} else {
+				s.deleteVector(id)
+				discrepancy.Fixed = true
+			}
+		}
+		res.Discrepancies = append(res.Discrepancies, discrepancy)
+	}
+
+	s.logger.Debug().Str("space", spaceID.OpaqueId).Int64("checked", res.Checked).Int("discrepancies", len(res.Discrepancies)).Msg("verified the index of the space")
+
+	return res, nil
+}
+
+// staleReason returns why the indexed entity does not match the resource, it is empty if they match
+func staleReason(entity *searchmsg.Entity, info *provider.ResourceInfo, path string) string {
+	switch {
+	case entity.GetEtag() != "" && entity.GetEtag() != info.GetEtag():
+		return "etag differs"
+	case info.GetMtime() != nil && !entity.GetLastModifiedTime().AsTime().Equal(utils.TSToTime(info.GetMtime())):
+		return "mtime differs"
+	case entity.GetRef().GetPath() != path:
+		return "path differs"
+	default:
+		return ""
+	}
+}
+
+// spaceRootID returns the id of the root of the given space
+func (s *Service) spaceRootID(spaceID *provider.StorageSpaceId) (*provider.ResourceId, error) {
+	rootID, err := storagespace.ParseID(spaceID.GetOpaqueId())
+	if err != nil {
+		s.logger.Error().Err(err).Msg("invalid space id")
+		return nil, err
+	}
+	if rootID.StorageId == "" || rootID.SpaceId == "" {
+		s.logger.Error().Err(err).Msg("invalid space id")
+		return nil, fmt.Errorf("invalid space id")
+	}
+	rootID.OpaqueId = rootID.SpaceId
+
+	return &rootID, nil
+}
+
 // TrashItem marks the item as deleted.
 func (s *Service) TrashItem(rID *provider.ResourceId) {
 	err := s.engine.Delete(storagespace.FormatResourceID(rID))
@@ -499,6 +724,7 @@ func (s *Service) UpsertItem(ref *provider.Reference) {
 		}),
 		Path:     utils.MakeRelativePath(path),
 		Type:     uint64(stat.Info.Type),
+		Etag:     stat.Info.Etag,
 		Document: doc,
 	}
 	r.Hidden = strings.HasPrefix(r.Path, ".")
@@ -511,6 +737,7 @@ func (s *Service) UpsertItem(ref *provider.Reference) {
 		s.logger.Error().Err(err).Msg("error adding updating the resource in the index")
 	} else {
 		logDocCount(s.engine, s.logger)
+		s.upsertVector(ctx, r)
 	}
 
 	// determine if metadata needs to be stored in storage as well
@@ -627,9 +854,18 @@ func (s *Service) MoveItem(ref *provider.Reference) {
 		return
 	}
 
-	if err := s.engine.Move(storagespace.FormatResourceID(stat.GetInfo().GetId()), storagespace.FormatResourceID(stat.GetInfo().GetParentId()), path); err != nil {
+	id, parentID := storagespace.FormatResourceID(stat.GetInfo().GetId()), storagespace.FormatResourceID(stat.GetInfo().GetParentId())
+	if err := s.engine.Move(id, parentID, path); err != nil {
 		s.logger.Error().Err(err).Msg("failed to move the changed resource in the index")
+		return
 	}
+	s.moveVector(id, parentID)
+}
+
+// PurgeItem removes the vectors of a resource which was removed from the trash-bin and of its descendants.
+// The resources stay in the index, marked as deleted.
+func (s *Service) PurgeItem(rID *provider.ResourceId) {
+	s.deleteVector(storagespace.FormatResourceID(rID))
 }
 
 func (s *Service) resInfo(ref *provider.Reference) (context.Context, *provider.StatResponse, string) {
@@ -20,10 +20,14 @@ import (
 	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
 	contentMocks "github.com/owncloud/ocis/v2/services/search/pkg/content/mocks"
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
+	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
 	engineMocks "github.com/owncloud/ocis/v2/services/search/pkg/engine/mocks"
 	"github.com/owncloud/ocis/v2/services/search/pkg/search"
 	"github.com/stretchr/testify/mock"
+	microstore "go-micro.dev/v4/store"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("Searchprovider", func() {
@@ -90,7 +94,7 @@ var _ = Describe("Searchprovider", func() {
 		indexClient = &engineMocks.Engine{}
 		extractor = &contentMocks.Extractor{}
 
-		s = search.NewService(gatewaySelector, indexClient, extractor, logger, &config.Config{})
+		s = search.NewService(gatewaySelector, indexClient, extractor, nil, nil, nil, nil, logger, &config.Config{})
 
 		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
 			Status: status.NewOK(ctx),
@@ -110,7 +114,7 @@ var _ = Describe("Searchprovider", func() {
 
 	Describe("New", func() {
 		It("returns a new instance", func() {
-			s := search.NewService(gatewaySelector, indexClient, extractor, logger, &config.Config{})
+			s := search.NewService(gatewaySelector, indexClient, extractor, nil, nil, nil, nil, logger, &config.Config{})
 			Expect(s).ToNot(BeNil())
 		})
 	})
@@ -131,6 +135,192 @@ var _ = Describe("Searchprovider", func() {
 			err := s.IndexSpace(&sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"})
 			Expect(err).ShouldNot(HaveOccurred())
 		})
+
+		It("indexes unchanged resources again once after the configuration changed", func() {
+			versions := search.NewIndexVersions(microstore.NewMemoryStore(), &config.Config{
+				CustomProperties: []config.CustomProperty{{Name: "project", Type: "keyword"}},
+			})
+			s := search.NewService(gatewaySelector, indexClient, extractor, nil, nil, nil, versions, logger, &config.Config{})
+
+			gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+				Status: status.NewOK(context.Background()),
+				User:   user,
+			}, nil)
+			extractor.On("Extract", mock.Anything, mock.Anything, mock.Anything).Return(content.Document{}, nil)
+			indexClient.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+			indexClient.On("DocCount").Return(uint64(1), nil)
+			// the resource is indexed and did not change
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{{Entity: &searchmsg.Entity{Id: &searchmsg.ResourceID{StorageId: "storageid", OpaqueId: "opaqueid"}}}},
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   ri,
+			}, nil)
+
+			Expect(s.IndexSpace(&sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"})).To(Succeed())
+			indexClient.AssertNumberOfCalls(GinkgoT(), "Upsert", 1)
+
+			Expect(s.IndexSpace(&sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"})).To(Succeed())
+			indexClient.AssertNumberOfCalls(GinkgoT(), "Upsert", 1)
+		})
+
+		It("computes the missing vectors of unchanged resources", func() {
+			vectors, err := embedding.NewBoltStore(GinkgoT().TempDir())
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(vectors.Close)
+			s := search.NewService(gatewaySelector, indexClient, extractor, embedding.NewLocal(64), vectors, nil, nil, logger, &config.Config{})
+
+			gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+				Status: status.NewOK(context.Background()),
+				User:   user,
+			}, nil)
+			extractor.On("Extract", mock.Anything, mock.Anything, mock.Anything).Return(content.Document{Name: "foo.pdf"}, nil)
+			indexClient.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+			indexClient.On("DocCount").Return(uint64(1), nil)
+			// the resource is indexed and did not change
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{{Entity: &searchmsg.Entity{Id: &searchmsg.ResourceID{StorageId: "storageid", OpaqueId: "opaqueid"}}}},
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   ri,
+			}, nil)
+
+			Expect(s.IndexSpace(&sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"})).To(Succeed())
+			indexClient.AssertNumberOfCalls(GinkgoT(), "Upsert", 1)
+			_, err = vectors.Get("storageid$!opaqueid")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(s.IndexSpace(&sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"})).To(Succeed())
+			indexClient.AssertNumberOfCalls(GinkgoT(), "Upsert", 1)
+		})
+	})
+
+	Describe("VerifySpace", func() {
+		var (
+			spaceID = &sprovider.StorageSpaceId{OpaqueId: "storageid$spaceid!spaceid"}
+			info    = &sprovider.ResourceInfo{
+				Id: &sprovider.ResourceId{
+					StorageId: "storageid",
+					SpaceId:   "spaceid",
+					OpaqueId:  "opaqueid",
+				},
+				Path:  "foo.pdf",
+				Etag:  "etag",
+				Mtime: &typesv1beta1.Timestamp{Seconds: 4000},
+			}
+			indexed = func(id, etag string) *searchmsg.Match {
+				return &searchmsg.Match{
+					Entity: &searchmsg.Entity{
+						Ref: &searchmsg.Reference{Path: "./foo.pdf"},
+						Id: &searchmsg.ResourceID{
+							StorageId: "storageid",
+							SpaceId:   "spaceid",
+							OpaqueId:  id,
+						},
+						Etag:             etag,
+						LastModifiedTime: &timestamppb.Timestamp{Seconds: 4000},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   info,
+			}, nil)
+		})
+
+		It("reports nothing if the index matches the space", func() {
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{indexed("opaqueid", "etag")},
+			}, nil)
+
+			res, err := s.VerifySpace(spaceID, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Checked).To(Equal(int64(1)))
+			Expect(res.Discrepancies).To(BeEmpty())
+			indexClient.AssertCalled(GinkgoT(), "Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+				return req.PageSize == -1 && req.Ref.GetResourceId().GetSpaceId() == "spaceid"
+			}))
+		})
+
+		It("reads the index page by page", func() {
+			indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+				return req.PageToken == ""
+			})).Return(&searchsvc.SearchIndexResponse{
+				Matches:       []*searchmsg.Match{indexed("opaqueid", "etag")},
+				NextPageToken: "next",
+			}, nil).Once()
+			indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
+				return req.PageToken == "next"
+			})).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{indexed("orphan", "etag")},
+			}, nil).Once()
+
+			res, err := s.VerifySpace(spaceID, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Discrepancies).To(HaveLen(1))
+			Expect(res.Discrepancies[0].Kind).To(Equal(search.DiscrepancyOrphaned))
+			Expect(res.Discrepancies[0].ResourceId).To(Equal("storageid$spaceid!orphan"))
+		})
+
+		It("reports missing resources", func() {
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{}, nil)
+
+			res, err := s.VerifySpace(spaceID, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Discrepancies).To(HaveLen(1))
+			Expect(res.Discrepancies[0].Kind).To(Equal(search.DiscrepancyMissing))
+			Expect(res.Discrepancies[0].ResourceId).To(Equal("storageid$spaceid!opaqueid"))
+			Expect(res.Discrepancies[0].Path).To(Equal("./foo.pdf"))
+			Expect(res.Discrepancies[0].Fixed).To(BeFalse())
+		})
+
+		It("reports stale and orphaned resources", func() {
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{indexed("opaqueid", "outdated"), indexed("orphan", "etag")},
+			}, nil)
+
+			res, err := s.VerifySpace(spaceID, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Discrepancies).To(HaveLen(2))
+			Expect(res.Discrepancies[0].Kind).To(Equal(search.DiscrepancyStale))
+			Expect(res.Discrepancies[0].Reason).To(Equal("etag differs"))
+			Expect(res.Discrepancies[1].Kind).To(Equal(search.DiscrepancyOrphaned))
+			Expect(res.Discrepancies[1].ResourceId).To(Equal("storageid$spaceid!orphan"))
+			indexClient.AssertNotCalled(GinkgoT(), "Upsert", mock.Anything, mock.Anything)
+			indexClient.AssertNotCalled(GinkgoT(), "Purge", mock.Anything)
+		})
+
+		It("fixes the discrepancies", func() {
+			gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+				Status: status.NewOK(context.Background()),
+				User:   user,
+			}, nil)
+			gatewayClient.On("GetPath", mock.Anything, mock.Anything).Return(&sprovider.GetPathResponse{
+				Status: status.NewOK(context.Background()),
+				Path:   info.Path,
+			}, nil)
+			extractor.On("Extract", mock.Anything, mock.Anything, mock.Anything).Return(content.Document{}, nil)
+			indexClient.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+			indexClient.On("Purge", mock.Anything).Return(nil)
+			indexClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchIndexResponse{
+				Matches: []*searchmsg.Match{indexed("opaqueid", "outdated"), indexed("orphan", "etag")},
+			}, nil)
+
+			res, err := s.VerifySpace(spaceID, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Discrepancies).To(HaveLen(2))
+			Expect(res.Discrepancies[0].Fixed).To(BeTrue())
+			Expect(res.Discrepancies[1].Fixed).To(BeTrue())
+			indexClient.AssertCalled(GinkgoT(), "Upsert", "storageid$spaceid!opaqueid", mock.MatchedBy(func(r engine.Resource) bool {
+				return r.Etag == "etag"
+			}))
+			indexClient.AssertCalled(GinkgoT(), "Purge", "storageid$spaceid!orphan")
+		})
 	})
 
 	Describe("Search", func() {
@@ -412,6 +602,10 @@ var _ = Describe("Searchprovider", func() {
 								},
 							},
 						},
+						Facets: []*searchsvc.Facet{
+							{Field: "mediatype", Values: []*searchsvc.FacetValue{{Value: "pdf", Count: 2}}},
+							{Field: "space", Values: []*searchsvc.FacetValue{{Value: "storageproviderid$spaceid", Count: 2}}},
+						},
 					}, nil)
 					indexClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchIndexRequest) bool {
 						return req.Ref.ResourceId.OpaqueId == personalSpace.Root.OpaqueId &&
@@ -438,6 +632,10 @@ var _ = Describe("Searchprovider", func() {
 								},
 							},
 						},
+						Facets: []*searchsvc.Facet{
+							{Field: "mediatype", Values: []*searchsvc.FacetValue{{Value: "image", Count: 4}, {Value: "pdf", Count: 1}}},
+							{Field: "space", Values: []*searchsvc.FacetValue{{Value: "storageid$personalspace", Count: 5}}},
+						},
 					}, nil)
 				})
 
@@ -480,6 +678,23 @@ var _ = Describe("Searchprovider", func() {
 					ids := []string{res.Matches[0].Entity.Id.OpaqueId, res.Matches[1].Entity.Id.OpaqueId}
 					Expect(ids).To(Equal([]string{"grant-shared-id", "foo-id"}))
 				})
+
+				It("sums up the facets of all spaces", func() {
+					res, err := s.Search(ctx, &searchsvc.SearchRequest{
+						Query:  "foo",
+						Facets: []*searchsvc.FacetRequest{{Field: "mediatype"}, {Field: "space"}},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(res.Facets).To(HaveLen(2))
+					Expect(res.Facets[0].Values).To(Equal([]*searchsvc.FacetValue{
+						{Value: "image", Count: 4},
+						{Value: "pdf", Count: 3},
+					}))
+					Expect(res.Facets[1].Values).To(Equal([]*searchsvc.FacetValue{
+						{Value: "storageid$personalspace", Count: 5},
+						{Value: "storageproviderid$spaceid", Count: 2},
+					}))
+				})
 			})
 		})
 	})
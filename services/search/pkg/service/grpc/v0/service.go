@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
@@ -14,12 +15,14 @@ import (
 	"github.com/cs3org/reva/v2/pkg/errtypes"
 	"github.com/cs3org/reva/v2/pkg/events/stream"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/cs3org/reva/v2/pkg/store"
 	"github.com/cs3org/reva/v2/pkg/token"
 	"github.com/cs3org/reva/v2/pkg/token/manager/jwt"
 	"github.com/cs3org/reva/v2/pkg/utils"
 	"github.com/jellydator/ttlcache/v2"
 	merrors "go-micro.dev/v4/errors"
 	"go-micro.dev/v4/metadata"
+	microstore "go-micro.dev/v4/store"
 	grpcmetadata "google.golang.org/grpc/metadata"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
@@ -28,8 +31,10 @@ import (
 	searchsvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/search/v0"
 	"github.com/owncloud/ocis/v2/services/search/pkg/config"
 	"github.com/owncloud/ocis/v2/services/search/pkg/content"
+	"github.com/owncloud/ocis/v2/services/search/pkg/embedding"
 	"github.com/owncloud/ocis/v2/services/search/pkg/engine"
 	"github.com/owncloud/ocis/v2/services/search/pkg/query/bleve"
+	"github.com/owncloud/ocis/v2/services/search/pkg/query/opensearch"
 	"github.com/owncloud/ocis/v2/services/search/pkg/search"
 )
 
@@ -44,7 +49,7 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 	var eng engine.Engine
 	switch cfg.Engine.Type {
 	case "bleve":
-		idx, err := engine.NewBleveIndex(cfg.Engine.Bleve.Datapath)
+		idx, err := engine.NewBleveIndex(cfg.Engine.Bleve.Datapath, cfg.CustomProperties...)
 		if err != nil {
 			return nil, teardown, err
 		}
@@ -53,7 +58,12 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 			_ = idx.Close()
 		}
 
-		eng = engine.NewBleveEngine(idx, bleve.DefaultCreator)
+		eng = engine.NewBleveEngine(idx, bleve.NewCreator(engine.PropertyFields(cfg.CustomProperties)))
+	case "opensearch":
+		var err error
+		if eng, err = engine.NewOpenSearchEngine(cfg.Engine.OpenSearch, opensearch.NewCreator(engine.PropertyFields(cfg.CustomProperties)), cfg.CustomProperties...); err != nil {
+			return nil, teardown, err
+		}
 	default:
 		return nil, teardown, fmt.Errorf("unknown search engine: %s", cfg.Engine.Type)
 	}
@@ -68,7 +78,11 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 	var extractor content.Extractor
 	switch cfg.Extractor.Type {
 	case "basic":
-		if extractor, err = content.NewBasicExtractor(logger); err != nil {
+		if extractor, err = content.NewBasicExtractor(logger, cfg.CustomProperties...); err != nil {
+			return nil, teardown, err
+		}
+	case "native":
+		if extractor, err = content.NewNativeExtractor(selector, logger, cfg); err != nil {
 			return nil, teardown, err
 		}
 	case "tika":
@@ -79,6 +93,28 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 		return nil, teardown, fmt.Errorf("unknown search extractor: %s", cfg.Extractor.Type)
 	}
 
+	// initialize the embeddings of the semantic search
+	var (
+		embedder embedding.Provider
+		vectors  embedding.Store
+	)
+	switch cfg.Embedding.Type {
+	case "", "none":
+	case "local":
+		if vectors, err = embedding.NewBoltStore(cfg.Embedding.Datapath); err != nil {
+			return nil, teardown, err
+		}
+		embedder = embedding.NewLocal(cfg.Embedding.Local.Dimensions)
+
+		closeIndex := teardown
+		teardown = func() {
+			closeIndex()
+			_ = vectors.Close()
+		}
+	default:
+		return nil, teardown, fmt.Errorf("unknown search embedding provider: %s", cfg.Embedding.Type)
+	}
+
 	bus, err := stream.NatsFromConfig(cfg.Service.Name, false, stream.NatsConfig{
 		Endpoint:             cfg.Events.Endpoint,
 		Cluster:              cfg.Events.Cluster,
@@ -92,7 +128,16 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 		return nil, teardown, err
 	}
 
-	ss := search.NewService(selector, eng, extractor, logger, cfg)
+	st := store.Create(
+		store.Store(cfg.Store.Store),
+		microstore.Nodes(cfg.Store.Nodes...),
+		microstore.Database(cfg.Store.Database),
+		microstore.Table(cfg.Store.Table),
+		store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+	)
+	savedSearches := search.NewSavedSearches(st, bus)
+
+	ss := search.NewService(selector, eng, extractor, embedder, vectors, savedSearches, search.NewIndexVersions(st, cfg), logger, cfg)
 
 	// setup event handling
 	if err := search.HandleEvents(ss, bus, logger, cfg); err != nil {
@@ -116,6 +161,7 @@ func NewHandler(opts ...Option) (searchsvc.SearchProviderHandler, func(), error)
 		id:           cfg.GRPC.Namespace + "." + cfg.Service.Name,
 		log:          logger,
 		searcher:     ss,
+		saved:        savedSearches,
 		cache:        cache,
 		tokenManager: tokenManager,
 		gws:          selector,
@@ -128,6 +174,7 @@ type Service struct {
 	id           string
 	log          log.Logger
 	searcher     search.Searcher
+	saved        *search.SavedSearches
 	cache        *ttlcache.Cache
 	tokenManager token.Manager
 	gws          *pool.Selector[gateway.GatewayAPIClient]
@@ -151,7 +198,7 @@ func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *s
 	}
 	ctx = revactx.ContextSetUser(ctx, u)
 
-	key := cacheKey(in.Query, in.PageSize, in.Ref, u)
+	key := cacheKey(in.Query, in.PageSize, in.Ref, in.Facets, u)
 	res, ok := s.FromCache(key)
 	if !ok {
 		var err error
@@ -159,6 +206,7 @@ func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *s
 			Query:    in.Query,
 			PageSize: in.PageSize,
 			Ref:      in.Ref,
+			Facets:   in.Facets,
 		})
 		if err != nil {
 			switch err.(type) {
@@ -175,6 +223,7 @@ func (s Service) Search(ctx context.Context, in *searchsvc.SearchRequest, out *s
 	out.Matches = res.Matches
 	out.TotalMatches = res.TotalMatches
 	out.NextPageToken = res.NextPageToken
+	out.Facets = res.Facets
 	return nil
 }
 
@@ -185,34 +234,147 @@ func (s Service) IndexSpace(_ context.Context, in *searchsvc.IndexSpaceRequest,
 	}
 
 	// index all spaces instead
-	gwc, err := s.gws.Next()
+	spaces, err := s.listSpaces()
 	if err != nil {
 		return err
 	}
 
-	ctx, err := utils.GetServiceUserContext(s.cfg.ServiceAccount.ServiceAccountID, gwc, s.cfg.ServiceAccount.ServiceAccountSecret)
+	for _, space := range spaces {
+		if err := s.searcher.IndexSpace(space.GetId()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifySpace compares the index with the resources of a space and reports the discrepancies
+func (s Service) VerifySpace(_ context.Context, in *searchsvc.VerifySpaceRequest, out *searchsvc.VerifySpaceResponse) error {
+	if in.GetSpaceId() != "" {
+		res, err := s.searcher.VerifySpace(&provider.StorageSpaceId{OpaqueId: in.GetSpaceId()}, in.GetFix())
+		if err != nil {
+			return err
+		}
+		out.Checked = res.GetChecked()
+		out.Discrepancies = res.GetDiscrepancies()
+		return nil
+	}
+
+	// verify all spaces instead
+	spaces, err := s.listSpaces()
 	if err != nil {
 		return err
 	}
 
-	resp, err := gwc.ListStorageSpaces(ctx, &provider.ListStorageSpacesRequest{})
+	for _, space := range spaces {
+		res, err := s.searcher.VerifySpace(space.GetId(), in.GetFix())
+		if err != nil {
+			return err
+		}
+		out.Checked += res.GetChecked()
+		out.Discrepancies = append(out.Discrepancies, res.GetDiscrepancies()...)
+	}
+
+	return nil
+}
+
+// ListSavedSearches returns the saved searches of the current user
+func (s Service) ListSavedSearches(ctx context.Context, _ *searchsvc.ListSavedSearchesRequest, out *searchsvc.ListSavedSearchesResponse) error {
+	u, err := s.currentUser(ctx)
 	if err != nil {
 		return err
 	}
 
-	if resp.GetStatus().GetCode() != rpc.Code_CODE_OK {
-		return errors.New(resp.GetStatus().GetMessage())
+	searches, err := s.saved.List(u.GetId().GetOpaqueId())
+	if err != nil {
+		return s.savedSearchError(err)
 	}
 
-	for _, space := range resp.GetStorageSpaces() {
-		if err := s.searcher.IndexSpace(space.GetId()); err != nil {
-			return err
-		}
+	out.SavedSearches = searches
+	return nil
+}
+
+// SaveSearch creates or updates a saved search of the current user
+func (s Service) SaveSearch(ctx context.Context, in *searchsvc.SaveSearchRequest, out *searchsvc.SaveSearchResponse) error {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	search, err := s.saved.Save(u.GetId().GetOpaqueId(), in.GetSavedSearch())
+	if err != nil {
+		return s.savedSearchError(err)
+	}
+
+	out.SavedSearch = search
+	return nil
+}
+
+// DeleteSavedSearch removes a saved search of the current user
+func (s Service) DeleteSavedSearch(ctx context.Context, in *searchsvc.DeleteSavedSearchRequest, _ *searchsvc.DeleteSavedSearchResponse) error {
+	u, err := s.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.saved.Delete(u.GetId().GetOpaqueId(), in.GetId()); err != nil {
+		return s.savedSearchError(err)
 	}
 
 	return nil
 }
 
+// currentUser unpacks the user from the token of the context
+func (s Service) currentUser(ctx context.Context) (*user.User, error) {
+	t, ok := metadata.Get(ctx, revactx.TokenHeader)
+	if !ok {
+		s.log.Error().Msg("Could not get token from context")
+		return nil, merrors.Unauthorized(s.id, "could not get token from context")
+	}
+
+	u, _, err := s.tokenManager.DismantleToken(ctx, t)
+	if err != nil {
+		return nil, merrors.Unauthorized(s.id, err.Error())
+	}
+
+	return u, nil
+}
+
+func (s Service) savedSearchError(err error) error {
+	switch err.(type) {
+	case errtypes.BadRequest:
+		return merrors.BadRequest(s.id, err.Error())
+	case errtypes.NotFound:
+		return merrors.NotFound(s.id, err.Error())
+	default:
+		return merrors.InternalServerError(s.id, err.Error())
+	}
+}
+
+// listSpaces returns all storage spaces visible to the service account
+func (s Service) listSpaces() ([]*provider.StorageSpace, error) {
+	gwc, err := s.gws.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := utils.GetServiceUserContext(s.cfg.ServiceAccount.ServiceAccountID, gwc, s.cfg.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gwc.ListStorageSpaces(ctx, &provider.ListStorageSpacesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, errors.New(resp.GetStatus().GetMessage())
+	}
+
+	return resp.GetStorageSpaces(), nil
+}
+
 // FromCache pulls a search result from cache
 func (s Service) FromCache(key string) (*searchsvc.SearchResponse, bool) {
 	v, err := s.cache.Get(key)
@@ -230,6 +392,10 @@ func (s Service) Cache(key string, res *searchsvc.SearchResponse) {
 	_ = s.cache.Set(key, res)
 }
 
-func cacheKey(query string, pagesize int32, ref *v0.Reference, user *user.User) string {
-	return fmt.Sprintf("%s|%d|%s$%s!%s/%s|%s", query, pagesize, ref.GetResourceId().GetStorageId(), ref.GetResourceId().GetSpaceId(), ref.GetResourceId().GetOpaqueId(), ref.GetPath(), user.GetId().GetOpaqueId())
+func cacheKey(query string, pagesize int32, ref *v0.Reference, facets []*searchsvc.FacetRequest, user *user.User) string {
+	facetKeys := make([]string, 0, len(facets))
+	for _, f := range facets {
+		facetKeys = append(facetKeys, fmt.Sprintf("%s:%d", f.GetField(), f.GetSize()))
+	}
+	return fmt.Sprintf("%s|%d|%s$%s!%s/%s|%s|%s", query, pagesize, ref.GetResourceId().GetStorageId(), ref.GetResourceId().GetSpaceId(), ref.GetResourceId().GetOpaqueId(), ref.GetPath(), strings.Join(facetKeys, ","), user.GetId().GetOpaqueId())
 }
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/cs3org/reva/v2/pkg/bytesize"
+	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config/parser"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/logging"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
+	"github.com/urfave/cli/v2"
+)
+
+// Cache wraps the commands managing the thumbnail cache.
+func Cache(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "cache",
+		Usage: "manage the thumbnail cache",
+		Subcommands: []*cli.Command{
+			CacheStats(cfg),
+			CachePrune(cfg),
+		},
+	}
+}
+
+// CacheStats prints the number and the size of the cached thumbnails.
+func CacheStats(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "stats",
+		Usage: "show the number and the size of the cached thumbnails",
+		Before: func(_ *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			cache, err := thumbnailCache(cfg)
+			if err != nil {
+				return err
+			}
+
+			stats, err := cache.Stats()
+			if err != nil {
+				return configlog.ReturnError(err)
+			}
+
+			fmt.Printf("Storage: %s\n", cfg.Thumbnail.Storage)
+			fmt.Printf("Thumbnails: %d\n", stats.Thumbnails)
+			fmt.Printf("Size: %d bytes\n", stats.Size)
+			return nil
+		},
+	}
+}
+
+// CachePrune removes the least recently used thumbnails from the cache.
+func CachePrune(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "prune",
+		Usage: "remove the least recently used thumbnails until the cache is not larger than the maximum size",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "max-size",
+				Usage: "The maximum size of the cache, for example 2GB. Use 0 to remove all thumbnails. Defaults to THUMBNAILS_FILESYSTEMSTORAGE_MAX_SIZE for the filesystem storage.",
+			},
+		},
+		Before: func(_ *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			maxSize := c.String("max-size")
+			if maxSize == "" && cfg.Thumbnail.Storage == storage.TypeFileSystem {
+				maxSize = cfg.Thumbnail.FileSystemStorage.MaxSize
+			}
+			if maxSize == "" {
+				return fmt.Errorf("the maximum size of the cache is required")
+			}
+			size, err := bytesize.Parse(maxSize)
+			if err != nil {
+				return fmt.Errorf("could not parse the maximum size: %w", err)
+			}
+
+			cache, err := thumbnailCache(cfg)
+			if err != nil {
+				return err
+			}
+
+			removed, err := cache.Prune(size.Bytes())
+			fmt.Printf("Removed %d thumbnails (%d bytes)\n", removed.Thumbnails, removed.Size)
+			return configlog.ReturnError(err)
+		},
+	}
+}
+
+func thumbnailCache(cfg *config.Config) (storage.Cache, error) {
+	logger := logging.Configure(cfg.Service.Name, cfg.Log)
+	s, err := storage.New(cfg.Thumbnail, logger)
+	if err != nil {
+		return nil, configlog.ReturnError(err)
+	}
+
+	cache, ok := s.(storage.Cache)
+	if !ok {
+		return nil, fmt.Errorf("the %s storage does not support cache management", cfg.Thumbnail.Storage)
+	}
+	return cache, nil
+}
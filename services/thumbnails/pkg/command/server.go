@@ -4,8 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cs3org/reva/v2/pkg/bytesize"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/events/stream"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/oklog/run"
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/configlog"
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/ocis-pkg/registry"
 	ogrpc "github.com/owncloud/ocis/v2/ocis-pkg/service/grpc"
 	"github.com/owncloud/ocis/v2/ocis-pkg/tracing"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
@@ -13,10 +19,16 @@ import (
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config/parser"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/logging"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/metrics"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/pregenerator"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/preprocessor"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/server/debug"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/server/grpc"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/server/http"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/imgsource"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
 	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Server is the entrypoint for the server command.
@@ -126,7 +138,98 @@ func Server(cfg *config.Config) *cli.Command {
 				cancel()
 			})
 
+			if cfg.Thumbnail.Storage == storage.TypeFileSystem && cfg.Thumbnail.FileSystemStorage.MaxSize != "" {
+				maxSize, err := bytesize.Parse(cfg.Thumbnail.FileSystemStorage.MaxSize)
+				if err != nil {
+					logger.Error().Err(err).Msg("could not parse the maximum size of the filesystem storage")
+					return err
+				}
+
+				evictor := storage.NewEvictor(
+					storage.NewFileSystemStorage(cfg.Thumbnail.FileSystemStorage, logger),
+					maxSize.Bytes(),
+					cfg.Thumbnail.FileSystemStorage.EvictionInterval,
+					logger,
+				)
+				gr.Add(func() error {
+					return evictor.Run(ctx)
+				}, func(_ error) {
+					cancel()
+				})
+			}
+
+			if cfg.Thumbnail.Pregeneration.Enabled {
+				p, err := newPregenerator(cfg, logger, traceProvider)
+				if err != nil {
+					logger.Error().Err(err).Msg("could not create the thumbnail pregenerator")
+					return err
+				}
+
+				bus, err := stream.NatsFromConfig(cfg.Service.Name, false, stream.NatsConfig(cfg.Events))
+				if err != nil {
+					return err
+				}
+				ch, err := events.Consume(bus, "thumbnails", events.UploadReady{})
+				if err != nil {
+					return err
+				}
+
+				gr.Add(func() error {
+					return p.Run(ctx, ch)
+				}, func(_ error) {
+					logger.Info().
+						Str("transport", "stream").
+						Str("server", cfg.Service.Name).
+						Msg("Shutting down server")
+
+					cancel()
+				})
+			}
+
 			return gr.Run()
 		},
 	}
 }
+
+// newPregenerator creates the pregenerator with the same storage, sources and converters as the grpc service
+func newPregenerator(cfg *config.Config, logger log.Logger, traceProvider trace.TracerProvider) (pregenerator.Pregenerator, error) {
+	tconf := cfg.Thumbnail
+	tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+	gatewaySelector, err := pool.GatewaySelector(tconf.RevaGateway,
+		pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+		pool.WithTLSMode(tm),
+		pool.WithRegistry(registry.GetRegistry()),
+		pool.WithTracerProvider(traceProvider),
+	)
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+	b, err := bytesize.Parse(tconf.MaxInputImageFileSize)
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+	converters, err := preprocessor.NewDocumentConverters(tconf.Converters, b.Bytes())
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+	thumbnailStorage, err := storage.New(tconf, logger)
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+	resolutions, err := thumbnail.ParseResolutions(tconf.Resolutions)
+	if err != nil {
+		return pregenerator.Pregenerator{}, err
+	}
+
+	return pregenerator.New(
+		cfg,
+		thumbnail.NewSimpleManager(resolutions, thumbnailStorage, logger, tconf.MaxInputWidth, tconf.MaxInputHeight),
+		imgsource.NewCS3Source(tconf, gatewaySelector, b),
+		gatewaySelector,
+		converters,
+		logger,
+	)
+}
@@ -3,6 +3,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"go-micro.dev/v4/client"
@@ -26,18 +27,35 @@ type Config struct {
 
 	Thumbnail Thumbnail `yaml:"thumbnail"`
 
+	Events         Events         `yaml:"events"`
+	ServiceAccount ServiceAccount `yaml:"service_account"`
+
 	Context context.Context `yaml:"-"`
 }
 
 // FileSystemStorage defines the available filesystem storage configuration.
 type FileSystemStorage struct {
-	RootDirectory string `yaml:"root_directory" env:"THUMBNAILS_FILESYSTEMSTORAGE_ROOT" desc:"The directory where the filesystem storage will store the thumbnails. If not defined, the root directory derives from $OCIS_BASE_DATA_PATH/thumbnails." introductionVersion:"pre5.0"`
+	RootDirectory    string        `yaml:"root_directory" env:"THUMBNAILS_FILESYSTEMSTORAGE_ROOT" desc:"The directory where the filesystem storage will store the thumbnails. If not defined, the root directory derives from $OCIS_BASE_DATA_PATH/thumbnails." introductionVersion:"pre5.0"`
+	MaxSize          string        `yaml:"max_size" env:"THUMBNAILS_FILESYSTEMSTORAGE_MAX_SIZE" desc:"The maximum size of the thumbnails in the filesystem storage. If exceeded, the least recently used thumbnails are removed in the background. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB. The size is unlimited if empty." introductionVersion:"7.0.0"`
+	EvictionInterval time.Duration `yaml:"eviction_interval" env:"THUMBNAILS_FILESYSTEMSTORAGE_EVICTION_INTERVAL" desc:"The interval in which the size of the filesystem storage is checked against THUMBNAILS_FILESYSTEMSTORAGE_MAX_SIZE. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+}
+
+// S3Storage defines the available S3 storage configuration.
+type S3Storage struct {
+	Endpoint  string `yaml:"endpoint" env:"THUMBNAILS_S3STORAGE_ENDPOINT" desc:"The endpoint of the S3 compatible object storage including the scheme, for example 'https://s3.example.com'." introductionVersion:"7.0.0"`
+	Region    string `yaml:"region" env:"THUMBNAILS_S3STORAGE_REGION" desc:"The region of the S3 bucket." introductionVersion:"7.0.0"`
+	AccessKey string `yaml:"access_key" env:"THUMBNAILS_S3STORAGE_ACCESS_KEY" desc:"The access key of the S3 bucket." introductionVersion:"7.0.0"`
+	SecretKey string `yaml:"secret_key" env:"THUMBNAILS_S3STORAGE_SECRET_KEY" desc:"The secret key of the S3 bucket." introductionVersion:"7.0.0"`
+	Bucket    string `yaml:"bucket" env:"THUMBNAILS_S3STORAGE_BUCKET" desc:"The name of the S3 bucket storing the thumbnails. The bucket must exist." introductionVersion:"7.0.0"`
+	Insecure  bool   `yaml:"insecure" env:"OCIS_INSECURE;THUMBNAILS_S3STORAGE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the S3 storage." introductionVersion:"7.0.0"`
 }
 
 // Thumbnail defines the available thumbnail related configuration.
 type Thumbnail struct {
 	Resolutions           []string          `yaml:"resolutions" env:"THUMBNAILS_RESOLUTIONS" desc:"The supported list of target resolutions in the format WidthxHeight like 32x32. You can define any resolution as required. See the Environment Variable Types description for more details." introductionVersion:"pre5.0"`
+	Storage               string            `yaml:"storage" env:"THUMBNAILS_STORAGE" desc:"The storage for the generated thumbnails. Supported values are 'filesystem' and 's3'. Use 's3' to share the thumbnails between multiple instances of the thumbnails service." introductionVersion:"7.0.0"`
 	FileSystemStorage     FileSystemStorage `yaml:"filesystem_storage"`
+	S3Storage             S3Storage         `yaml:"s3_storage"`
 	WebdavAllowInsecure   bool              `yaml:"webdav_allow_insecure" env:"OCIS_INSECURE;THUMBNAILS_WEBDAVSOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the webdav source." introductionVersion:"pre5.0"`
 	CS3AllowInsecure      bool              `yaml:"cs3_allow_insecure" env:"OCIS_INSECURE;THUMBNAILS_CS3SOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the CS3 source." introductionVersion:"pre5.0"`
 	RevaGateway           string            `yaml:"reva_gateway" env:"OCIS_REVA_GATEWAY" desc:"CS3 gateway used to look up user metadata" introductionVersion:"pre5.0"`
@@ -47,4 +65,41 @@ type Thumbnail struct {
 	MaxInputWidth         int               `yaml:"max_input_width" env:"THUMBNAILS_MAX_INPUT_WIDTH" desc:"The maximum width of an input image which is being processed." introductionVersion:"6.0.0"`
 	MaxInputHeight        int               `yaml:"max_input_height" env:"THUMBNAILS_MAX_INPUT_HEIGHT" desc:"The maximum height of an input image which is being processed." introductionVersion:"6.0.0"`
 	MaxInputImageFileSize string            `yaml:"max_input_image_file_size" env:"THUMBNAILS_MAX_INPUT_IMAGE_FILE_SIZE" desc:"The maximum file size of an input image which is being processed. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB." introductionVersion:"6.0.0"`
+	Converters            Converters        `yaml:"converters"`
+	Pregeneration         Pregeneration     `yaml:"pregeneration"`
+}
+
+// Pregeneration defines the generation of thumbnails for uploaded files before they are requested.
+type Pregeneration struct {
+	Enabled     bool     `yaml:"enabled" env:"THUMBNAILS_PREGENERATION_ENABLED" desc:"Generate the thumbnails of uploaded files in the background when their postprocessing is finished, so they don't need to be generated on the first request. The thumbnails are generated for all resolutions defined in THUMBNAILS_RESOLUTIONS. Requires a service account." introductionVersion:"7.0.0"`
+	Concurrency int      `yaml:"concurrency" env:"THUMBNAILS_PREGENERATION_CONCURRENCY" desc:"The maximum number of files for which thumbnails are generated at the same time." introductionVersion:"7.0.0"`
+	Types       []string `yaml:"types" env:"THUMBNAILS_PREGENERATION_TYPES" desc:"Additional types of the generated thumbnails for clients requesting them. Supported values are 'webp' and 'avif'. The thumbnails are always generated in the type which is used when no specific type is requested. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
+}
+
+// Events combines the configuration options for the event bus.
+type Events struct {
+	Endpoint             string `yaml:"endpoint" env:"OCIS_EVENTS_ENDPOINT;THUMBNAILS_EVENTS_ENDPOINT" desc:"The address of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture." introductionVersion:"7.0.0"`
+	Cluster              string `yaml:"cluster" env:"OCIS_EVENTS_CLUSTER;THUMBNAILS_EVENTS_CLUSTER" desc:"The clusterID of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture. Mandatory when using NATS as event system." introductionVersion:"7.0.0"`
+	TLSInsecure          bool   `yaml:"tls_insecure" env:"OCIS_INSECURE;THUMBNAILS_EVENTS_TLS_INSECURE" desc:"Whether to verify the server TLS certificates." introductionVersion:"7.0.0"`
+	TLSRootCACertificate string `yaml:"tls_root_ca_certificate" env:"OCIS_EVENTS_TLS_ROOT_CA_CERTIFICATE;THUMBNAILS_EVENTS_TLS_ROOT_CA_CERTIFICATE" desc:"The root CA certificate used to validate the server's TLS certificate. If provided THUMBNAILS_EVENTS_TLS_INSECURE will be seen as false." introductionVersion:"7.0.0"`
+	EnableTLS            bool   `yaml:"enable_tls" env:"OCIS_EVENTS_ENABLE_TLS;THUMBNAILS_EVENTS_ENABLE_TLS" desc:"Enable TLS for the connection to the events broker. The events broker is the ocis service which receives and delivers events between the services." introductionVersion:"7.0.0"`
+	AuthUsername         string `yaml:"username" env:"OCIS_EVENTS_AUTH_USERNAME;THUMBNAILS_EVENTS_AUTH_USERNAME" desc:"The username to authenticate with the events broker. The events broker is the ocis service which receives and delivers events between the services." introductionVersion:"7.0.0"`
+	AuthPassword         string `yaml:"password" env:"OCIS_EVENTS_AUTH_PASSWORD;THUMBNAILS_EVENTS_AUTH_PASSWORD" desc:"The password to authenticate with the events broker. The events broker is the ocis service which receives and delivers events between the services." introductionVersion:"7.0.0"`
+}
+
+// ServiceAccount is the configuration for the used service account
+type ServiceAccount struct {
+	ServiceAccountID     string `yaml:"service_account_id" env:"OCIS_SERVICE_ACCOUNT_ID;THUMBNAILS_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use. See the 'auth-service' service description for more details." introductionVersion:"7.0.0"`
+	ServiceAccountSecret string `yaml:"service_account_secret" env:"OCIS_SERVICE_ACCOUNT_SECRET;THUMBNAILS_SERVICE_ACCOUNT_SECRET" desc:"The service account secret." introductionVersion:"7.0.0"`
+}
+
+// Converters defines the converters rendering thumbnails of pdf files, videos and office documents.
+// Commands must contain the placeholders {input} and {outdir} which are replaced by the path of the file and the directory the image is written to.
+type Converters struct {
+	PDFCommand     string        `yaml:"pdf_command" env:"THUMBNAILS_CONVERTER_PDF_COMMAND" desc:"The command rendering the first page of a pdf file into an image, for example 'pdftoppm -png -singlefile -f 1 -l 1 -scale-to 1920 {input} {outdir}/page'. Thumbnails for pdf files are disabled if empty." introductionVersion:"7.0.0"`
+	VideoCommand   string        `yaml:"video_command" env:"THUMBNAILS_CONVERTER_VIDEO_COMMAND" desc:"The command rendering a keyframe of a video into an image, for example 'ffmpeg -v error -i {input} -vf thumbnail -frames:v 1 {outdir}/frame.png'. Thumbnails for videos are disabled if empty." introductionVersion:"7.0.0"`
+	OfficeCommand  string        `yaml:"office_command" env:"THUMBNAILS_CONVERTER_OFFICE_COMMAND" desc:"The command rendering the first page of an office document into an image, for example 'soffice --headless --convert-to png --outdir {outdir} {input}'. Ignored if THUMBNAILS_CONVERTER_OFFICE_ENDPOINT is set." introductionVersion:"7.0.0"`
+	OfficeEndpoint string        `yaml:"office_endpoint" env:"THUMBNAILS_CONVERTER_OFFICE_ENDPOINT" desc:"The URL of a conversion service rendering office documents into an image, for example 'https://collabora.example.com/cool/convert-to/png'. The document is posted as the multipart form field 'data'. Thumbnails for office documents are disabled if neither an endpoint nor a command is set." introductionVersion:"7.0.0"`
+	OfficeInsecure bool          `yaml:"office_insecure" env:"OCIS_INSECURE;THUMBNAILS_CONVERTER_OFFICE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the conversion service for office documents." introductionVersion:"7.0.0"`
+	Timeout        time.Duration `yaml:"timeout" env:"THUMBNAILS_CONVERTER_TIMEOUT" desc:"The maximum time a converter may take to render a file. See the Environment Variable Types description for more details." introductionVersion:"7.0.0"`
 }
@@ -3,6 +3,7 @@ package defaults
 import (
 	"path"
 	"strings"
+	"time"
 
 	"github.com/owncloud/ocis/v2/ocis-pkg/config/defaults"
 	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
@@ -48,8 +49,14 @@ func DefaultConfig() *config.Config {
 		},
 		Thumbnail: config.Thumbnail{
 			Resolutions: []string{"16x16", "32x32", "64x64", "128x128", "1080x1920", "1920x1080", "2160x3840", "3840x2160", "4320x7680", "7680x4320"},
+			Storage:     "filesystem",
 			FileSystemStorage: config.FileSystemStorage{
-				RootDirectory: path.Join(defaults.BaseDataPath(), "thumbnails"),
+				RootDirectory:    path.Join(defaults.BaseDataPath(), "thumbnails"),
+				EvictionInterval: 10 * time.Minute,
+			},
+			S3Storage: config.S3Storage{
+				Region: "default",
+				Bucket: "thumbnails",
 			},
 			WebdavAllowInsecure:   false,
 			RevaGateway:           shared.DefaultRevaConfig().Address,
@@ -58,6 +65,17 @@ func DefaultConfig() *config.Config {
 			MaxInputWidth:         7680,
 			MaxInputHeight:        7680,
 			MaxInputImageFileSize: "50MB",
+			Converters: config.Converters{
+				Timeout: 30 * time.Second,
+			},
+			Pregeneration: config.Pregeneration{
+				Concurrency: 2,
+			},
+		},
+		Events: config.Events{
+			Endpoint:  "127.0.0.1:9233",
+			Cluster:   "ocis-cluster",
+			EnableTLS: false,
 		},
 	}
 }
@@ -105,4 +123,7 @@ func Sanitize(cfg *config.Config) {
 	if len(cfg.Thumbnail.Resolutions) == 1 && strings.Contains(cfg.Thumbnail.Resolutions[0], ",") {
 		cfg.Thumbnail.Resolutions = strings.Split(cfg.Thumbnail.Resolutions[0], ",")
 	}
+	if len(cfg.Thumbnail.Pregeneration.Types) == 1 && strings.Contains(cfg.Thumbnail.Pregeneration.Types[0], ",") {
+		cfg.Thumbnail.Pregeneration.Types = strings.Split(cfg.Thumbnail.Pregeneration.Types[0], ",")
+	}
 }
@@ -2,8 +2,10 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	ociscfg "github.com/owncloud/ocis/v2/ocis-pkg/config"
+	"github.com/owncloud/ocis/v2/ocis-pkg/shared"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config/defaults"
 
@@ -34,6 +36,30 @@ func ParseConfig(cfg *config.Config) error {
 }
 
 // Validate can validate the configuration
-func Validate(_ *config.Config) error {
+func Validate(cfg *config.Config) error {
+	switch cfg.Thumbnail.Storage {
+	case "filesystem":
+		if cfg.Thumbnail.FileSystemStorage.MaxSize != "" && cfg.Thumbnail.FileSystemStorage.EvictionInterval <= 0 {
+			return fmt.Errorf("the eviction interval of the filesystem storage must be positive")
+		}
+	case "s3":
+		if cfg.Thumbnail.S3Storage.Endpoint == "" || cfg.Thumbnail.S3Storage.Bucket == "" {
+			return fmt.Errorf("the s3 storage requires an endpoint and a bucket")
+		}
+	default:
+		return fmt.Errorf("unknown thumbnail storage %q", cfg.Thumbnail.Storage)
+	}
+
+	if cfg.Thumbnail.Pregeneration.Enabled {
+		if cfg.Thumbnail.Pregeneration.Concurrency <= 0 {
+			return fmt.Errorf("the concurrency of the thumbnail pregeneration must be positive")
+		}
+		if cfg.ServiceAccount.ServiceAccountID == "" {
+			return shared.MissingServiceAccountID(cfg.Service.Name)
+		}
+		if cfg.ServiceAccount.ServiceAccountSecret == "" {
+			return shared.MissingServiceAccountSecret(cfg.Service.Name)
+		}
+	}
 	return nil
 }
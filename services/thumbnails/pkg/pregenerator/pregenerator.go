@@ -0,0 +1,211 @@
+// Package pregenerator generates the thumbnails of uploaded files before they are requested.
+package pregenerator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	revactx "github.com/cs3org/reva/v2/pkg/ctx"
+	"github.com/cs3org/reva/v2/pkg/events"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/cs3org/reva/v2/pkg/storagespace"
+	"github.com/cs3org/reva/v2/pkg/utils"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	thumbnailerErrors "github.com/owncloud/ocis/v2/services/thumbnails/pkg/errors"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/preprocessor"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/imgsource"
+)
+
+// Pregenerator generates the thumbnails of uploaded files in all configured resolutions,
+// so they don't need to be generated when they are requested for the first time.
+type Pregenerator struct {
+	manager         thumbnail.Manager
+	source          imgsource.Source
+	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	converters      preprocessor.DocumentConverters
+	fontFileMap     string
+	resolutions     thumbnail.Resolutions
+	types           []string
+	concurrency     int
+	serviceAccount  config.ServiceAccount
+	logger          log.Logger
+}
+
+// New creates a new Pregenerator
+func New(cfg *config.Config, manager thumbnail.Manager, source imgsource.Source, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], converters preprocessor.DocumentConverters, logger log.Logger) (Pregenerator, error) {
+	resolutions, err := thumbnail.ParseResolutions(cfg.Thumbnail.Resolutions)
+	if err != nil {
+		return Pregenerator{}, err
+	}
+	for _, t := range cfg.Thumbnail.Pregeneration.Types {
+		switch strings.ToLower(t) {
+		case "webp", "avif":
+		default:
+			return Pregenerator{}, errors.Errorf("unsupported thumbnail type %q for the pregeneration", t)
+		}
+	}
+
+	concurrency := cfg.Thumbnail.Pregeneration.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	return Pregenerator{
+		manager:         manager,
+		source:          source,
+		gatewaySelector: gatewaySelector,
+		converters:      converters,
+		fontFileMap:     cfg.Thumbnail.FontMapFile,
+		resolutions:     resolutions,
+		types:           cfg.Thumbnail.Pregeneration.Types,
+		concurrency:     concurrency,
+		serviceAccount:  cfg.ServiceAccount,
+		logger:          logger,
+	}, nil
+}
+
+// Run generates the thumbnails of the files announced by UploadReady events until the context is done.
+// The configured concurrency limits the number of files processed at the same time.
+func (p Pregenerator) Run(ctx context.Context, ch <-chan events.Event) error {
+	var wg sync.WaitGroup
+	for i := 0; i < p.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case e, ok := <-ch:
+					if !ok {
+						return
+					}
+					ev, ok := e.Event.(events.UploadReady)
+					if !ok || ev.Failed || ev.FileRef == nil {
+						continue
+					}
+					p.handle(ctx, ev.FileRef)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (p Pregenerator) handle(ctx context.Context, ref *provider.Reference) {
+	err := p.Pregenerate(ctx, ref)
+	switch {
+	case err == nil:
+	case errors.Is(err, thumbnailerErrors.ErrImageTooLarge):
+		p.logger.Debug().Err(err).Interface("ref", ref).Msg("file is too large to pregenerate thumbnails")
+	default:
+		p.logger.Error().Err(err).Interface("ref", ref).Msg("could not pregenerate thumbnails")
+	}
+}
+
+// Pregenerate generates the missing thumbnails of the referenced file.
+// Files of unsupported types are ignored.
+func (p Pregenerator) Pregenerate(ctx context.Context, ref *provider.Reference) error {
+	gwc, err := p.gatewaySelector.Next()
+	if err != nil {
+		return err
+	}
+	token, err := utils.GetServiceUserToken(ctx, gwc, p.serviceAccount.ServiceAccountID, p.serviceAccount.ServiceAccountSecret)
+	if err != nil {
+		return errors.Wrap(err, "could not authenticate the service account")
+	}
+
+	sRes, err := gwc.Stat(metadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, token), &provider.StatRequest{Ref: ref})
+	if err != nil {
+		return err
+	}
+	if sRes.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return fmt.Errorf("could not stat file: %s", sRes.GetStatus().GetMessage())
+	}
+
+	info := sRes.GetInfo()
+	mimeType := info.GetMimeType()
+	if info.GetType() != provider.ResourceType_RESOURCE_TYPE_FILE || info.GetChecksum().GetSum() == "" {
+		return nil
+	}
+	if !thumbnail.IsMimeTypeSupported(mimeType) && !p.converters.Supports(mimeType) {
+		return nil
+	}
+
+	requests, err := p.missingThumbnails(mimeType, info.GetChecksum().GetSum())
+	if err != nil || len(requests) == 0 {
+		return err
+	}
+
+	r, err := p.source.Get(imgsource.ContextSetAuthorization(ctx, token), storagespace.FormatResourceID(info.GetId()))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	// documents are rendered only once, the thumbnails are generated from the rendered image
+	if converter, ok := p.converters.ConverterFor(mimeType); ok {
+		data, err = converter.Convert(ctx, bytes.NewReader(data), mimeType)
+		if err != nil {
+			return errors.Wrap(err, "could not render the document")
+		}
+		mimeType = http.DetectContentType(data)
+	}
+
+	ppOpts := map[string]interface{}{
+		"fontFileMap": p.fontFileMap,
+	}
+	for _, tr := range requests {
+		// the image is decoded for every thumbnail because generators may modify it
+		img, err := preprocessor.ForType(mimeType, ppOpts).Convert(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if _, err := p.manager.Generate(tr, img); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// missingThumbnails returns the requests for the thumbnails of the file which don't exist yet
+func (p Pregenerator) missingThumbnails(mimeType, checksum string) ([]thumbnail.Request, error) {
+	types := map[string]struct{}{
+		thumbnail.ResolveType(mimeType, ""): {},
+	}
+	for _, t := range p.types {
+		types[thumbnail.ResolveType(mimeType, t)] = struct{}{}
+	}
+
+	var requests []thumbnail.Request
+	for t := range types {
+		for _, resolution := range p.resolutions {
+			tr, err := thumbnail.PrepareRequest(resolution.Dx(), resolution.Dy(), t, checksum, "")
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := p.manager.CheckThumbnail(tr); !exists {
+				requests = append(requests, tr)
+			}
+		}
+	}
+	return requests, nil
+}
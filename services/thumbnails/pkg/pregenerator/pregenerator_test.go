@@ -0,0 +1,136 @@
+package pregenerator_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/cs3org/reva/v2/tests/cs3mocks/mocks"
+	tAssert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	tRequire "github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/pregenerator"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/preprocessor"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/imgsource"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// fileSource serves a local file and records the requested paths
+type fileSource struct {
+	file  string
+	paths []string
+}
+
+func (s *fileSource) Get(ctx context.Context, path string) (io.ReadCloser, error) {
+	if auth, _ := imgsource.ContextGetAuthorization(ctx); auth != "service-token" {
+		return nil, os.ErrPermission
+	}
+	s.paths = append(s.paths, path)
+	return os.Open(s.file)
+}
+
+func setup(t *testing.T, mimeType string, types ...string) (pregenerator.Pregenerator, thumbnail.Manager, *fileSource) {
+	cfg := &config.Config{
+		Thumbnail: config.Thumbnail{
+			Resolutions: []string{"16x16", "32x32"},
+			Pregeneration: config.Pregeneration{
+				Enabled:     true,
+				Concurrency: 1,
+				Types:       types,
+			},
+		},
+		ServiceAccount: config.ServiceAccount{
+			ServiceAccountID:     "service-account",
+			ServiceAccountSecret: "secret",
+		},
+	}
+
+	pool.RemoveSelector("GatewaySelector" + "com.owncloud.api.gateway")
+	gatewayClient := &cs3mocks.GatewayAPIClient{}
+	gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+		"GatewaySelector",
+		"com.owncloud.api.gateway",
+		func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+			return gatewayClient
+		},
+	)
+	gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+		Status: &rpc.Status{Code: rpc.Code_CODE_OK},
+		Token:  "service-token",
+	}, nil)
+	gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{
+		Status: &rpc.Status{Code: rpc.Code_CODE_OK},
+		Info: &provider.ResourceInfo{
+			Id:       &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"},
+			Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+			MimeType: mimeType,
+			Checksum: &provider.ResourceChecksum{Sum: "120EA8A25E5D487BF68B5F7096440019"},
+		},
+	}, nil)
+
+	resolutions, err := thumbnail.ParseResolutions(cfg.Thumbnail.Resolutions)
+	tRequire.NoError(t, err)
+	manager := thumbnail.NewSimpleManager(
+		resolutions,
+		storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: t.TempDir()}, log.NopLogger()),
+		log.NopLogger(),
+		7680,
+		7680,
+	)
+	source := &fileSource{file: "../../testdata/oc.png"}
+
+	p, err := pregenerator.New(cfg, manager, source, gatewaySelector, preprocessor.DocumentConverters{}, log.NopLogger())
+	tRequire.NoError(t, err)
+	return p, manager, source
+}
+
+func TestPregenerate(t *testing.T) {
+	assert := tAssert.New(t)
+	require := tRequire.New(t)
+	p, manager, source := setup(t, "image/png", "webp")
+
+	ref := &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}}
+	require.NoError(p.Pregenerate(context.Background(), ref))
+	assert.Equal([]string{"storage$space!file"}, source.paths)
+
+	for _, tType := range []string{"png", "webp"} {
+		for _, size := range []int{16, 32} {
+			tr, err := thumbnail.PrepareRequest(size, size, tType, "120EA8A25E5D487BF68B5F7096440019", "")
+			require.NoError(err)
+			_, exists := manager.CheckThumbnail(tr)
+			assert.True(exists, "missing %dx%d.%s", size, size, tType)
+		}
+	}
+
+	// existing thumbnails are not generated again
+	require.NoError(p.Pregenerate(context.Background(), ref))
+	assert.Len(source.paths, 1)
+}
+
+func TestPregenerate_UnsupportedMimeType(t *testing.T) {
+	p, _, source := setup(t, "application/zip")
+
+	ref := &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}}
+	tRequire.NoError(t, p.Pregenerate(context.Background(), ref))
+	tAssert.Empty(t, source.paths)
+}
+
+func TestNew_InvalidType(t *testing.T) {
+	_, err := pregenerator.New(&config.Config{
+		Thumbnail: config.Thumbnail{
+			Resolutions:   []string{"16x16"},
+			Pregeneration: config.Pregeneration{Types: []string{"bmp"}},
+		},
+	}, nil, nil, nil, nil, log.NopLogger())
+	tAssert.Error(t, err)
+}
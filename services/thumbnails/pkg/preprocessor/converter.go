@@ -0,0 +1,171 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+
+	"github.com/pkg/errors"
+
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	thumbnailerErrors "github.com/owncloud/ocis/v2/services/thumbnails/pkg/errors"
+)
+
+var (
+	// PDFMimeTypes contains the mimetypes of pdf files
+	PDFMimeTypes = []string{
+		"application/pdf",
+	}
+	// VideoMimeTypes contains the mimetypes of videos
+	VideoMimeTypes = []string{
+		"video/mp4",
+		"video/mpeg",
+		"video/ogg",
+		"video/quicktime",
+		"video/webm",
+		"video/x-matroska",
+		"video/x-msvideo",
+	}
+	// OfficeMimeTypes contains the mimetypes of office documents
+	OfficeMimeTypes = []string{
+		"application/msword",
+		"application/rtf",
+		"application/vnd.ms-excel",
+		"application/vnd.ms-powerpoint",
+		"application/vnd.oasis.opendocument.presentation",
+		"application/vnd.oasis.opendocument.spreadsheet",
+		"application/vnd.oasis.opendocument.text",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	}
+)
+
+// documentExtensions maps the mimetypes to the extensions of the files passed to the converters,
+// some tools detect the type of a file by its extension
+var documentExtensions = map[string]string{
+	"application/pdf":               ".pdf",
+	"video/mp4":                     ".mp4",
+	"video/mpeg":                    ".mpeg",
+	"video/ogg":                     ".ogv",
+	"video/quicktime":               ".mov",
+	"video/webm":                    ".webm",
+	"video/x-matroska":              ".mkv",
+	"video/x-msvideo":               ".avi",
+	"application/msword":            ".doc",
+	"application/rtf":               ".rtf",
+	"application/vnd.ms-excel":      ".xls",
+	"application/vnd.ms-powerpoint": ".ppt",
+	"application/vnd.oasis.opendocument.presentation":                           ".odp",
+	"application/vnd.oasis.opendocument.spreadsheet":                            ".ods",
+	"application/vnd.oasis.opendocument.text":                                   ".odt",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": ".pptx",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         ".xlsx",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   ".docx",
+}
+
+// Converter renders files which can't be decoded directly, like documents and videos, into an encoded image
+type Converter interface {
+	// Convert renders the first page or a keyframe of the file into an encoded image
+	Convert(ctx context.Context, r io.Reader, mimeType string) ([]byte, error)
+}
+
+// DocumentConverters maps the mimetypes of documents and videos to the converters rendering them
+type DocumentConverters map[string]Converter
+
+// NewDocumentConverters creates the converters enabled in the configuration.
+// Inputs larger than maxInputSize are rejected by the converters.
+func NewDocumentConverters(cfg config.Converters, maxInputSize uint64) (DocumentConverters, error) {
+	converters := DocumentConverters{}
+
+	if cfg.PDFCommand != "" {
+		c, err := NewCommandConverter(cfg.PDFCommand, cfg.Timeout, maxInputSize)
+		if err != nil {
+			return nil, err
+		}
+		converters.Register(c, PDFMimeTypes...)
+	}
+
+	if cfg.VideoCommand != "" {
+		c, err := NewCommandConverter(cfg.VideoCommand, cfg.Timeout, maxInputSize)
+		if err != nil {
+			return nil, err
+		}
+		converters.Register(c, VideoMimeTypes...)
+	}
+
+	switch {
+	case cfg.OfficeEndpoint != "":
+		converters.Register(NewHTTPConverter(cfg.OfficeEndpoint, cfg.OfficeInsecure, cfg.Timeout, maxInputSize), OfficeMimeTypes...)
+	case cfg.OfficeCommand != "":
+		c, err := NewCommandConverter(cfg.OfficeCommand, cfg.Timeout, maxInputSize)
+		if err != nil {
+			return nil, err
+		}
+		converters.Register(c, OfficeMimeTypes...)
+	}
+
+	return converters, nil
+}
+
+// Register uses the converter for the given mimetypes
+func (c DocumentConverters) Register(converter Converter, mimeTypes ...string) {
+	for _, m := range mimeTypes {
+		c[m] = converter
+	}
+}
+
+// Supports checks if a converter is registered for the mimetype
+func (c DocumentConverters) Supports(m string) bool {
+	_, ok := c.ConverterFor(m)
+	return ok
+}
+
+// ConverterFor returns the converter registered for the mimetype
+func (c DocumentConverters) ConverterFor(m string) (Converter, bool) {
+	mimeType, _, err := mime.ParseMediaType(m)
+	if err != nil {
+		return nil, false
+	}
+	converter, ok := c[mimeType]
+	return converter, ok
+}
+
+// DocumentDecoder is a converter for documents and videos which are rendered by a Converter
+type DocumentDecoder struct {
+	converter Converter
+	mimeType  string
+}
+
+// Convert renders the document and decodes the resulting image
+func (d DocumentDecoder) Convert(r io.Reader) (interface{}, error) {
+	img, err := d.converter.Convert(context.Background(), r, d.mimeType)
+	if err != nil {
+		return nil, errors.Wrap(err, `could not render the document`)
+	}
+	return ImageDecoder{}.Convert(bytes.NewReader(img))
+}
+
+// extensionFor returns the file extension for the mimetype
+func extensionFor(mimeType string) string {
+	if ext, ok := documentExtensions[mimeType]; ok {
+		return ext
+	}
+	if exts, _ := mime.ExtensionsByType(mimeType); len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
+
+// copyLimited copies the reader to the writer and fails if the reader holds more than limit bytes
+func copyLimited(w io.Writer, r io.Reader, limit uint64) error {
+	n, err := io.Copy(w, io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		return err
+	}
+	if uint64(n) > limit {
+		return thumbnailerErrors.ErrImageTooLarge
+	}
+	return nil
+}
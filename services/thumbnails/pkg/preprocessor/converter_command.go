@@ -0,0 +1,102 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	placeholderInput  = "{input}"
+	placeholderOutdir = "{outdir}"
+)
+
+// CommandConverter renders documents with a local command line tool like pdftoppm, ffmpeg or soffice.
+// The placeholders {input} and {outdir} in the command are replaced by the path of the document and
+// the directory the command writes the image to. The first file written to that directory is the result.
+type CommandConverter struct {
+	command      []string
+	timeout      time.Duration
+	maxInputSize uint64
+}
+
+// NewCommandConverter creates a new CommandConverter for the given command
+func NewCommandConverter(command string, timeout time.Duration, maxInputSize uint64) (CommandConverter, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return CommandConverter{}, errors.New("the converter command must not be empty")
+	}
+	if !strings.Contains(command, placeholderInput) || !strings.Contains(command, placeholderOutdir) {
+		return CommandConverter{}, errors.Errorf("the converter command %q must contain the placeholders %s and %s", command, placeholderInput, placeholderOutdir)
+	}
+	return CommandConverter{
+		command:      args,
+		timeout:      timeout,
+		maxInputSize: maxInputSize,
+	}, nil
+}
+
+// Convert writes the document to a temporary directory and runs the command on it
+func (c CommandConverter) Convert(ctx context.Context, r io.Reader, mimeType string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "thumbnails-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input"+extensionFor(mimeType))
+	outdir := filepath.Join(dir, "out")
+	if err := os.Mkdir(outdir, 0700); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(input)
+	if err != nil {
+		return nil, err
+	}
+	err = copyLimited(f, r, c.maxInputSize)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	replacer := strings.NewReplacer(placeholderInput, input, placeholderOutdir, outdir)
+	args := make([]string, 0, len(c.command))
+	for _, arg := range c.command {
+		args = append(args, replacer.Replace(arg))
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "the converter command %s failed: %s", args[0], strings.TrimSpace(stderr.String()))
+	}
+
+	entries, err := os.ReadDir(outdir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			return os.ReadFile(filepath.Join(outdir, entry.Name()))
+		}
+	}
+	return nil, errors.Errorf("the converter command %s did not write an image", args[0])
+}
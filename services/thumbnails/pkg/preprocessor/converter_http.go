@@ -0,0 +1,72 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// HTTPConverter renders documents with an external conversion service.
+// The document is posted as the multipart form field "data" and the service responds with the image,
+// like the convert-to API of Collabora Online does.
+type HTTPConverter struct {
+	endpoint     string
+	client       *http.Client
+	maxInputSize uint64
+}
+
+// NewHTTPConverter creates a new HTTPConverter for the given endpoint
+func NewHTTPConverter(endpoint string, insecure bool, timeout time.Duration, maxInputSize uint64) HTTPConverter {
+	return HTTPConverter{
+		endpoint: endpoint,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion:         tls.VersionTLS12,
+					InsecureSkipVerify: insecure, //nolint:gosec
+				},
+			},
+		},
+		maxInputSize: maxInputSize,
+	}
+}
+
+// Convert posts the document to the conversion service and returns the image from the response
+func (c HTTPConverter) Convert(ctx context.Context, r io.Reader, mimeType string) ([]byte, error) {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	part, err := form.CreateFormFile("data", "document"+extensionFor(mimeType))
+	if err != nil {
+		return nil, err
+	}
+	if err := copyLimited(part, r, c.maxInputSize); err != nil {
+		return nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("the conversion service responded with statuscode %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
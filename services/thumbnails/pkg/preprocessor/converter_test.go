@@ -0,0 +1,130 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	thumbnailerErrors "github.com/owncloud/ocis/v2/services/thumbnails/pkg/errors"
+)
+
+var _ = Describe("DocumentConverters", func() {
+	var image []byte
+
+	BeforeEach(func() {
+		var err error
+		image, err = os.ReadFile("test_assets/noise.png")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("NewDocumentConverters", func() {
+		It("only enables the configured converters", func() {
+			converters, err := NewDocumentConverters(config.Converters{
+				PDFCommand: "cp {input} {outdir}/page.png",
+			}, 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(converters.Supports("application/pdf")).To(BeTrue())
+			Expect(converters.Supports("application/pdf; charset=binary")).To(BeTrue())
+			Expect(converters.Supports("video/mp4")).To(BeFalse())
+			Expect(converters.Supports("application/vnd.oasis.opendocument.text")).To(BeFalse())
+		})
+
+		It("prefers the endpoint for office documents", func() {
+			converters, err := NewDocumentConverters(config.Converters{
+				OfficeCommand:  "soffice --headless --convert-to png --outdir {outdir} {input}",
+				OfficeEndpoint: "http://localhost/cool/convert-to/png",
+			}, 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(converters["application/vnd.oasis.opendocument.text"]).To(BeAssignableToTypeOf(HTTPConverter{}))
+		})
+
+		It("fails for commands without placeholders", func() {
+			_, err := NewDocumentConverters(config.Converters{VideoCommand: "ffmpeg -i input frame.png"}, 1024)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ForType", func() {
+		It("uses the document converters", func() {
+			converters := DocumentConverters{}
+			converters.Register(CommandConverter{}, PDFMimeTypes...)
+
+			Expect(ForType("application/pdf", map[string]interface{}{"documentConverters": converters})).To(BeAssignableToTypeOf(DocumentDecoder{}))
+			Expect(ForType("application/pdf", nil)).To(BeAssignableToTypeOf(ImageDecoder{}))
+		})
+	})
+
+	Describe("CommandConverter", func() {
+		It("renders the document with the command", func() {
+			converter, err := NewCommandConverter("cp {input} {outdir}/page.png", time.Minute, uint64(len(image)))
+			Expect(err).ToNot(HaveOccurred())
+
+			img, err := DocumentDecoder{converter: converter, mimeType: "application/pdf"}.Convert(bytes.NewReader(image))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+		})
+
+		It("rejects documents exceeding the maximum input size", func() {
+			converter, err := NewCommandConverter("cp {input} {outdir}/page.png", time.Minute, uint64(len(image)-1))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = converter.Convert(context.Background(), bytes.NewReader(image), "application/pdf")
+			Expect(err).To(MatchError(thumbnailerErrors.ErrImageTooLarge))
+		})
+
+		It("fails if the command does not write an image", func() {
+			converter, err := NewCommandConverter("true {input} {outdir}", time.Minute, uint64(len(image)))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = converter.Convert(context.Background(), bytes.NewReader(image), "application/pdf")
+			Expect(err).To(MatchError(ContainSubstring("did not write an image")))
+		})
+	})
+
+	Describe("HTTPConverter", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				f, header, err := r.FormFile("data")
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				defer f.Close()
+				if header.Filename != "document.odt" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = io.Copy(w, f)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("renders the document with the conversion service", func() {
+			converter := NewHTTPConverter(server.URL, false, time.Minute, uint64(len(image)))
+
+			img, err := converter.Convert(context.Background(), bytes.NewReader(image), "application/vnd.oasis.opendocument.text")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).To(Equal(image))
+		})
+
+		It("fails if the conversion service fails", func() {
+			converter := NewHTTPConverter(server.URL, false, time.Minute, uint64(len(image)))
+
+			_, err := converter.Convert(context.Background(), bytes.NewReader(image), "application/msword")
+			Expect(err).To(MatchError(ContainSubstring("statuscode 400")))
+		})
+	})
+})
@@ -227,6 +227,13 @@ func ForType(mimeType string, opts map[string]interface{}) FileConverter {
 	// We can ignore the error here because we parse it in IsMimeTypeSupported before and if it fails
 	// return the service call. So we should only get here when the mimeType parses fine.
 	mimeType, _, _ = mime.ParseMediaType(mimeType)
+
+	if converters, ok := opts["documentConverters"].(DocumentConverters); ok {
+		if converter, ok := converters[mimeType]; ok {
+			return DocumentDecoder{converter: converter, mimeType: mimeType}
+		}
+	}
+
 	switch mimeType {
 	case "text/plain":
 		fontFileMap := ""
@@ -8,6 +8,7 @@ import (
 	"github.com/owncloud/ocis/v2/ocis-pkg/service/grpc/handler/ratelimiter"
 	"github.com/owncloud/ocis/v2/ocis-pkg/version"
 	thumbnailssvc "github.com/owncloud/ocis/v2/protogen/gen/ocis/services/thumbnails/v0"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/preprocessor"
 	svc "github.com/owncloud/ocis/v2/services/thumbnails/pkg/service/grpc/v0"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/service/grpc/v0/decorators"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/imgsource"
@@ -62,6 +63,17 @@ func NewService(opts ...Option) grpc.Service {
 		options.Logger.Error().Err(err).Msg("could not parse MaxInputImageFileSize")
 		return grpc.Service{}
 	}
+	converters, err := preprocessor.NewDocumentConverters(tconf.Converters, b.Bytes())
+	if err != nil {
+		options.Logger.Error().Err(err).Msg("could not create the document converters")
+		return grpc.Service{}
+	}
+
+	thumbnailStorage, err := storage.New(tconf, options.Logger)
+	if err != nil {
+		options.Logger.Error().Err(err).Msg("could not create the thumbnail storage")
+		return grpc.Service{}
+	}
 
 	var thumbnail decorators.DecoratedService
 	{
@@ -69,14 +81,10 @@ func NewService(opts ...Option) grpc.Service {
 			svc.Config(options.Config),
 			svc.Logger(options.Logger),
 			svc.ThumbnailSource(imgsource.NewWebDavSource(tconf, b)),
-			svc.ThumbnailStorage(
-				storage.NewFileSystemStorage(
-					tconf.FileSystemStorage,
-					options.Logger,
-				),
-			),
+			svc.ThumbnailStorage(thumbnailStorage),
 			svc.CS3Source(imgsource.NewCS3Source(tconf, gatewaySelector, b)),
 			svc.GatewaySelector(gatewaySelector),
+			svc.DocumentConverters(converters),
 		)
 		thumbnail = decorators.NewInstrument(thumbnail, options.Metrics)
 		thumbnail = decorators.NewLogging(thumbnail, options.Logger)
@@ -34,6 +34,14 @@ func Server(opts ...Option) (http.Service, error) {
 		return http.Service{}, fmt.Errorf("could not initialize http service: %w", err)
 	}
 
+	thumbnailStorage, err := storage.New(options.Config.Thumbnail, options.Logger)
+	if err != nil {
+		options.Logger.Error().
+			Err(err).
+			Msg("Error initializing thumbnail storage")
+		return http.Service{}, fmt.Errorf("could not initialize thumbnail storage: %w", err)
+	}
+
 	handle := svc.NewService(
 		svc.Logger(options.Logger),
 		svc.Config(options.Config),
@@ -53,12 +61,7 @@ func Server(opts ...Option) (http.Service, error) {
 			),
 			ocismiddleware.Logger(options.Logger),
 		),
-		svc.ThumbnailStorage(
-			storage.NewFileSystemStorage(
-				options.Config.Thumbnail.FileSystemStorage,
-				options.Logger,
-			),
-		),
+		svc.ThumbnailStorage(thumbnailStorage),
 	)
 
 	{
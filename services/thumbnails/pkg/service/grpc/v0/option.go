@@ -7,6 +7,7 @@ import (
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/preprocessor"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/imgsource"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
 )
@@ -16,13 +17,14 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Logger           log.Logger
-	Config           *config.Config
-	Middleware       []func(http.Handler) http.Handler
-	ThumbnailStorage storage.Storage
-	ImageSource      imgsource.Source
-	CS3Source        imgsource.Source
-	GatewaySelector  pool.Selectable[gateway.GatewayAPIClient]
+	Logger             log.Logger
+	Config             *config.Config
+	Middleware         []func(http.Handler) http.Handler
+	ThumbnailStorage   storage.Storage
+	ImageSource        imgsource.Source
+	CS3Source          imgsource.Source
+	GatewaySelector    pool.Selectable[gateway.GatewayAPIClient]
+	DocumentConverters preprocessor.DocumentConverters
 }
 
 // newOptions initializes the available default options.
@@ -77,3 +79,10 @@ func GatewaySelector(val pool.Selectable[gateway.GatewayAPIClient]) Option {
 		o.GatewaySelector = val
 	}
 }
+
+// DocumentConverters provides a function to set the converters for documents and videos
+func DocumentConverters(val preprocessor.DocumentConverters) Option {
+	return func(o *Options) {
+		o.DocumentConverters = val
+	}
+}
@@ -51,7 +51,8 @@ func NewService(opts ...Option) decorators.DecoratedService {
 		logger:       logger,
 		selector:     options.GatewaySelector,
 		preprocessorOpts: PreprocessorOpts{
-			TxtFontFileMap: options.Config.Thumbnail.FontMapFile,
+			TxtFontFileMap:     options.Config.Thumbnail.FontMapFile,
+			DocumentConverters: options.DocumentConverters,
 		},
 		dataEndpoint:   options.Config.Thumbnail.DataEndpoint,
 		transferSecret: options.Config.Thumbnail.TransferSecret,
@@ -75,7 +76,8 @@ type Thumbnail struct {
 
 // PreprocessorOpts holds the options for the preprocessor
 type PreprocessorOpts struct {
-	TxtFontFileMap string
+	TxtFontFileMap     string
+	DocumentConverters preprocessor.DocumentConverters
 }
 
 // GetThumbnail retrieves a thumbnail for an image
@@ -122,10 +124,7 @@ func (g Thumbnail) checkThumbnail(req *thumbnailssvc.GetThumbnailRequest, sRes *
 		return "", tr, merrors.Forbidden(g.serviceID, "no download permission")
 	}
 
-	tType := thumbnail.GetExtForMime(sRes.GetInfo().GetMimeType())
-	if tType == "" {
-		tType = req.GetThumbnailType().String()
-	}
+	tType := thumbnail.ResolveType(sRes.GetInfo().GetMimeType(), req.GetThumbnailType().String())
 	tr, err := thumbnail.PrepareRequest(int(req.GetWidth()), int(req.GetHeight()), tType, sRes.GetInfo().GetChecksum().GetSum(), req.GetProcessor())
 	if err != nil {
 		return "", tr, merrors.BadRequest(g.serviceID, err.Error())
@@ -160,7 +159,8 @@ func (g Thumbnail) handleCS3Source(ctx context.Context, req *thumbnailssvc.GetTh
 	}
 	defer r.Close()
 	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
+		"fontFileMap":        g.preprocessorOpts.TxtFontFileMap,
+		"documentConverters": g.preprocessorOpts.DocumentConverters,
 	}
 	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
 	img, err := pp.Convert(r)
@@ -249,7 +249,8 @@ func (g Thumbnail) handleWebdavSource(ctx context.Context, req *thumbnailssvc.Ge
 	}
 	defer r.Close()
 	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
+		"fontFileMap":        g.preprocessorOpts.TxtFontFileMap,
+		"documentConverters": g.preprocessorOpts.DocumentConverters,
 	}
 	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
 	img, err := pp.Convert(r)
@@ -311,7 +312,8 @@ func (g Thumbnail) stat(path, auth string) (*provider.StatResponse, error) {
 		g.logger.Error().Msg("resource info is missing checksum")
 		return nil, merrors.NotFound(g.serviceID, "resource info is missing a checksum")
 	}
-	if !thumbnail.IsMimeTypeSupported(rsp.GetInfo().GetMimeType()) {
+	mimeType := rsp.GetInfo().GetMimeType()
+	if !thumbnail.IsMimeTypeSupported(mimeType) && !g.preprocessorOpts.DocumentConverters.Supports(mimeType) {
 		return nil, merrors.NotFound(g.serviceID, "Unsupported file type")
 	}
 	return rsp, nil
@@ -3,7 +3,9 @@ package svc
 import (
 	"context"
 	"fmt"
+	"mime"
 	"net/http"
+	"path/filepath"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
@@ -104,8 +106,12 @@ func (s Thumbnails) GetThumbnail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
+	// the key ends with the extension of the thumbnail type
+	if contentType := mime.TypeByExtension(filepath.Ext(key)); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
 	w.Header().Set("Content-Length", strconv.Itoa(len(thumbnailBytes)))
+	w.WriteHeader(http.StatusOK)
 	if _, err = w.Write(thumbnailBytes); err != nil {
 		logger.Error().
 			Err(err).
@@ -14,6 +14,8 @@ const (
 	typeJpeg = "jpeg"
 	typeGif  = "gif"
 	typeGgs  = "ggs"
+	typeWebp = "webp"
+	typeAvif = "avif"
 )
 
 // Encoder encodes the thumbnail to a specific format.
@@ -58,6 +60,10 @@ func EncoderForType(fileType string) (Encoder, error) {
 		return JpegEncoder{}, nil
 	case typeGif:
 		return GifEncoder{}, nil
+	case typeWebp:
+		return WebpEncoder{}, nil
+	case typeAvif:
+		return avifEncoder()
 	default:
 		return nil, errors.ErrNoEncoderForType
 	}
@@ -75,3 +81,30 @@ func GetExtForMime(fileType string) string {
 		return ""
 	}
 }
+
+// ResolveType returns the type of the thumbnail for a source with the given mimetype.
+// Thumbnails keep the type of their source unless the client requested WebP or AVIF.
+// Animated gifs always stay gifs. AVIF falls back to WebP without libvips, other types without an encoder fall back to jpg.
+// Without libvips jpg sources stay jpg, their lossless WebP thumbnails would be larger.
+func ResolveType(mimeType, requested string) string {
+	requested = strings.ToLower(requested)
+	if _, err := EncoderForType(requested); err != nil {
+		if requested == typeAvif {
+			requested = typeWebp
+		} else {
+			requested = typeJpg
+		}
+	}
+
+	tType := GetExtForMime(mimeType)
+	switch {
+	case tType == "":
+		return requested
+	case !lossyWebp && (tType == typeJpg || tType == typeJpeg):
+		return tType
+	case tType != typeGif && (requested == typeWebp || requested == typeAvif):
+		return requested
+	default:
+		return tType
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"io"
 
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/errors"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/webp"
 )
 
+// lossyWebp is false because the built-in encoder only encodes lossless webp
+const lossyWebp = false
+
 // PngEncoder encodes to png
 type PngEncoder struct{}
 
@@ -54,3 +58,30 @@ func (e JpegEncoder) Types() []string {
 func (e JpegEncoder) MimeType() string {
 	return "image/jpeg"
 }
+
+// WebpEncoder encodes to lossless webp
+type WebpEncoder struct{}
+
+// Encode encodes to webp
+func (e WebpEncoder) Encode(w io.Writer, img interface{}) error {
+	m, ok := img.(image.Image)
+	if !ok {
+		return errors.ErrInvalidType
+	}
+	return webp.Encode(w, m)
+}
+
+// Types returns the webp suffix.
+func (e WebpEncoder) Types() []string {
+	return []string{typeWebp}
+}
+
+// MimeType returns the mimetype for webp files.
+func (e WebpEncoder) MimeType() string {
+	return "image/webp"
+}
+
+// avifEncoder fails because there is no avif encoder without libvips
+func avifEncoder() (Encoder, error) {
+	return nil, errors.ErrNoEncoderForType
+}
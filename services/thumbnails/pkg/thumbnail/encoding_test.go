@@ -10,6 +10,8 @@ func TestEncoderForType(t *testing.T) {
 		"JPEG":    JpegEncoder{},
 		"png":     PngEncoder{},
 		"PNG":     PngEncoder{},
+		"webp":    WebpEncoder{},
+		"WEBP":    WebpEncoder{},
 		"invalid": nil,
 	}
 
@@ -20,3 +22,32 @@ func TestEncoderForType(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveType(t *testing.T) {
+	// photos are only encoded to webp if the encoder is lossy
+	photo := "jpeg"
+	if lossyWebp {
+		photo = "webp"
+	}
+
+	tests := []struct {
+		mimeType  string
+		requested string
+		want      string
+	}{
+		{mimeType: "image/png", requested: "JPG", want: "png"},
+		{mimeType: "image/png", requested: "WEBP", want: "webp"},
+		{mimeType: "image/jpeg", requested: "WEBP", want: photo},
+		{mimeType: "image/jpeg", requested: "AVIF", want: photo},
+		{mimeType: "image/gif", requested: "WEBP", want: "gif"},
+		{mimeType: "text/plain", requested: "PNG", want: "png"},
+		{mimeType: "text/plain", requested: "WEBP", want: "webp"},
+		{mimeType: "text/plain", requested: "invalid", want: "jpg"},
+	}
+
+	for _, tt := range tests {
+		if got := ResolveType(tt.mimeType, tt.requested); got != tt.want {
+			t.Errorf("ResolveType(%q, %q) = %q, want %q", tt.mimeType, tt.requested, got, tt.want)
+		}
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/errors"
 )
 
+// lossyWebp is true because libvips encodes lossy webp
+const lossyWebp = true
+
 // PngEncoder encodes to png
 type PngEncoder struct{}
 
@@ -64,3 +67,63 @@ func (e JpegEncoder) Types() []string {
 func (e JpegEncoder) MimeType() string {
 	return "image/jpeg"
 }
+
+// WebpEncoder encodes to webp
+type WebpEncoder struct{}
+
+// Encode encodes to webp
+func (e WebpEncoder) Encode(w io.Writer, img interface{}) error {
+	m, ok := img.(*vips.ImageRef)
+	if !ok {
+		return errors.ErrInvalidType
+	}
+
+	buf, _, err := m.ExportWebp(vips.NewWebpExportParams())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+// Types returns the webp suffix.
+func (e WebpEncoder) Types() []string {
+	return []string{typeWebp}
+}
+
+// MimeType returns the mimetype for webp files.
+func (e WebpEncoder) MimeType() string {
+	return "image/webp"
+}
+
+// AvifEncoder encodes to avif
+type AvifEncoder struct{}
+
+// Encode encodes to avif
+func (e AvifEncoder) Encode(w io.Writer, img interface{}) error {
+	m, ok := img.(*vips.ImageRef)
+	if !ok {
+		return errors.ErrInvalidType
+	}
+
+	buf, _, err := m.ExportAvif(vips.NewAvifExportParams())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+// Types returns the avif suffix.
+func (e AvifEncoder) Types() []string {
+	return []string{typeAvif}
+}
+
+// MimeType returns the mimetype for avif files.
+func (e AvifEncoder) MimeType() string {
+	return "image/avif"
+}
+
+func avifEncoder() (Encoder, error) {
+	return AvifEncoder{}, nil
+}
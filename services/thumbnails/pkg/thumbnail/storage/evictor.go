@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+)
+
+// Evictor periodically removes the least recently used thumbnails when the cache exceeds its maximum size.
+type Evictor struct {
+	cache    Cache
+	maxSize  uint64
+	interval time.Duration
+	logger   log.Logger
+}
+
+// NewEvictor creates a new Evictor for the cache
+func NewEvictor(cache Cache, maxSize uint64, interval time.Duration, logger log.Logger) Evictor {
+	return Evictor{
+		cache:    cache,
+		maxSize:  maxSize,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run evicts thumbnails until the context is done
+func (e Evictor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		e.evict()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e Evictor) evict() {
+	stats, err := e.cache.Stats()
+	if err != nil {
+		e.logger.Error().Err(err).Msg("could not get the size of the thumbnail cache")
+		return
+	}
+	if stats.Size <= e.maxSize {
+		return
+	}
+
+	// evict a bit more than necessary, otherwise every new thumbnail would trigger the next eviction
+	removed, err := e.cache.Prune(e.maxSize / 10 * 9)
+	if err != nil {
+		e.logger.Error().Err(err).Int("thumbnails", removed.Thumbnails).Msg("could not evict thumbnails")
+		return
+	}
+	e.logger.Info().
+		Int("thumbnails", removed.Thumbnails).
+		Uint64("bytes", removed.Size).
+		Msg("evicted the least recently used thumbnails")
+}
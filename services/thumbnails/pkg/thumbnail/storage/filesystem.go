@@ -4,8 +4,9 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
-	"strconv"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -15,6 +16,9 @@ import (
 
 const (
 	filesDir = "files"
+
+	// putAttempts is how often storing a thumbnail is tried when its directory is removed concurrently
+	putAttempts = 3
 )
 
 // NewFileSystemStorage creates a new instance of FileSystem
@@ -50,12 +54,30 @@ func (s FileSystem) Get(key string) ([]byte, error) {
 		}
 		return nil, err
 	}
+
+	// the modification time tracks the last access for the eviction
+	now := time.Now()
+	if err := os.Chtimes(img, now, now); err != nil {
+		s.logger.Debug().Err(err).Str("key", key).Msg("could not update the last access of the thumbnail")
+	}
 	return content, nil
 }
 
 // Put stores image data in the file system for the given key
 func (s FileSystem) Put(key string, img []byte) error {
 	imgPath := filepath.Join(s.root, filesDir, key)
+
+	// the evictor removes empty directories, a directory can vanish between its creation and the creation of the file
+	var err error
+	for i := 0; i < putAttempts; i++ {
+		if err = s.put(key, imgPath, img); !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s FileSystem) put(key, imgPath string, img []byte) error {
 	dir := filepath.Dir(imgPath)
 	if err := os.MkdirAll(dir, 0700); err != nil {
 		return errors.Wrapf(err, "error while creating directory %s", dir)
@@ -85,16 +107,94 @@ func (s FileSystem) Put(key string, img []byte) error {
 //
 // The key also represents the path to the thumbnail in the filesystem under the configured root directory.
 func (s FileSystem) BuildKey(r Request) string {
-	checksum := r.Checksum
-	filetype := r.Types[0]
+	return filepath.FromSlash(buildKey(r))
+}
 
-	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
+// Stats returns the number and the size of the thumbnails in the file system.
+func (s FileSystem) Stats() (Stats, error) {
+	files, err := s.files()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{}
+	for _, f := range files {
+		stats.Thumbnails++
+		stats.Size += f.size
+	}
+	return stats, nil
+}
+
+// Prune removes the least recently used thumbnails until the thumbnails are not larger than maxSize.
+// The modification time of a thumbnail is its last access, see Get.
+func (s FileSystem) Prune(maxSize uint64) (Stats, error) {
+	files, err := s.files()
+	if err != nil {
+		return Stats{}, err
+	}
 
-	if r.Characteristic != "" {
-		parts = append(parts, "-", r.Characteristic)
+	var size uint64
+	for _, f := range files {
+		size += f.size
 	}
 
-	parts = append(parts, ".", filetype)
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].lastAccess.Before(files[j].lastAccess)
+	})
 
-	return filepath.Join(checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, ""))
+	removed := Stats{}
+	for _, f := range files {
+		if size <= maxSize {
+			break
+		}
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, errors.Wrapf(err, "could not remove thumbnail %s", f.path)
+		}
+		s.removeEmptyDirs(filepath.Dir(f.path))
+
+		size -= f.size
+		removed.Thumbnails++
+		removed.Size += f.size
+	}
+	return removed, nil
+}
+
+type thumbnailFile struct {
+	path       string
+	size       uint64
+	lastAccess time.Time
+}
+
+func (s FileSystem) files() ([]thumbnailFile, error) {
+	var files []thumbnailFile
+	err := filepath.WalkDir(filepath.Join(s.root, filesDir), func(path string, d fs.DirEntry, err error) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return nil
+		case err != nil:
+			return err
+		case !d.Type().IsRegular():
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// the thumbnail was removed in the meantime
+			return nil
+		}
+		files = append(files, thumbnailFile{path: path, size: uint64(info.Size()), lastAccess: info.ModTime()})
+		return nil
+	})
+	return files, err
+}
+
+// removeEmptyDirs removes the empty parent directories of a removed thumbnail
+func (s FileSystem) removeEmptyDirs(dir string) {
+	root := filepath.Join(s.root, filesDir)
+	for dir != root && strings.HasPrefix(dir, root) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
 }
@@ -1,11 +1,18 @@
 package storage_test
 
 import (
+	"context"
 	"image"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	tAssert "github.com/stretchr/testify/assert"
+	tRequire "github.com/stretchr/testify/require"
 
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
 	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
 )
 
@@ -50,6 +57,24 @@ func TestFileSystem_BuildKey(t *testing.T) {
 			},
 			want: "12/0E/A8A25E5D487BF68B5F7096440019/2x2-fill.png",
 		},
+		{
+			r: storage.Request{
+				Checksum: "120EA8A25E5D487BF68B5F7096440019",
+				Types:    []string{"webp"},
+				Resolution: image.Rectangle{
+					Min: image.Point{
+						X: 1,
+						Y: 2,
+					},
+					Max: image.Point{
+						X: 3,
+						Y: 4,
+					},
+				},
+				Characteristic: "fill",
+			},
+			want: "12/0E/A8A25E5D487BF68B5F7096440019/2x2-fill.webp",
+		},
 	}
 
 	s := storage.FileSystem{}
@@ -63,3 +88,65 @@ func TestFileSystem_BuildKey(t *testing.T) {
 	}
 
 }
+
+func TestFileSystem_Prune(t *testing.T) {
+	assert := tAssert.New(t)
+	require := tRequire.New(t)
+
+	root := t.TempDir()
+	s := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+
+	keys := []string{"aa/bb/cc/16x16.png", "aa/bb/cc/32x32.png", "dd/ee/ff/16x16.png"}
+	for i, key := range keys {
+		require.NoError(s.Put(key, make([]byte, 100)))
+		// the thumbnails were last accessed in the order of the keys
+		lastAccess := time.Now().Add(time.Duration(i-len(keys)) * time.Hour)
+		require.NoError(os.Chtimes(filepath.Join(root, "files", key), lastAccess, lastAccess))
+	}
+
+	// accessing a thumbnail makes it the most recently used one
+	_, err := s.Get(keys[0])
+	require.NoError(err)
+
+	stats, err := s.Stats()
+	require.NoError(err)
+	assert.Equal(storage.Stats{Thumbnails: 3, Size: 300}, stats)
+
+	removed, err := s.Prune(200)
+	require.NoError(err)
+	assert.Equal(storage.Stats{Thumbnails: 1, Size: 100}, removed)
+	assert.True(s.Stat(keys[0]))
+	assert.False(s.Stat(keys[1]))
+	assert.True(s.Stat(keys[2]))
+
+	removed, err = s.Prune(0)
+	require.NoError(err)
+	assert.Equal(storage.Stats{Thumbnails: 2, Size: 200}, removed)
+	assert.NoDirExists(filepath.Join(root, "files", "aa"))
+
+	stats, err = s.Stats()
+	require.NoError(err)
+	assert.Equal(storage.Stats{}, stats)
+}
+
+func TestEvictor(t *testing.T) {
+	require := tRequire.New(t)
+
+	root := t.TempDir()
+	s := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+	for _, key := range []string{"aa/bb/cc/16x16.png", "aa/bb/cc/32x32.png", "dd/ee/ff/16x16.png"} {
+		require.NoError(s.Put(key, make([]byte, 100)))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = storage.NewEvictor(s, 250, time.Hour, log.NopLogger()).Run(ctx)
+	}()
+
+	// the evictor frees some space below the maximum size
+	require.Eventually(func() bool {
+		stats, err := s.Stats()
+		return err == nil && stats.Size == 200
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+)
+
+// NewS3Storage creates a new instance of S3
+func NewS3Storage(cfg config.S3Storage, logger log.Logger) (S3, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return S3{}, errors.Wrap(err, "could not parse the s3 endpoint")
+	}
+	if endpoint.Host == "" {
+		return S3{}, errors.Errorf("the s3 endpoint %q must contain the scheme and the host", cfg.Endpoint)
+	}
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return S3{}, errors.New("unexpected type of the default http transport")
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.Insecure, //nolint:gosec
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:     credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:    endpoint.Scheme == "https",
+		Region:    cfg.Region,
+		Transport: transport,
+	})
+	if err != nil {
+		return S3{}, errors.Wrap(err, "could not create the s3 client")
+	}
+
+	return S3{
+		client: client,
+		bucket: cfg.Bucket,
+		logger: logger,
+	}, nil
+}
+
+// S3 represents a storage for the thumbnails using an S3 compatible object storage.
+// It can be shared by multiple instances of the thumbnails service.
+type S3 struct {
+	client *minio.Client
+	bucket string
+	logger log.Logger
+}
+
+// Stat returns if an object for the given key exists in the bucket
+func (s S3) Stat(key string) bool {
+	_, err := s.client.StatObject(context.Background(), s.bucket, key, minio.StatObjectOptions{})
+	return err == nil
+}
+
+// Get returns the object content for the given key
+func (s S3) Get(key string) ([]byte, error) {
+	obj, err := s.client.GetObject(context.Background(), s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+			s.logger.Debug().Str("err", err.Error()).Str("key", key).Msg("could not load thumbnail from store")
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+// Put stores image data in the bucket for the given key
+func (s S3) Put(key string, img []byte) error {
+	_, err := s.client.PutObject(context.Background(), s.bucket, key, bytes.NewReader(img), int64(len(img)), minio.PutObjectOptions{
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not store thumbnail \"%s\"", key)
+	}
+	return nil
+}
+
+// BuildKey generate the unique key for a thumbnail, it has the same structure as the keys of the FileSystem storage.
+func (s S3) BuildKey(r Request) string {
+	return buildKey(r)
+}
+
+// Stats returns the number and the size of the thumbnails in the bucket.
+func (s S3) Stats() (Stats, error) {
+	objects, err := s.objects()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{}
+	for _, o := range objects {
+		stats.Thumbnails++
+		stats.Size += uint64(o.Size)
+	}
+	return stats, nil
+}
+
+// Prune removes the oldest thumbnails until the thumbnails are not larger than maxSize.
+// Object storages don't track the last access, so the thumbnails are removed by their creation time.
+func (s S3) Prune(maxSize uint64) (Stats, error) {
+	objects, err := s.objects()
+	if err != nil {
+		return Stats{}, err
+	}
+
+	var size uint64
+	for _, o := range objects {
+		size += uint64(o.Size)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].LastModified.Before(objects[j].LastModified)
+	})
+
+	removed := Stats{}
+	for _, o := range objects {
+		if size <= maxSize {
+			break
+		}
+		if err := s.client.RemoveObject(context.Background(), s.bucket, o.Key, minio.RemoveObjectOptions{}); err != nil {
+			return removed, errors.Wrapf(err, "could not remove thumbnail %s", o.Key)
+		}
+
+		size -= uint64(o.Size)
+		removed.Thumbnails++
+		removed.Size += uint64(o.Size)
+	}
+	return removed, nil
+}
+
+func (s S3) objects() ([]minio.ObjectInfo, error) {
+	// stops the listing when returning early
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var objects []minio.ObjectInfo
+	for o := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if o.Err != nil {
+			return nil, errors.Wrap(o.Err, "could not list the thumbnails")
+		}
+		objects = append(objects, o)
+	}
+	return objects, nil
+}
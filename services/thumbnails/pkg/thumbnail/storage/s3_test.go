@@ -0,0 +1,199 @@
+package storage_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tAssert "github.com/stretchr/testify/assert"
+	tRequire "github.com/stretchr/testify/require"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// fakeS3 is a minimal stand-in for an S3 compatible object storage with a single bucket.
+// It supports the requests needed by the storage and ignores the authentication.
+type fakeS3 struct {
+	bucket  string
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	data     []byte
+	modified time.Time
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name       `xml:"ListBucketResult"`
+	Name        string         `xml:"Name"`
+	KeyCount    int            `xml:"KeyCount"`
+	MaxKeys     int            `xml:"MaxKeys"`
+	IsTruncated bool           `xml:"IsTruncated"`
+	Contents    []listContents `xml:"Contents"`
+}
+
+type listContents struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int    `xml:"Size"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		result := listBucketResult{Name: f.bucket, MaxKeys: 1000}
+		for k, o := range f.objects {
+			result.Contents = append(result.Contents, listContents{
+				Key:          k,
+				LastModified: o.modified.UTC().Format("2006-01-02T15:04:05.000Z"),
+				ETag:         `"etag"`,
+				Size:         len(o.data),
+			})
+		}
+		sort.Slice(result.Contents, func(i, j int) bool {
+			return result.Contents[i].Key < result.Contents[j].Key
+		})
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err == nil && strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			data, err = decodeAWSChunked(data)
+		}
+		if err != nil {
+			f.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		f.objects[key] = fakeObject{data: data, modified: time.Now()}
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		o, ok := f.objects[key]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", o.modified.UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Length", fmt.Sprint(len(o.data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(o.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// decodeAWSChunked decodes the chunks of a streamed upload, the signatures and trailers are ignored
+func decodeAWSChunked(body []byte) ([]byte, error) {
+	var data []byte
+	for {
+		header, rest, ok := strings.Cut(string(body), "\r\n")
+		if !ok {
+			return nil, fmt.Errorf("invalid chunk")
+		}
+		size, err := strconv.ParseInt(strings.Split(header, ";")[0], 16, 64)
+		if err != nil || int(size)+2 > len(rest) {
+			return nil, fmt.Errorf("invalid chunk size")
+		}
+		if size == 0 {
+			return data, nil
+		}
+		data = append(data, rest[:size]...)
+		body = []byte(rest[size+2:])
+	}
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func newS3Storage(t *testing.T) (storage.S3, *fakeS3) {
+	fake := &fakeS3{bucket: "thumbnails", objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	s, err := storage.NewS3Storage(config.S3Storage{
+		Endpoint:  server.URL,
+		Region:    "default",
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "thumbnails",
+	}, log.NopLogger())
+	tRequire.NoError(t, err)
+	return s, fake
+}
+
+func TestS3(t *testing.T) {
+	assert := tAssert.New(t)
+	require := tRequire.New(t)
+	s, _ := newS3Storage(t)
+
+	key := s.BuildKey(storage.Request{
+		Checksum:   "120EA8A25E5D487BF68B5F7096440019",
+		Types:      []string{"webp"},
+		Resolution: image.Rect(0, 0, 32, 32),
+	})
+	assert.Equal("12/0E/A8A25E5D487BF68B5F7096440019/32x32.webp", key)
+
+	assert.False(s.Stat(key))
+	_, err := s.Get(key)
+	assert.Error(err)
+
+	require.NoError(s.Put(key, []byte("thumbnail")))
+	assert.True(s.Stat(key))
+	content, err := s.Get(key)
+	require.NoError(err)
+	assert.Equal([]byte("thumbnail"), content)
+}
+
+func TestS3_Prune(t *testing.T) {
+	assert := tAssert.New(t)
+	require := tRequire.New(t)
+	s, fake := newS3Storage(t)
+
+	keys := []string{"aa/bb/cc/16x16.png", "aa/bb/cc/32x32.png", "dd/ee/ff/16x16.png"}
+	for i, key := range keys {
+		require.NoError(s.Put(key, make([]byte, 100)))
+		// the thumbnails were created in the order of the keys
+		fake.objects[key] = fakeObject{data: fake.objects[key].data, modified: time.Now().Add(time.Duration(i-len(keys)) * time.Hour)}
+	}
+
+	stats, err := s.Stats()
+	require.NoError(err)
+	assert.Equal(storage.Stats{Thumbnails: 3, Size: 300}, stats)
+
+	removed, err := s.Prune(150)
+	require.NoError(err)
+	assert.Equal(storage.Stats{Thumbnails: 2, Size: 200}, removed)
+	assert.False(s.Stat(keys[0]))
+	assert.False(s.Stat(keys[1]))
+	assert.True(s.Stat(keys[2]))
+}
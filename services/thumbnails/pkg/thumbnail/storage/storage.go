@@ -2,6 +2,21 @@ package storage
 
 import (
 	"image"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/owncloud/ocis/v2/ocis-pkg/log"
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/config"
+)
+
+const (
+	// TypeFileSystem is the storage type of the FileSystem storage
+	TypeFileSystem = "filesystem"
+	// TypeS3 is the storage type of the S3 storage
+	TypeS3 = "s3"
 )
 
 // Request combines different attributes needed for storage operations.
@@ -30,3 +45,46 @@ type Storage interface {
 	Put(key string, img []byte) error
 	BuildKey(r Request) string
 }
+
+// Cache is implemented by storages which can report their usage and evict thumbnails.
+type Cache interface {
+	// Stats returns the number and the size of the stored thumbnails.
+	Stats() (Stats, error)
+	// Prune removes the least recently used thumbnails until the stored thumbnails are not larger than maxSize.
+	// It returns the number and the size of the removed thumbnails.
+	Prune(maxSize uint64) (Stats, error)
+}
+
+// Stats holds the number and the size of thumbnails.
+type Stats struct {
+	Thumbnails int
+	Size       uint64
+}
+
+// New creates the storage configured for the thumbnails.
+func New(cfg config.Thumbnail, logger log.Logger) (Storage, error) {
+	switch cfg.Storage {
+	case TypeFileSystem, "":
+		return NewFileSystemStorage(cfg.FileSystemStorage, logger), nil
+	case TypeS3:
+		return NewS3Storage(cfg.S3Storage, logger)
+	default:
+		return nil, errors.Errorf("unknown thumbnail storage %q", cfg.Storage)
+	}
+}
+
+// buildKey generates the unique key for a thumbnail, see FileSystem.BuildKey for its structure.
+func buildKey(r Request) string {
+	checksum := r.Checksum
+	filetype := r.Types[0]
+
+	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
+
+	if r.Characteristic != "" {
+		parts = append(parts, "-", r.Characteristic)
+	}
+
+	parts = append(parts, ".", filetype)
+
+	return path.Join(checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, ""))
+}
@@ -0,0 +1,579 @@
+// Package webp implements a lossless WebP encoder.
+//
+// The encoder writes the VP8L bitstream with the subtract green and predictor transforms,
+// backward references to the left and the upper pixel and a single prefix code group.
+// It trades compression ratio for simplicity and speed, which suits thumbnails.
+package webp
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+)
+
+const (
+	maxDimension = 1 << 14
+
+	// predictorBits defines the size of the blocks sharing a predictor, 1<<predictorBits pixels wide and high
+	predictorBits = 4
+
+	// backward references shorter than minLength are written as literals
+	minLength = 3
+	maxLength = 4096
+
+	numLiteralCodes  = 256
+	numLengthCodes   = 24
+	numDistanceCodes = 40
+
+	maxCodeLength           = 15
+	maxCodeLengthCodeLength = 7
+)
+
+const (
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+)
+
+// the distance codes of the upper and the left pixel
+const (
+	distanceCodeUp   = 1
+	distanceCodeLeft = 2
+)
+
+// the predictor modes used by the encoder
+var predictorModes = []uint32{1, 2, 3, 4, 7}
+
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// ErrInvalidDimensions is returned for empty images and images exceeding the maximum size of WebP
+var ErrInvalidDimensions = errors.New("webp: invalid image dimensions")
+
+// Encode writes the image m to w in the lossless WebP format.
+func Encode(w io.Writer, m image.Image) error {
+	b := m.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > maxDimension || height > maxDimension {
+		return ErrInvalidDimensions
+	}
+
+	pix, hasAlpha := argbPixels(m)
+
+	bw := &bitWriter{}
+	bw.writeBits(0x2f, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3) // version
+
+	// the decoder inverts the transforms in the reverse order
+	subtractGreen(pix)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformSubtractGreen, 2)
+
+	modes, modesWidth := predict(pix, width, height)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformPredictor, 2)
+	bw.writeBits(predictorBits-2, 3)
+	writeImage(bw, modes, modesWidth, false)
+
+	bw.writeBits(0, 1)
+	writeImage(bw, pix, width, true)
+
+	data := bw.bytes()
+	size := len(data)
+	if size%2 == 1 {
+		data = append(data, 0)
+	}
+
+	header := make([]byte, 20)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(12+len(data)))
+	copy(header[8:12], "WEBP")
+	copy(header[12:16], "VP8L")
+	binary.LittleEndian.PutUint32(header[16:20], uint32(size))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// argbPixels returns the non premultiplied pixels of the image as ARGB values
+func argbPixels(m image.Image) ([]uint32, bool) {
+	b := m.Bounds()
+	pix := make([]uint32, 0, b.Dx()*b.Dy())
+	hasAlpha := false
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := color.NRGBAModel.Convert(m.At(x, y)).(color.NRGBA)
+			if c.A != 0xff {
+				hasAlpha = true
+			}
+			pix = append(pix, uint32(c.A)<<24|uint32(c.R)<<16|uint32(c.G)<<8|uint32(c.B))
+		}
+	}
+	return pix, hasAlpha
+}
+
+func subtractGreen(pix []uint32) {
+	for i, p := range pix {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		b := (p - g) & 0xff
+		pix[i] = p&0xff00ff00 | r<<16 | b
+	}
+}
+
+// predict replaces the pixels by their residuals and returns the predictor modes of the blocks.
+// The mode of each block is chosen by the smallest sum of the residuals.
+func predict(pix []uint32, width, height int) ([]uint32, int) {
+	blockSize := 1 << predictorBits
+	modesWidth := (width + blockSize - 1) >> predictorBits
+	modesHeight := (height + blockSize - 1) >> predictorBits
+	modes := make([]uint32, modesWidth*modesHeight)
+
+	for by := 0; by < modesHeight; by++ {
+		for bx := 0; bx < modesWidth; bx++ {
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				for y := by * blockSize; y < min((by+1)*blockSize, height); y++ {
+					for x := bx * blockSize; x < min((bx+1)*blockSize, width); x++ {
+						if x == 0 || y == 0 {
+							continue
+						}
+						i := y*width + x
+						cost += residualCost(residual(pix[i], prediction(mode, pix, i, width)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes[by*modesWidth+bx] = 0xff000000 | best<<8
+		}
+	}
+
+	// the residuals are computed from the end, the predictions depend on the original pixels before
+	for i := len(pix) - 1; i >= 0; i-- {
+		x, y := i%width, i/width
+		var pred uint32
+		switch {
+		case x == 0 && y == 0:
+			pred = 0xff000000
+		case y == 0:
+			pred = pix[i-1]
+		case x == 0:
+			pred = pix[i-width]
+		default:
+			mode := (modes[(y>>predictorBits)*modesWidth+(x>>predictorBits)] >> 8) & 0xf
+			pred = prediction(mode, pix, i, width)
+		}
+		pix[i] = residual(pix[i], pred)
+	}
+
+	return modes, modesWidth
+}
+
+func prediction(mode uint32, pix []uint32, i, width int) uint32 {
+	switch mode {
+	case 1:
+		return pix[i-1]
+	case 2:
+		return pix[i-width]
+	case 3:
+		// for the rightmost column this is the leftmost pixel of the current row, as expected by the decoder
+		return pix[i-width+1]
+	case 4:
+		return pix[i-width-1]
+	case 7:
+		return average2(pix[i-1], pix[i-width])
+	default:
+		return 0xff000000
+	}
+}
+
+func average2(a, b uint32) uint32 {
+	return (((a ^ b) & 0xfefefefe) >> 1) + (a & b)
+}
+
+// residual subtracts the prediction from each channel of the pixel
+func residual(p, pred uint32) uint32 {
+	a := ((p >> 24) - (pred >> 24)) & 0xff
+	r := ((p >> 16) - (pred >> 16)) & 0xff
+	g := ((p >> 8) - (pred >> 8)) & 0xff
+	b := (p - pred) & 0xff
+	return a<<24 | r<<16 | g<<8 | b
+}
+
+func residualCost(p uint32) int {
+	cost := 0
+	for shift := 0; shift < 32; shift += 8 {
+		c := int((p >> shift) & 0xff)
+		cost += min(c, 256-c)
+	}
+	return cost
+}
+
+// token is either a literal pixel or a backward reference
+type token struct {
+	pixel        uint32
+	length       int
+	distanceCode int
+}
+
+// backwardReferences finds the runs of pixels repeating the left or the upper pixel
+func backwardReferences(pix []uint32, width int) []token {
+	tokens := make([]token, 0, len(pix))
+	for i := 0; i < len(pix); {
+		length, code := 0, 0
+		if i >= 1 {
+			length, code = matchLength(pix, i, 1), distanceCodeLeft
+		}
+		if i >= width {
+			if l := matchLength(pix, i, width); l > length {
+				length, code = l, distanceCodeUp
+			}
+		}
+
+		if length >= minLength {
+			tokens = append(tokens, token{length: length, distanceCode: code})
+			i += length
+			continue
+		}
+
+		tokens = append(tokens, token{pixel: pix[i]})
+		i++
+	}
+	return tokens
+}
+
+func matchLength(pix []uint32, i, distance int) int {
+	l := 0
+	for i+l < len(pix) && l < maxLength && pix[i+l] == pix[i+l-distance] {
+		l++
+	}
+	return l
+}
+
+// prefixEncode splits a length or distance code into its prefix symbol and extra bits
+func prefixEncode(v int) (int, uint, uint32) {
+	d := v - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	h := 0
+	for d>>(h+1) != 0 {
+		h++
+	}
+	second := (d >> (h - 1)) & 1
+	extraBits := uint(h - 1)
+	return 2*h + second, extraBits, uint32(d) & (1<<extraBits - 1)
+}
+
+// writeImage writes the entropy coded image without color cache and with a single prefix code group
+func writeImage(bw *bitWriter, pix []uint32, width int, isMain bool) {
+	bw.writeBits(0, 1) // no color cache
+	if isMain {
+		bw.writeBits(0, 1) // no meta prefix codes
+	}
+
+	tokens := backwardReferences(pix, width)
+
+	green := make([]int, numLiteralCodes+numLengthCodes)
+	red := make([]int, numLiteralCodes)
+	blue := make([]int, numLiteralCodes)
+	alpha := make([]int, numLiteralCodes)
+	distance := make([]int, numDistanceCodes)
+	for _, t := range tokens {
+		if t.length > 0 {
+			lc, _, _ := prefixEncode(t.length)
+			dc, _, _ := prefixEncode(t.distanceCode)
+			green[numLiteralCodes+lc]++
+			distance[dc]++
+			continue
+		}
+		alpha[t.pixel>>24]++
+		red[(t.pixel>>16)&0xff]++
+		green[(t.pixel>>8)&0xff]++
+		blue[t.pixel&0xff]++
+	}
+
+	greenCode := writePrefixCode(bw, green)
+	redCode := writePrefixCode(bw, red)
+	blueCode := writePrefixCode(bw, blue)
+	alphaCode := writePrefixCode(bw, alpha)
+	distanceCode := writePrefixCode(bw, distance)
+
+	for _, t := range tokens {
+		if t.length > 0 {
+			lc, lBits, lExtra := prefixEncode(t.length)
+			greenCode.write(bw, numLiteralCodes+lc)
+			bw.writeBits(lExtra, lBits)
+			dc, dBits, dExtra := prefixEncode(t.distanceCode)
+			distanceCode.write(bw, dc)
+			bw.writeBits(dExtra, dBits)
+			continue
+		}
+		greenCode.write(bw, int((t.pixel>>8)&0xff))
+		redCode.write(bw, int((t.pixel>>16)&0xff))
+		blueCode.write(bw, int(t.pixel&0xff))
+		alphaCode.write(bw, int(t.pixel>>24))
+	}
+}
+
+// prefixCode holds the canonical codes of an alphabet, bit reversed to be written least significant bit first
+type prefixCode struct {
+	codes   []uint32
+	lengths []int
+}
+
+func (c prefixCode) write(bw *bitWriter, symbol int) {
+	bw.writeBits(c.codes[symbol], uint(c.lengths[symbol]))
+}
+
+// writePrefixCode writes the code of the histogram and returns it.
+// Alphabets with up to two symbols below 256 use the simple code, all others the normal code.
+func writePrefixCode(bw *bitWriter, histogram []int) prefixCode {
+	var used []int
+	for s, n := range histogram {
+		if n > 0 {
+			used = append(used, s)
+		}
+	}
+
+	lengths := make([]int, len(histogram))
+	if len(used) == 0 {
+		used = append(used, 0)
+	}
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint32(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+			lengths[used[0]], lengths[used[1]] = 1, 1
+		}
+		return prefixCode{codes: canonicalCodes(lengths), lengths: lengths}
+	}
+
+	if len(used) == 1 {
+		// a normal code needs at least two symbols
+		histogram = append([]int(nil), histogram...)
+		histogram[(used[0]+1)%len(histogram)] = 1
+	}
+	lengths = codeLengths(histogram, maxCodeLength)
+
+	bw.writeBits(0, 1)
+	writeCodeLengths(bw, lengths)
+	return prefixCode{codes: canonicalCodes(lengths), lengths: lengths}
+}
+
+// writeCodeLengths writes the code lengths of a normal code, runs of zeros are written with the repeat codes 17 and 18
+func writeCodeLengths(bw *bitWriter, lengths []int) {
+	type clToken struct {
+		symbol    int
+		extra     uint32
+		extraBits uint
+	}
+
+	var tokens []clToken
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, clToken{symbol: lengths[i]})
+			i++
+			continue
+		}
+
+		run := 0
+		for i+run < len(lengths) && lengths[i+run] == 0 {
+			run++
+		}
+		i += run
+		for run > 0 {
+			switch {
+			case run >= 11:
+				n := min(run, 138)
+				tokens = append(tokens, clToken{symbol: 18, extra: uint32(n - 11), extraBits: 7})
+				run -= n
+			case run >= 3:
+				tokens = append(tokens, clToken{symbol: 17, extra: uint32(run - 3), extraBits: 3})
+				run = 0
+			default:
+				tokens = append(tokens, clToken{symbol: 0})
+				run--
+			}
+		}
+	}
+
+	histogram := make([]int, len(codeLengthCodeOrder))
+	for _, t := range tokens {
+		histogram[t.symbol]++
+	}
+	used := 0
+	for _, n := range histogram {
+		if n > 0 {
+			used++
+		}
+	}
+	if used == 1 {
+		// the code length code needs at least two symbols
+		if histogram[0] == 0 {
+			histogram[0] = 1
+		} else {
+			histogram[1] = 1
+		}
+	}
+	clLengths := codeLengths(histogram, maxCodeLengthCodeLength)
+
+	n := len(codeLengthCodeOrder)
+	for n > 4 && clLengths[codeLengthCodeOrder[n-1]] == 0 {
+		n--
+	}
+	bw.writeBits(uint32(n-4), 4)
+	for _, s := range codeLengthCodeOrder[:n] {
+		bw.writeBits(uint32(clLengths[s]), 3)
+	}
+	bw.writeBits(0, 1) // the code lengths of all symbols are written
+
+	clCode := prefixCode{codes: canonicalCodes(clLengths), lengths: clLengths}
+	for _, t := range tokens {
+		clCode.write(bw, t.symbol)
+		bw.writeBits(t.extra, t.extraBits)
+	}
+}
+
+// codeLengths computes the lengths of a huffman code for the histogram which has at least two symbols.
+// The counts are halved until no code is longer than the limit.
+func codeLengths(histogram []int, limit int) []int {
+	counts := append([]int(nil), histogram...)
+	for {
+		lengths := huffmanLengths(counts)
+		longest := 0
+		for _, l := range lengths {
+			longest = max(longest, l)
+		}
+		if longest <= limit {
+			return lengths
+		}
+		for i, n := range counts {
+			if n > 0 {
+				counts[i] = (n + 1) / 2
+			}
+		}
+	}
+}
+
+func huffmanLengths(counts []int) []int {
+	type node struct {
+		count       int
+		symbol      int
+		left, right int
+	}
+
+	var nodes []node
+	var queue []int
+	for s, n := range counts {
+		if n > 0 {
+			nodes = append(nodes, node{count: n, symbol: s, left: -1, right: -1})
+			queue = append(queue, len(nodes)-1)
+		}
+	}
+
+	// repeatedly merge the two nodes with the smallest counts
+	for len(queue) > 1 {
+		var pick [2]int
+		for k := range pick {
+			m := 0
+			for j := range queue {
+				if nodes[queue[j]].count < nodes[queue[m]].count {
+					m = j
+				}
+			}
+			pick[k] = queue[m]
+			queue = append(queue[:m], queue[m+1:]...)
+		}
+		nodes = append(nodes, node{count: nodes[pick[0]].count + nodes[pick[1]].count, symbol: -1, left: pick[0], right: pick[1]})
+		queue = append(queue, len(nodes)-1)
+	}
+
+	lengths := make([]int, len(counts))
+	var walk func(n, depth int)
+	walk = func(n, depth int) {
+		if nodes[n].symbol >= 0 {
+			lengths[nodes[n].symbol] = depth
+			return
+		}
+		walk(nodes[n].left, depth+1)
+		walk(nodes[n].right, depth+1)
+	}
+	walk(queue[0], 0)
+	return lengths
+}
+
+// canonicalCodes assigns the canonical codes to the code lengths like DEFLATE does
+func canonicalCodes(lengths []int) []uint32 {
+	var count [maxCodeLength + 1]uint32
+	for _, l := range lengths {
+		if l > 0 {
+			count[l]++
+		}
+	}
+	var next [maxCodeLength + 2]uint32
+	for l := 1; l <= maxCodeLength; l++ {
+		next[l+1] = (next[l] + count[l]) << 1
+	}
+
+	codes := make([]uint32, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		code := next[l]
+		next[l]++
+		var reversed uint32
+		for i := 0; i < l; i++ {
+			reversed = reversed<<1 | (code>>i)&1
+		}
+		codes[s] = reversed
+	}
+	return codes
+}
+
+// bitWriter writes bits least significant bit first
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nacc uint
+}
+
+func (w *bitWriter) writeBits(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nacc
+	w.nacc += n
+	for w.nacc >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nacc -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nacc > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nacc = 0, 0
+	}
+	return w.buf
+}
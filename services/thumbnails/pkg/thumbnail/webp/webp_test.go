@@ -0,0 +1,114 @@
+package webp_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xwebp "golang.org/x/image/webp"
+
+	"github.com/owncloud/ocis/v2/services/thumbnails/pkg/thumbnail/webp"
+)
+
+func TestEncode(t *testing.T) {
+	m := image.NewNRGBA(image.Rect(0, 0, 37, 21))
+	for y := 0; y < 21; y++ {
+		for x := 0; x < 37; x++ {
+			m.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 7), G: uint8(y * 11), B: 200, A: 255})
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, webp.Encode(buf, m))
+
+	data := buf.Bytes()
+	require.Greater(t, len(data), 25)
+	assert.Equal(t, "RIFF", string(data[0:4]))
+	assert.Equal(t, uint32(len(data)-8), binary.LittleEndian.Uint32(data[4:8]))
+	assert.Equal(t, "WEBPVP8L", string(data[8:16]))
+	assert.Equal(t, byte(0x2f), data[20])
+
+	header := binary.LittleEndian.Uint32(data[21:25])
+	assert.Equal(t, uint32(37), header&0x3fff+1)
+	assert.Equal(t, uint32(21), (header>>14)&0x3fff+1)
+	assert.Equal(t, uint32(0), (header>>28)&1, "the image has no alpha")
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	filled := func(width, height int, pixel func(x, y int) color.NRGBA) image.Image {
+		m := image.NewNRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				m.SetNRGBA(x, y, pixel(x, y))
+			}
+		}
+		return m
+	}
+
+	tests := map[string]image.Image{
+		"single pixel": filled(1, 1, func(x, y int) color.NRGBA { return color.NRGBA{R: 12, G: 34, B: 56, A: 255} }),
+		"single column": filled(1, 37, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(y * 5), G: 100, B: uint8(255 - y), A: 255}
+		}),
+		"single row": filled(53, 1, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x), G: uint8(x * 3), B: uint8(x * 7), A: 255}
+		}),
+		"odd size": filled(37, 21, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x * 7), G: uint8(y * 11), B: 200, A: 255}
+		}),
+		"alpha": filled(19, 13, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x * 13), G: uint8(y * 19), B: 77, A: uint8(x*y*3 + 1)}
+		}),
+		"single column with alpha": filled(1, 9, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: 200, G: 100, B: 50, A: uint8(y * 31)}
+		}),
+		"repeated pattern": filled(64, 33, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x % 4 * 60), G: uint8(y % 3 * 80), B: 10, A: 255}
+		}),
+		"noise": filled(45, 29, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(rnd.Intn(256)), G: uint8(rnd.Intn(256)), B: uint8(rnd.Intn(256)), A: uint8(rnd.Intn(256))}
+		}),
+		"sub image": filled(31, 17, func(x, y int) color.NRGBA {
+			return color.NRGBA{R: uint8(x * 8), G: uint8(y * 15), B: uint8(x + y), A: 255}
+		}).(*image.NRGBA).SubImage(image.Rect(3, 2, 26, 15)),
+		"premultiplied input": func() image.Image {
+			m := image.NewRGBA(image.Rect(0, 0, 7, 5))
+			for y := 0; y < 5; y++ {
+				for x := 0; x < 7; x++ {
+					m.SetRGBA(x, y, color.RGBA{R: uint8(x * 20), G: uint8(y * 20), B: 30, A: 160})
+				}
+			}
+			return m
+		}(),
+	}
+
+	for name, m := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			require.NoError(t, webp.Encode(buf, m))
+
+			decoded, err := xwebp.Decode(buf)
+			require.NoError(t, err)
+			require.Equal(t, m.Bounds().Size(), decoded.Bounds().Size())
+
+			for y := 0; y < m.Bounds().Dy(); y++ {
+				for x := 0; x < m.Bounds().Dx(); x++ {
+					want := color.NRGBAModel.Convert(m.At(m.Bounds().Min.X+x, m.Bounds().Min.Y+y))
+					got := color.NRGBAModel.Convert(decoded.At(decoded.Bounds().Min.X+x, decoded.Bounds().Min.Y+y))
+					require.Equal(t, want, got, "pixel %d,%d", x, y)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeInvalidDimensions(t *testing.T) {
+	assert.ErrorIs(t, webp.Encode(new(bytes.Buffer), image.NewNRGBA(image.Rect(0, 0, 0, 10))), webp.ErrInvalidDimensions)
+	assert.ErrorIs(t, webp.Encode(new(bytes.Buffer), image.NewNRGBA(image.Rect(0, 0, 1<<14+1, 1))), webp.ErrInvalidDimensions)
+}
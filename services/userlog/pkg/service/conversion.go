@@ -18,6 +18,7 @@ import (
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/cs3org/reva/v2/pkg/storagespace"
 	"github.com/cs3org/reva/v2/pkg/utils"
+	ocisevent "github.com/owncloud/ocis/v2/ocis-pkg/event"
 	"github.com/owncloud/ocis/v2/ocis-pkg/l10n"
 )
 
@@ -96,6 +97,22 @@ func (c *Converter) ConvertEvent(eventid string, event interface{}) (OC10Notific
 			return OC10Notification{}, fmt.Errorf("unknown postprocessing step: %s", ev.FinishedStep)
 		}
 
+	case ocisevent.StoredFileInfected:
+		nt := StoredFileInfected
+		switch ev.Outcome {
+		case events.PPOutcomeDelete:
+			nt = StoredFileDeleted
+		case "quarantine":
+			nt = StoredFileQuarantined
+		}
+		return c.virusMessage(eventid, nt, nil, ev.ResourceID, ev.Filename, ev.Description, ev.Scandate)
+	case ocisevent.SavedSearchMatched:
+		return c.savedSearchMessage(eventid, SavedSearchMatched, ev.ResourceID, ev.Filename, ev.SearchID, ev.SearchName, utils.TSToTime(ev.Timestamp))
+
+	// authentication related
+	case ocisevent.AuthenticationLockedOut:
+		return c.lockoutMessage(eventid, AccountLockedOut, utils.TSToTime(ev.LockedUntil), utils.TSToTime(ev.Timestamp))
+
 	// space related
 	case events.SpaceDisabled:
 		return c.spaceMessage(eventid, SpaceDisabled, ev.Executant, ev.ID.GetOpaqueId(), ev.Timestamp)
@@ -267,6 +284,40 @@ func (c *Converter) virusMessage(eventid string, nt NotificationTemplate, execut
 	}, nil
 }
 
+func (c *Converter) savedSearchMessage(eventid string, nt NotificationTemplate, rid *storageprovider.ResourceId, filename string, searchID string, searchName string, ts time.Time) (OC10Notification, error) {
+	subj, subjraw, msg, msgraw, err := composeMessage(nt, c.locale, c.defaultLanguage, c.translationPath, map[string]interface{}{
+		"resourcename": filename,
+		"searchname":   searchName,
+	})
+	if err != nil {
+		return OC10Notification{}, err
+	}
+
+	dets := map[string]interface{}{
+		"resource": map[string]string{
+			"id":   storagespace.FormatResourceID(rid),
+			"name": filename,
+		},
+		"search": map[string]string{
+			"id":   searchID,
+			"name": searchName,
+		},
+	}
+
+	return OC10Notification{
+		EventID:        eventid,
+		Service:        c.serviceName,
+		Timestamp:      ts.Format(time.RFC3339Nano),
+		ResourceID:     storagespace.FormatResourceID(rid),
+		ResourceType:   _resourceTypeResource,
+		Subject:        subj,
+		SubjectRaw:     subjraw,
+		Message:        msg,
+		MessageRaw:     msgraw,
+		MessageDetails: dets,
+	}, nil
+}
+
 func (c *Converter) policiesMessage(eventid string, nt NotificationTemplate, executant *user.User, filename string, ts time.Time) (OC10Notification, error) {
 	subj, subjraw, msg, msgraw, err := composeMessage(nt, c.locale, c.defaultLanguage, c.translationPath, map[string]interface{}{
 		"resourcename": filename,
@@ -295,6 +346,27 @@ func (c *Converter) policiesMessage(eventid string, nt NotificationTemplate, exe
 	}, nil
 }
 
+func (c *Converter) lockoutMessage(eventid string, nt NotificationTemplate, lockedUntil time.Time, ts time.Time) (OC10Notification, error) {
+	subj, subjraw, msg, msgraw, err := composeMessage(nt, c.locale, c.defaultLanguage, c.translationPath, map[string]interface{}{
+		"date": lockedUntil.UTC().Format(time.RFC1123),
+	})
+	if err != nil {
+		return OC10Notification{}, err
+	}
+
+	return OC10Notification{
+		EventID:        eventid,
+		Service:        c.serviceName,
+		Timestamp:      ts.Format(time.RFC3339Nano),
+		ResourceType:   _resourceTypeResource,
+		Subject:        subj,
+		SubjectRaw:     subjraw,
+		Message:        msg,
+		MessageRaw:     msgraw,
+		MessageDetails: map[string]interface{}{},
+	}, nil
+}
+
 func (c *Converter) deprovisionMessage(nt NotificationTemplate, deproDate string) (OC10Notification, error) {
 	subj, subjraw, msg, msgraw, err := composeMessage(nt, c.locale, c.defaultLanguage, c.translationPath, map[string]interface{}{
 		"date": deproDate,
@@ -10,6 +10,7 @@ import (
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	"github.com/go-chi/chi/v5"
 	"go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
@@ -17,6 +18,7 @@ import (
 	"github.com/cs3org/reva/v2/pkg/events"
 	"github.com/cs3org/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/cs3org/reva/v2/pkg/utils"
+	ocisevent "github.com/owncloud/ocis/v2/ocis-pkg/event"
 	"github.com/owncloud/ocis/v2/ocis-pkg/l10n"
 	"github.com/owncloud/ocis/v2/ocis-pkg/log"
 	"github.com/owncloud/ocis/v2/ocis-pkg/roles"
@@ -150,6 +152,22 @@ func (ul *UserlogService) processEvent(event events.Event) {
 			return
 		}
 
+	case ocisevent.StoredFileInfected:
+		users, err = utils.GetSpaceMembers(ctx, e.SpaceID, gwc, utils.ManagerRole)
+	case ocisevent.SavedSearchMatched:
+		executant = e.Executant
+		users = append(users, e.UserID.GetOpaqueId())
+
+	// authentication related
+	case ocisevent.AuthenticationLockedOut:
+		if e.Kind != "user" {
+			// only locked out users are notified, client IPs and public links are audited
+			return
+		}
+		var u *user.User
+		u, err = getUserByUsername(ctx, gwc, e.Value)
+		users = append(users, u.GetId().GetOpaqueId())
+
 	// space related // TODO: how to find spaceadmins?
 	case events.SpaceDisabled:
 		executant = e.Executant
@@ -467,3 +485,19 @@ func removeExecutant(users []string, executant *user.UserId) []string {
 	}
 	return usrs
 }
+
+// getUserByUsername returns the user with the given username
+func getUserByUsername(ctx context.Context, gwc gateway.GatewayAPIClient, username string) (*user.User, error) {
+	res, err := gwc.GetUserByClaim(ctx, &user.GetUserByClaimRequest{
+		Claim:                  "username",
+		Value:                  username,
+		SkipFetchingUserGroups: true,
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return nil, fmt.Errorf("could not get user %s: %s", username, res.GetStatus().GetMessage())
+	}
+	return res.GetUser(), nil
+}
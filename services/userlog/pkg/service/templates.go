@@ -9,6 +9,26 @@ var (
 		Message: l10n.Template("Virus found in {resource}. Upload not possible. Virus: {virus}"),
 	}
 
+	StoredFileInfected = NotificationTemplate{
+		Subject: l10n.Template("Virus found"),
+		Message: l10n.Template("Virus found in stored file {resource}. Virus: {virus}"),
+	}
+
+	StoredFileDeleted = NotificationTemplate{
+		Subject: l10n.Template("Virus found"),
+		Message: l10n.Template("Virus found in stored file {resource}. The file was deleted. Virus: {virus}"),
+	}
+
+	StoredFileQuarantined = NotificationTemplate{
+		Subject: l10n.Template("Virus found"),
+		Message: l10n.Template("Virus found in stored file {resource}. The file was moved to quarantine. Virus: {virus}"),
+	}
+
+	SavedSearchMatched = NotificationTemplate{
+		Subject: l10n.Template("Saved search matched"),
+		Message: l10n.Template("{resource} matches your saved search {search}"),
+	}
+
 	PoliciesEnforced = NotificationTemplate{
 		Subject: l10n.Template("Policies enforced"),
 		Message: l10n.Template("File {resource} was deleted because it violates the policies"),
@@ -54,6 +74,11 @@ var (
 		Message: l10n.Template("Access to {resource} expired"),
 	}
 
+	AccountLockedOut = NotificationTemplate{
+		Subject: l10n.Template("Account temporarily locked"),
+		Message: l10n.Template("Your account was locked after too many failed login attempts. It will be unlocked on {date}."),
+	}
+
 	PlatformDeprovision = NotificationTemplate{
 		Subject: l10n.Template("Instance will be shut down and deprovisioned"),
 		Message: l10n.Template("Attention! The instance will be shut down and deprovisioned on {date}. Download all your data before that date as no access past that date is possible."),
@@ -66,6 +91,7 @@ var _placeholders = map[string]string{
 	"{space}":    "{{ .spacename }}",
 	"{resource}": "{{ .resourcename }}",
 	"{virus}":    "{{ .virusdescription }}",
+	"{search}":   "{{ .searchname }}",
 	"{date}":     "{{ .date }}",
 }
 
@@ -2,10 +2,13 @@ package requests
 
 import (
 	"fmt"
+	"mime"
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 
 	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"github.com/cs3org/reva/v2/pkg/storagespace"
@@ -40,6 +43,8 @@ type ThumbnailRequest struct {
 	Processor string
 	// The Identifier from the requested URL
 	Identifier string
+	// The media types accepted by the client, most preferred first
+	AcceptedTypes []string
 }
 
 func addMissingStorageID(id string) string {
@@ -78,9 +83,47 @@ func ParseThumbnailRequest(r *http.Request) (*ThumbnailRequest, error) {
 		Processor:       q.Get("processor"),
 		PublicLinkToken: chi.URLParam(r, "token"),
 		Identifier:      id,
+		AcceptedTypes:   parseAccept(r.Header.Get("Accept")),
 	}, nil
 }
 
+// parseAccept returns the media types of an Accept header ordered by their quality.
+// Media types with the same quality keep their order, media types with a quality of 0 are dropped.
+func parseAccept(header string) []string {
+	type accepted struct {
+		mediaType string
+		quality   float64
+	}
+
+	var types []accepted
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		types = append(types, accepted{mediaType: mediaType, quality: quality})
+	}
+
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].quality > types[j].quality
+	})
+
+	mediaTypes := make([]string, 0, len(types))
+	for _, t := range types {
+		mediaTypes = append(mediaTypes, t.mediaType)
+	}
+	return mediaTypes
+}
+
 func parseDimensions(q url.Values) (int64, int64, error) {
 	width, err := parseDimension(q.Get("x"), "width", DefaultWidth)
 	if err != nil {
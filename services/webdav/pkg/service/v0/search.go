@@ -55,6 +55,12 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 		Query:    rep.SearchFiles.Search.Pattern,
 		PageSize: int32(rep.SearchFiles.Search.Limit),
 	}
+	for _, f := range rep.SearchFiles.Search.Facets {
+		req.Facets = append(req.Facets, &searchsvc.FacetRequest{
+			Field: strings.TrimSpace(f.Field),
+			Size:  int32(f.Size),
+		})
+	}
 
 	// Limit search to the according space when searching /dav/spaces/
 	if strings.HasPrefix(r.URL.Path, "/dav/spaces") {
@@ -91,7 +97,7 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 
 func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.ResponseWriter, r *http.Request) {
 	logger := g.log.SubloggerWithRequestID(r.Context())
-	responsesXML, err := multistatusResponse(r.Context(), rsp.Matches)
+	responsesXML, err := multistatusResponse(r.Context(), rsp.Matches, rsp.Facets)
 	if err != nil {
 		logger.Error().Err(err).Msg("error formatting propfind")
 		w.WriteHeader(http.StatusInternalServerError)
@@ -108,8 +114,8 @@ func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.Respons
 	}
 }
 
-// multistatusResponse converts a list of matches into a multistatus response string
-func multistatusResponse(ctx context.Context, matches []*searchmsg.Match) ([]byte, error) {
+// multistatusResponse converts a list of matches and the facet counts into a multistatus response string
+func multistatusResponse(ctx context.Context, matches []*searchmsg.Match, facets []*searchsvc.Facet) ([]byte, error) {
 	responses := make([]*propfind.ResponseXML, 0, len(matches))
 	for i := range matches {
 		res, err := matchToPropResponse(ctx, matches[i])
@@ -119,8 +125,18 @@ func multistatusResponse(ctx context.Context, matches []*searchmsg.Match) ([]byt
 		responses = append(responses, res)
 	}
 
-	msr := propfind.NewMultiStatusResponseXML()
+	msr := searchMultiStatusXML{MultiStatusResponseXML: *propfind.NewMultiStatusResponseXML()}
 	msr.Responses = responses
+	if len(facets) > 0 {
+		msr.Facets = &facetsXML{}
+		for _, f := range facets {
+			fx := facetXML{Field: f.Field}
+			for _, v := range f.Values {
+				fx.Values = append(fx.Values, facetValueXML{Count: v.Count, Value: v.Value})
+			}
+			msr.Facets.Facets = append(msr.Facets.Facets, fx)
+		}
+	}
 	msg, err := xml.Marshal(msr)
 	if err != nil {
 		return nil, err
@@ -235,9 +251,36 @@ type reportSearchFiles struct {
 	Search  reportSearchFilesSearch `xml:"search"`
 }
 type reportSearchFilesSearch struct {
-	Pattern string `xml:"pattern"`
-	Limit   int    `xml:"limit"`
-	Offset  int    `xml:"offset"`
+	Pattern string                   `xml:"pattern"`
+	Limit   int                      `xml:"limit"`
+	Offset  int                      `xml:"offset"`
+	Facets  []reportSearchFilesFacet `xml:"facet"`
+}
+
+// reportSearchFilesFacet requests the counts of a facet, like <oc:facet size="5">tags</oc:facet>
+type reportSearchFilesFacet struct {
+	Field string `xml:",chardata"`
+	Size  int    `xml:"size,attr,omitempty"`
+}
+
+// searchMultiStatusXML is a multistatus response which also carries the requested facet counts
+type searchMultiStatusXML struct {
+	propfind.MultiStatusResponseXML
+	Facets *facetsXML `xml:"oc:facets,omitempty"`
+}
+
+type facetsXML struct {
+	Facets []facetXML `xml:"oc:facet"`
+}
+
+type facetXML struct {
+	Field  string          `xml:"name,attr"`
+	Values []facetValueXML `xml:"oc:value"`
+}
+
+type facetValueXML struct {
+	Count int64  `xml:"count,attr"`
+	Value string `xml:",chardata"`
 }
 
 type reportFilterFiles struct {
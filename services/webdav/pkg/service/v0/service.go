@@ -238,7 +238,7 @@ func (g Webdav) SpacesThumbnail(w http.ResponseWriter, r *http.Request) {
 	fullPath := filepath.Join(tr.Identifier, tr.Filepath)
 	rsp, err := g.thumbnailsClient.GetThumbnail(r.Context(), &thumbnailssvc.GetThumbnailRequest{
 		Filepath:      strings.TrimLeft(tr.Filepath, "/"),
-		ThumbnailType: extensionToThumbnailType(strings.TrimLeft(tr.Extension, ".")),
+		ThumbnailType: negotiateThumbnailType(tr),
 		Width:         tr.Width,
 		Height:        tr.Height,
 		Processor:     tr.Processor,
@@ -336,7 +336,7 @@ func (g Webdav) Thumbnail(w http.ResponseWriter, r *http.Request) {
 	fullPath := filepath.Join(templates.WithUser(user, g.config.WebdavNamespace), tr.Filepath)
 	rsp, err := g.thumbnailsClient.GetThumbnail(r.Context(), &thumbnailssvc.GetThumbnailRequest{
 		Filepath:      strings.TrimLeft(tr.Filepath, "/"),
-		ThumbnailType: extensionToThumbnailType(strings.TrimLeft(tr.Extension, ".")),
+		ThumbnailType: negotiateThumbnailType(tr),
 		Width:         tr.Width,
 		Height:        tr.Height,
 		Processor:     tr.Processor,
@@ -386,7 +386,7 @@ func (g Webdav) PublicThumbnail(w http.ResponseWriter, r *http.Request) {
 
 	rsp, err := g.thumbnailsClient.GetThumbnail(r.Context(), &thumbnailssvc.GetThumbnailRequest{
 		Filepath:      strings.TrimLeft(tr.Filepath, "/"),
-		ThumbnailType: extensionToThumbnailType(strings.TrimLeft(tr.Extension, ".")),
+		ThumbnailType: negotiateThumbnailType(tr),
 		Width:         tr.Width,
 		Height:        tr.Height,
 		Processor:     tr.Processor,
@@ -431,7 +431,7 @@ func (g Webdav) PublicThumbnailHead(w http.ResponseWriter, r *http.Request) {
 
 	_, err = g.thumbnailsClient.GetThumbnail(r.Context(), &thumbnailssvc.GetThumbnailRequest{
 		Filepath:      strings.TrimLeft(tr.Filepath, "/"),
-		ThumbnailType: extensionToThumbnailType(strings.TrimLeft(tr.Extension, ".")),
+		ThumbnailType: negotiateThumbnailType(tr),
 		Width:         tr.Width,
 		Height:        tr.Height,
 		Processor:     tr.Processor,
@@ -497,14 +497,31 @@ func (g Webdav) sendThumbnailResponse(rsp *thumbnailssvc.GetThumbnailResponse, w
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", dlRsp.Header.Get("Content-Type"))
+	// the type of the thumbnail depends on the formats accepted by the client
+	w.Header().Set("Vary", "Accept")
+	w.WriteHeader(http.StatusOK)
 	_, err = io.Copy(w, dlRsp.Body)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to write thumbnail to response writer")
 	}
 }
 
+// negotiateThumbnailType prefers the AVIF and WebP formats if the client accepts them explicitly.
+// Otherwise the thumbnail type is derived from the file extension. The thumbnails service keeps jpg for
+// photos if it can't encode WebP lossily, the response carries the type of the generated thumbnail.
+func negotiateThumbnailType(tr *requests.ThumbnailRequest) thumbnailsmsg.ThumbnailType {
+	for _, t := range tr.AcceptedTypes {
+		switch t {
+		case "image/avif":
+			return thumbnailsmsg.ThumbnailType_AVIF
+		case "image/webp":
+			return thumbnailsmsg.ThumbnailType_WEBP
+		}
+	}
+	return extensionToThumbnailType(strings.TrimLeft(tr.Extension, "."))
+}
+
 func extensionToThumbnailType(ext string) thumbnailsmsg.ThumbnailType {
 	switch strings.ToUpper(ext) {
 	case "GIF":